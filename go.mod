@@ -1,12 +1,19 @@
 module github.com/H0llyW00dzZ/K8sBlackPearl
 
-go 1.21.5
+go 1.22.0
+
 toolchain go1.22.4
 
 require go.uber.org/zap v1.27.0
 
 require github.com/H0llyW00dzZ/ChatGPT-Next-Web-Session-Exporter v1.3.1
 
+require (
+	github.com/evanphx/json-patch v4.12.0+incompatible // indirect
+	github.com/google/go-cmp v0.6.0 // indirect
+	github.com/pkg/errors v0.9.1 // indirect
+)
+
 require (
 	github.com/H0llyW00dzZ/go-urlshortner v0.4.10
 	github.com/davecgh/go-spew v1.1.1 // indirect
@@ -45,6 +52,7 @@ require (
 	k8s.io/client-go v0.30.2
 	k8s.io/klog/v2 v2.120.1 // indirect
 	k8s.io/kube-openapi v0.0.0-20240228011516-70dd3763d340 // indirect
+	k8s.io/metrics v0.30.2
 	k8s.io/utils v0.0.0-20230726121419-3b25d923346b // indirect
 	sigs.k8s.io/json v0.0.0-20221116044647-bc3834ca7abd // indirect
 	sigs.k8s.io/structured-merge-diff/v4 v4.4.1 // indirect
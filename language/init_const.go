@@ -51,15 +51,11 @@ const (
 	ErrorParameterPolicyName               = "parameter 'policyName' is required and must be a string"
 	ErrorParameterPolicySpec               = "parameter 'policySpec' is required and must be a string"
 	ErrorParaMetterPolicySpecJSONorYAML    = "parameter 'policySpec' contains invalid JSON or YAML: %v"
-	ErrorConflict                          = "Conflict detected when scaling deployment '%s', resolving..."
-	FailedToScaleDeployment                = "Failed to scale deployment '%s' to '%d' after %d retries: %v"
 	FailedTOScallEdDeployment              = "Failed to scale deployment '%s' to '%d' Reason: %v"
 	FailedToGetDeployment                  = "Failed to get deployment '%s': %v"
 	ErrorFailedtoScalingDeployment         = "Failed to scale deployment"
-	ErrorConflictUpdateImage               = "Conflict encountered while updating deployment image for deployment %s Retrying..."
 	ErrorReachedMaxRetries                 = "Reached max retries for updating deployment image"
 	ErrorFailedToUpdateImage               = "Failed to update image for deployment %s: %v"
-	ErrorFailedToUpdateImageAfterRetries   = "Failed to update image for deployment %s after %d retries"
 	ErrorFailedToUpdateDeployImage         = "Failed to update deployment image"
 	ErrorFailedToUpdatePolicy              = "Failed to update policy '%s': %s: %v"
 	ErrorFMTFailedtogetcurrentpolicy       = "Failed to get current policy '%s': %v"
@@ -77,6 +73,185 @@ const (
 	ErrorAttemptFailed                     = "attempt %d failed: %w"
 	ErrorTaskFailedAfterAttempts           = "task %s failed after %d attempts: %w"
 	ErrorFailedToCompleteAfterAttempts     = "failed to complete after %d attempts: %v"
+	ErrorParameterMustBeBool               = "parameter '%s' must be a boolean"
+	ErrorParameterMustBeStringMap          = "parameter '%s' must be a map of strings"
+	ErrorParameterMustBeList               = "parameter '%s' must be a list"
+	ErrorParameterLimitRangeName           = "parameter 'limitRangeName' is required and must be a string"
+	ErrorParameterLimitItem                = "parameter 'limits' entry %d is invalid: %v"
+	ErrorInvalidLimitType                  = "invalid limit type '%s', must be 'Container' or 'Pod'"
+	ErrorInvalidQuantity                   = "invalid quantity '%s' for resource '%s': %v"
+	ErrorCreatingLimitRange                = "Error creating limit range: %w"
+	ErrorFailedToCreateLimitRange          = "Failed to create LimitRange '%s': %v"
+	ErrorLimitRangeAlreadyExists           = "LimitRange '%s' already exists and overwrite is disabled"
+	ErrorParameterManifest                 = "parameter 'manifest' is required and must be a non-empty string"
+	ErrorDecodingManifest                  = "failed to decode manifest: %v"
+	ErrorFailedToApplyObject               = "Failed to apply '%s': %v"
+	ErrorApplyManifestFailed               = "failed to apply %d manifest object(s): %s"
+	ErrorForbiddenClusterScoped            = "permission denied for cluster-scoped resource %s '%s': %v"
+	ErrorMetricsAPIUnavailable             = "metrics API %s is not available, is metrics-server installed? %v"
+	ErrorInvalidSecretRef                  = "invalid 'secretRef': both 'name' and 'key' are required strings"
+	ErrorSecretRefNotFound                 = "failed to read secret '%s' in namespace '%s': %w"
+	ErrorSecretRefKeyNotFound              = "key '%s' not found in secret '%s' in namespace '%s'"
+	ErrorParameterNodeName                 = "parameter 'nodeName' is required and must be a string"
+	ErrorNodeNotCordoned                   = "node '%s' is still schedulable, cordon it before deletion"
+	ErrorNodeNotEmpty                      = "node '%s' still hosts %d non-DaemonSet pod(s): %s"
+	ErrorFailedToDeleteNode                = "Failed to delete node '%s': %v"
+	ErrorParameterProvisioner              = "parameter 'provisioner' is required and must be a string"
+	ErrorInvalidReclaimPolicy              = "invalid reclaimPolicy '%s', must be 'Delete' or 'Retain'"
+	ErrorInvalidVolumeBindingMode          = "invalid volumeBindingMode '%s', must be 'Immediate' or 'WaitForFirstConsumer'"
+	ErrorStorageClassAlreadyExists         = "StorageClass '%s' already exists and overwrite is disabled"
+	ErrorFailedToCreateStorageClass        = "Failed to create StorageClass '%s': %v"
+	ErrorAuditRecordDropped                = "Audit buffer is full, dropping audit record"
+	ErrorAuditWriteFailed                  = "Failed to write audit record"
+	ErrorPreviousReplicasMissing           = "Deployment '%s' has no '%s' annotation; it was not scaled down by ScaleToZero"
+	ErrorPreviousReplicasInvalid           = "Invalid replica count '%s' in annotation '%s' of deployment '%s'"
+	ErrorFailedToRestoreReplicas           = "Failed to restore replicas of deployment '%s': %v"
+	ErrorInvalidParameterSentinel          = "invalid task parameter"
+	ErrorWatchFailed                       = "Failed waiting for pods to become '%s': %v"
+	ErrorWatchTimedOut                     = "timed out waiting for condition '%s' after %v"
+	ErrorInvalidWatchCondition             = "invalid condition '%s': must be 'ready' or 'deleted'"
+	ErrorWatchTargetMissing                = "either parameter 'labelSelector' or 'podName' is required"
+	ErrorInvalidMessageTemplate            = "invalid message template: %w"
+	ErrorRenderingMessageTemplate          = "failed to render message template: %w"
+	ErrorFailedToCreatePod                 = "Failed to create pod '%s': %v"
+	ErrorPodFailedWhileWaiting             = "pod '%s' failed while waiting for it to become ready: %s"
+	ErrorPodNotReadyInTime                 = "pod '%s' did not become ready within %v"
+	ErrorInvalidRestartPolicy              = "invalid restart policy '%s': must be 'Always', 'OnFailure', or 'Never'"
+	ErrorFailedToDeletePod                 = "Failed to delete pod '%s': %v"
+	ErrorNamedPodNotFound                  = "pod '%s' not found in namespace '%s'"
+	ErrorFailedToAnnotateDeployment        = "Failed to annotate deployment '%s': %v"
+	ErrorInvalidAnnotationTarget           = "invalid target '%s': must be 'metadata' or 'template'"
+	ErrorTaskPanicked                      = "task '%s' panicked: %v"
+	ErrorListingSecrets                    = "error listing secrets: %w"
+	ErrorFailedToUpdateStrategy            = "Failed to update strategy of deployment '%s': %v"
+	ErrorInvalidStrategyType               = "invalid strategy type '%s': must be 'RollingUpdate' or 'Recreate'"
+	ErrorRollingParamsWithRecreate         = "parameters 'maxSurge' and 'maxUnavailable' are only valid for the 'RollingUpdate' strategy"
+	ErrorInvalidIntOrPercent               = "parameter '%s' must be a non-negative integer or a percentage such as \"25%%\""
+	ErrorListingIngresses                  = "error listing ingresses: %w"
+	ErrorGettingDeployment                 = "Failed to get deployment '%s': %w"
+	ErrorScalingDeploymentReason           = "Failed to scale deployment '%s' to '%d' Reason: %w"
+	ErrorFailedToSetPaused                 = "Failed to mark deployment '%s' as %s: %v"
+	ErrorCreatingEndpoints                 = "error creating endpoints: %w"
+	ErrorEndpointsAlreadyExists            = "endpoints '%s' already exists; set 'overwrite' to replace its addresses"
+	ErrorFailedToCreateEndpoints           = "Failed to create endpoints '%s': %v"
+	ErrorParameterEndpointAddress          = "parameter 'addresses' entry %d is invalid: %v"
+	ErrorInvalidIPAddress                  = "invalid IP address '%s'"
+	ErrorInvalidPort                       = "invalid port %d: must be between 1 and 65535"
+	ErrorInvalidHostname                   = "invalid hostname '%s': %s"
+	ErrorInvalidProtocol                   = "invalid protocol '%s': must be TCP, UDP, or SCTP"
+	ErrorReadingCompletionStore            = "error reading completion store configmap '%s/%s': %w"
+	ErrorWritingCompletionStore            = "error writing completion store configmap '%s/%s': %w"
+	ErrorConfigMapNotFound                 = "configmap '%s' not found in namespace '%s': %w"
+	ErrorSecretNotFound                    = "secret '%s' not found in namespace '%s': %w"
+	ErrorFailedToReadKeys                  = "Failed to read keys of '%s': %v"
+	ErrorIngressPathNotFound               = "no rule with host '%s' and path '%s' found in ingress '%s'"
+	ErrorFailedToUpdateIngressBackend      = "Failed to update backend of ingress '%s': %v"
+	ErrorServiceNotFound                   = "service '%s' not found in namespace '%s': %w"
+	ErrorListingEndpointSlices             = "error listing endpointslices: %w"
+	ErrorGettingEndpoints                  = "error getting endpoints '%s': %w"
+	ErrorNoReadyEndpoints                  = "service '%s' has no ready endpoints"
+	ErrorPodNotRunning                     = "pod '%s' is not running (phase %s)"
+	ErrorProbingPod                        = "error probing pod: %w"
+	ErrorUnexpectedProbeStatus             = "unexpected HTTP status %d, expected %d"
+	ErrorFailedToProbePod                  = "Failed to probe pod '%s': %v"
+	ErrorRetryDelayOutOfBounds             = "retryDelay %v is outside the allowed bounds [%v, %v]"
+	ErrorDeploymentAlreadyExists           = "deployment '%s' already exists in namespace '%s'; set 'overwrite' to replace it"
+	ErrorFailedToCloneDeployment           = "Failed to clone deployment '%s/%s' into namespace '%s': %v"
+	ErrorCloneOntoItself                   = "the clone target must differ from the source deployment"
+	ErrorValidateManifestFailed            = "%d object(s) rejected by the API server: %s"
+	ErrorFailedToSetPullSecrets            = "Failed to set image pull secrets on %s '%s': %v"
+	ErrorPullSecretTargetAmbiguous         = "set only one of 'serviceAccountName' and 'deploymentName'"
+	ErrorPullSecretTargetMissing           = "one of 'serviceAccountName' or 'deploymentName' is required"
+	ErrorVPANotInstalled                   = "VerticalPodAutoscaler API %s is not available, is the VPA CRD installed? %v"
+	ErrorVPAAlreadyExists                  = "verticalpodautoscaler '%s' already exists and 'overwrite' is not set"
+	ErrorFailedToCreateVPA                 = "Failed to create VerticalPodAutoscaler '%s': %v"
+	ErrorInvalidVPAUpdateMode              = "invalid updateMode '%s', must be 'Off', 'Initial', or 'Auto'"
+	ErrorListingJobs                       = "error listing jobs: %w"
+	ErrorFailedToDeleteJob                 = "Failed to delete job '%s': %v"
+	ErrorDeletingJobs                      = "failed to delete %d job(s): %s"
+	ErrorFailedToCleanupJobs               = "Failed to clean up jobs after deleting %d job(s) [%s]: %v"
+	ErrorInvalidJobStatus                  = "invalid status '%s', must be 'Complete' or 'Failed'"
+	ErrorJobNotFound                       = "job '%s' not found in namespace '%s'"
+	ErrorJobFailed                         = "job '%s' failed: %s: %s"
+	ErrorJobNotCompleteInTime              = "job '%s' did not complete within %v"
+	ErrorFailedWaitingForJob               = "Failed waiting for job '%s': %v"
+	ErrorListingReplicaSets                = "error listing replicasets: %w"
+	ErrorApplyFieldConflict                = "%s has fields owned by another field manager, set 'force' to take ownership: %w"
+	ErrorForceRequiresServerSide           = "parameter 'force' requires 'serverSideApply' to be true"
+	ErrorInventoryResourceUnreadable       = "Could not list %s: %v"
+	ErrorInventoryUnavailable              = "could not list any resource in the namespace: %s"
+	ErrorListingDeployments                = "error listing deployments: %w"
+	ErrorScaleManyFailed                   = "failed to scale %d deployment(s): %s"
+	ErrorListingPDBs                       = "error listing poddisruptionbudgets: %w"
+	ErrorPDBsBlockDisruption               = "poddisruptionbudgets would block disruption: %s"
+	ErrorFailedToGetPodLogs                = "Failed to get logs of pod '%s': %v"
+	ErrorNoPreviousContainer               = "container '%s' of pod '%s' has no previous terminated instance: %v"
+	ErrorPodLogsSinceAmbiguous             = "only one of the parameters 'sinceSeconds' and 'sinceTime' may be set"
+	ErrorUnknownTaskTypes                  = "tasks with unknown types: %s; valid types are: %s"
+	ErrorFailedToUpdateSecret              = "Failed to update data of secret '%s': %v"
+	ErrorPodInformerNotSynced              = "pod informer for namespace '%s' did not sync within %v"
+	ErrorFailedToSetNodeSelector           = "Failed to set node selector of deployment '%s': %v"
+	ErrorImageMismatches                   = "containers running unexpected images: %s"
+	ErrorTaskOutputNotAvailable            = "output '%s' of task '%s' is not available: task '%s' completed without publishing it"
+	ErrorTaskOutputProducerNotInRun        = "output '%s' of task '%s' is not available: task '%s' is not part of the run"
+	ErrorTaskOutputProducerFailed          = "output '%s' of task '%s' is not available: task '%s' failed: %v"
+	ErrorFailedToDeletePodsByPhase         = "Failed to delete %s pods after deleting %d pod(s) [%s]: %v"
+	ErrorDeletingPods                      = "failed to delete %d pod(s): %s"
+	ErrorInvalidTerminalPhase              = "invalid phase '%s': must be 'Succeeded' or 'Failed'"
+	ErrorListingResourceQuotas             = "error listing resourcequotas: %w"
+	ErrorQuotasExhausted                   = "resource quotas exhausted: %s"
+	ErrorCreatingConfigMap                 = "error creating configmap: %w"
+	ErrorConfigMapAlreadyExists            = "configmap '%s' already exists; set 'overwrite' to replace its data"
+	ErrorFailedToCreateConfigMap           = "Failed to create configmap '%s': %v"
+	ErrorInvalidBinaryData                 = "binaryData key '%s' is not valid base64: %v"
+	ErrorDuplicateConfigMapKey             = "key '%s' appears in both 'data' and 'binaryData'"
+	ErrorConfigMapDataMissing              = "at least one of 'data' or 'binaryData' is required"
+	ErrorPVCNotFound                       = "pvc '%s' not found in namespace '%s'"
+	ErrorPVCLost                           = "pvc '%s' is lost; its volume '%s' no longer exists"
+	ErrorPVCNotBoundInTime                 = "pvc '%s' was not bound within %v; current phase is '%s'"
+	ErrorFailedWaitingForPVC               = "Failed waiting for PVC '%s': %v"
+	ErrorParameterGivenTwice               = "parameter '%s' is given in both camelCase and snake_case"
+	ErrorReplacementNotReadyInTime         = "no ready replacement for pod '%s' within %v"
+	ErrorFailedToRestartPods               = "Failed to restart pods after restarting %d pod(s) [%s]: %v"
+	ErrorFailedToGetOwnerChain             = "Failed to get the ownership chain of pod '%s': %v"
+	ErrorCreatingService                   = "error creating service: %w"
+	ErrorServiceAlreadyExists              = "service '%s' already exists"
+	ErrorFailedToCreateService             = "Failed to create service '%s': %v"
+	ErrorParameterServicePort              = "parameter 'ports' entry %d is invalid: %v"
+	ErrorServicePortNameRequired           = "port %d needs a 'name' when several ports are given"
+	ErrorInvalidPortName                   = "invalid port name '%s': %s"
+	ErrorInvalidTargetPort                 = "invalid targetPort '%s': %s"
+	ErrorRolloutStuck                      = "deployment '%s' rollout is stuck: %s"
+	ErrorFailedToCheckRollout              = "Failed to check the rollout of deployment '%s': %v"
+	ErrorPodsUnhealthy                     = "%d of %d checked pod(s) are unhealthy: %s"
+	ErrorHPANotFound                       = "horizontal pod autoscaler '%s' not found in namespace '%s'"
+	ErrorHPAInvalidBounds                  = "minReplicas %d must not exceed maxReplicas %d"
+	ErrorFailedToUpdateHPA                 = "Failed to update horizontal pod autoscaler '%s': %v"
+	ErrorHPAUpdateMissing                  = "at least one of 'minReplicas', 'maxReplicas', or 'targetCPUUtilizationPercentage' is required"
+	ErrorRunDeadlineExceeded               = "run deadline exceeded"
+	ErrorTaskInterrupted                   = "%w, task interrupted: %w"
+	ErrorCreatingEndpointSlice             = "error creating endpointslice: %w"
+	ErrorEndpointSliceAlreadyExists        = "endpointslice '%s' already exists; set 'overwrite' to replace its endpoints"
+	ErrorEndpointSliceAddressType          = "endpointslice '%s' has address type %s, which cannot be changed to %s"
+	ErrorFailedToCreateEndpointSlice       = "Failed to create endpointslice '%s': %v"
+	ErrorInvalidAddressType                = "invalid address type '%s'; expected 'IPv4', 'IPv6', or 'FQDN'"
+	ErrorParameterSliceEndpoint            = "parameter 'endpoints' entry %d is invalid: %v"
+	ErrorInvalidSliceAddress               = "invalid address '%v' for address type %s"
+	ErrorFailedToSumPodResources           = "Failed to sum the resources of pods matching '%s': %v"
+	ErrorClaimStore                        = "Claim store operation failed"
+	ErrorFailedToCheckPodSecurity          = "Failed to check the security of pods matching '%s': %v"
+	ErrorPodSecurityViolations             = "%d container(s) in namespace '%s' violate the pod security policy"
+	ErrorFailedToLabelKind                 = "Failed to label %s after labeling %d object(s): %v"
+	ErrorUnsupportedLabelKind              = "kind '%s' is not supported; supported kinds are deployments, statefulsets, daemonsets, services, configmaps, secrets, and pods"
+	ErrorInvalidTask                       = "task %d (%s): %w"
+	ErrorInvalidTasks                      = "%d task problem(s) found:\n%w"
+	ErrorTaskTypeMissing                   = "type is required"
+	ErrorFailedToFindOrphanedResources     = "Failed to find orphaned resources in namespace '%s': %v"
+	ErrorFailedToCountSourcePods           = "Failed to count the source pods matching '%s': %v"
+	ErrorShutdownRequested                 = "shutdown requested"
+	ErrorContextCancelledWithCause         = "%w (cause: %w)"
+	ErrorReconcileIntervalInvalid          = "reconcile interval must be positive, got %v"
+	ErrorDynamicClientMissing              = "no dynamic client is registered for the clientset; create it with NewKubernetesClient or register one with SetDynamicClient"
 )
 
 const (
@@ -97,54 +272,361 @@ const (
 )
 
 const (
-	TaskLabelKey              = "LabelKey"
-	TaskCheckHealth           = "CheckHealth"
-	TaskGetPod                = "GetPod"
-	TaskFetchPods             = "FetchPods"
-	TaskProcessPod            = "ProcessPod"
-	TaskCreatePod             = "CreatePod"
-	TaskDeletePod             = "DeletePod"
-	TaskCompleteS             = "Task '%s' completed successfully."
-	TaskWorker_Name           = "Crew Worker %d: %s"
-	TaskNumber                = "The number of workers and the number of tasks do not match."
-	RunningTaskBackup         = "Running BackupTaskRunner with parameters:"
-	Task_Name                 = "task_name"
-	Worker_Name               = "crew_worker"
-	TaskLabelPods             = "WriteLabelPods"
-	TaskManageDeployments     = "ManageDeployments"
-	TaskScaleDeployment       = "ScaleDeployment"
-	TaskUpdateDeploymentImage = "UpdateDeploymentImage"
-	TaskCreatePVC             = "CreatePVCStorage"
-	TaskUpdateNetworkPolicy   = "UpdateNetworkPolicy"
-	WritingLabelPods          = "Crew Worker %d: Writing label"
-	ScalingDeployment         = "Crew Worker %d: Scaling deployments"
-	ManagingDeployments       = "Crew Worker %d: Managing deployments"
-	UpdatingImage             = "Crew Worker %d: Updating deployment image"
-	CreatePVCStorage          = "Crew Worker %d: Creating PVC storage"
-	UpdateNetworkPolicy       = "Crew Worker %d: Updating network policy"
-	CheckingHealthPods        = "Crew Worker %d: Checking health pods"
+	TaskLabelKey                    = "LabelKey"
+	TaskCheckHealth                 = "CheckHealth"
+	TaskGetPod                      = "GetPod"
+	TaskFetchPods                   = "FetchPods"
+	TaskProcessPod                  = "ProcessPod"
+	TaskCreatePod                   = "CreatePod"
+	TaskDeletePod                   = "DeletePod"
+	TaskCompleteS                   = "Task '%s' completed successfully."
+	TaskWorker_Name                 = "Crew Worker %d: %s"
+	TaskNumber                      = "The number of workers and the number of tasks do not match."
+	RunningTaskBackup               = "Running BackupTaskRunner with parameters:"
+	Task_Name                       = "task_name"
+	Worker_Name                     = "crew_worker"
+	TaskLabelPods                   = "WriteLabelPods"
+	TaskManageDeployments           = "ManageDeployments"
+	TaskScaleDeployment             = "ScaleDeployment"
+	TaskUpdateDeploymentImage       = "UpdateDeploymentImage"
+	TaskCreatePVC                   = "CreatePVCStorage"
+	TaskUpdateNetworkPolicy         = "UpdateNetworkPolicy"
+	TaskCreateLimitRange            = "CreateLimitRange"
+	WritingLabelPods                = "Crew Worker %d: Writing label"
+	ScalingDeployment               = "Crew Worker %d: Scaling deployments"
+	ManagingDeployments             = "Crew Worker %d: Managing deployments"
+	UpdatingImage                   = "Crew Worker %d: Updating deployment image"
+	CreatePVCStorage                = "Crew Worker %d: Creating PVC storage"
+	UpdateNetworkPolicy             = "Crew Worker %d: Updating network policy"
+	CheckingHealthPods              = "Crew Worker %d: Checking health pods"
+	CreateLimitRange                = "Crew Worker %d: Creating limit range"
+	TaskApplyManifest               = "ApplyManifest"
+	ApplyManifest                   = "Crew Worker %d: Applying manifest"
+	TaskGetPodMetrics               = "GetPodMetrics"
+	TaskGetNodeMetrics              = "GetNodeMetrics"
+	GettingPodMetrics               = "Crew Worker %d: Getting pod metrics"
+	GettingNodeMetrics              = "Crew Worker %d: Getting node metrics"
+	TaskDeleteNode                  = "DeleteNode"
+	DeletingNode                    = "Crew Worker %d: Deleting node"
+	TaskCreateStorageClass          = "CreateStorageClass"
+	CreatingStorageClass            = "Crew Worker %d: Creating storage class"
+	TaskScaleToZero                 = "ScaleToZero"
+	ScalingDeploymentToZero         = "Crew Worker %d: Scaling deployment to zero"
+	TaskRestoreReplicas             = "RestoreReplicas"
+	RestoringDeploymentReplicas     = "Crew Worker %d: Restoring deployment replicas"
+	TaskWatchPods                   = "WatchPodsUntilCondition"
+	WatchingPods                    = "Crew Worker %d: Watching pods until condition is met"
+	CreatingPod                     = "Crew Worker %d: Creating pod"
+	TaskDeletePodByName             = "DeletePodByName"
+	DeletingPodByName               = "Crew Worker %d: Deleting pod by name"
+	TaskAnnotateDeployment          = "AnnotateDeployment"
+	AnnotatingDeployment            = "Crew Worker %d: Annotating deployment"
+	TaskListSecretsMetadata         = "ListSecretsMetadata"
+	ListingSecretsMetadata          = "Crew Worker %d: Listing secrets metadata"
+	TaskUpdateDeploymentStrategy    = "UpdateDeploymentStrategy"
+	UpdatingDeploymentStrategy      = "Crew Worker %d: Updating deployment strategy"
+	TaskGetIngresses                = "GetIngresses"
+	GettingIngresses                = "Crew Worker %d: Getting ingresses"
+	TaskPauseDeployment             = "PauseDeployment"
+	PausingDeployment               = "Crew Worker %d: Pausing deployment rollout"
+	TaskResumeDeployment            = "ResumeDeployment"
+	ResumingDeployment              = "Crew Worker %d: Resuming deployment rollout"
+	TaskGetPodsByNode               = "GetPodsByNode"
+	GettingPodsByNode               = "Crew Worker %d: Grouping pods by node"
+	TaskCreateEndpoints             = "CreateEndpoints"
+	CreatingEndpoints               = "Crew Worker %d: Creating endpoints"
+	TaskGetConfigMap                = "GetConfigMap"
+	GettingConfigMap                = "Crew Worker %d: Getting configmap keys"
+	TaskGetSecretKeys               = "GetSecretKeys"
+	GettingSecretKeys               = "Crew Worker %d: Getting secret keys"
+	TaskUpdateIngressBackend        = "UpdateIngressBackend"
+	UpdatingIngressBackend          = "Crew Worker %d: Updating ingress backend"
+	TaskCheckServiceEndpoints       = "CheckServiceEndpoints"
+	CheckingServiceEndpoints        = "Crew Worker %d: Checking service endpoints"
+	TaskProbePod                    = "RunHealthProbeAgainstPod"
+	ProbingPod                      = "Crew Worker %d: Probing pod health endpoint"
+	TaskCloneDeployment             = "CloneDeployment"
+	CloningDeployment               = "Crew Worker %d: Cloning deployment"
+	TaskValidateManifest            = "ValidateManifest"
+	ValidatingManifest              = "Crew Worker %d: Validating manifest with a server-side dry run"
+	TaskSetImagePullSecrets         = "SetImagePullSecrets"
+	SettingImagePullSecrets         = "Crew Worker %d: Setting image pull secrets"
+	TaskCreateVPA                   = "CreateVPA"
+	CreatingVPA                     = "Crew Worker %d: Creating VerticalPodAutoscaler"
+	TaskCleanupJobs                 = "CleanupJobs"
+	CleaningUpJobs                  = "Crew Worker %d: Cleaning up finished jobs"
+	TaskWaitForJobCompletion        = "WaitForJobCompletion"
+	WaitingForJob                   = "Crew Worker %d: Waiting for job completion"
+	TaskGetDeploymentRevisions      = "GetDeploymentRevisions"
+	GettingDeploymentRevisions      = "Crew Worker %d: Getting deployment revisions"
+	TaskGetNamespaceInventory       = "GetNamespaceInventory"
+	GettingNamespaceInventory       = "Crew Worker %d: Getting namespace inventory"
+	TaskScaleMany                   = "ScaleMany"
+	ScalingManyDeployments          = "Crew Worker %d: Scaling deployments by label selector"
+	TaskCheckPDBViolations          = "CheckPDBViolations"
+	CheckingPDBViolations           = "Crew Worker %d: Checking pod disruption budgets"
+	TaskGetPodLogsSince             = "GetPodLogsSince"
+	GettingPodLogs                  = "Crew Worker %d: Getting pod logs"
+	TaskUpdateSecretData            = "UpdateSecretData"
+	UpdatingSecretData              = "Crew Worker %d: Updating secret data"
+	TaskSetDeploymentNodeSelector   = "SetDeploymentNodeSelector"
+	SettingDeploymentNodeSelector   = "Crew Worker %d: Setting deployment node selector"
+	TaskCheckImageTags              = "CheckImageTags"
+	CheckingImageTags               = "Crew Worker %d: Checking deployment images"
+	TaskDeletePodsByPhase           = "DeletePodsByPhase"
+	DeletingPodsByPhase             = "Crew Worker %d: Deleting pods by phase"
+	TaskGetResourceQuotaUsage       = "GetResourceQuotaUsage"
+	GettingResourceQuotaUsage       = "Crew Worker %d: Getting resource quota usage"
+	TaskCreateConfigMap             = "CreateConfigMap"
+	CreatingConfigMap               = "Crew Worker %d: Creating configmap"
+	TaskWaitForPVCBound             = "WaitForPVCBound"
+	WaitingForPVCBound              = "Crew Worker %d: Waiting for PVC to be bound"
+	TaskRestartPods                 = "RestartPods"
+	RestartingPods                  = "Crew Worker %d: Restarting pods"
+	TaskGetPodOwnerChain            = "GetPodOwnerChain"
+	GettingPodOwnerChain            = "Crew Worker %d: Getting pod ownership chain"
+	TaskCreateHeadlessService       = "CreateHeadlessService"
+	CreatingHeadlessService         = "Crew Worker %d: Creating headless service"
+	TaskCheckDeploymentRolloutStuck = "CheckDeploymentRolloutStuck"
+	CheckingRolloutStuck            = "Crew Worker %d: Checking for a stuck deployment rollout"
+	TaskUpdateHPA                   = "UpdateHPA"
+	UpdatingHPA                     = "Crew Worker %d: Updating horizontal pod autoscaler"
+	MaxRunDuration                  = "max_run_duration"
+	TaskCreateEndpointSlice         = "CreateEndpointSlice"
+	CreatingEndpointSlice           = "Crew Worker %d: Creating endpointslice"
+	ReconcileCycle                  = "reconcile_cycle"
+	CycleDuration                   = "cycle_duration"
+	TaskGetPodResourceRequests      = "GetPodResourceRequests"
+	SummingPodResources             = "Crew Worker %d: Summing pod resource requests"
+	LabelSelector                   = "label_selector"
+	ClientMode                      = "client_mode"
+	ClientModeInCluster             = "in-cluster"
+	ClientModeOutOfCluster          = "out-of-cluster"
+	TaskCheckPodSecurity            = "CheckPodSecurity"
+	CheckingPodSecurity             = "Crew Worker %d: Checking pod security contexts"
+	Violations                      = "violations"
+	TaskLabelResourcesAcrossKinds   = "LabelResourcesAcrossKinds"
+	LabelingResourcesAcrossKinds    = "Crew Worker %d: Labeling resources across kinds"
+	TaskGetOrphanedResources        = "GetOrphanedResources"
+	FindingOrphanedResources        = "Crew Worker %d: Finding orphaned configmaps, secrets, and persistent volume claims"
+	TaskScaleBasedOnPodCount        = "ScaleBasedOnPodCount"
+	ScalingBasedOnPodCount          = "Crew Worker %d: Scaling a deployment based on a pod count"
 )
 
 const (
-	WorkerStarted                   = "Worker started"
-	WorkerFinishedProcessingPods    = "Worker finished processing pods"
-	WorkerCancelled                 = "Worker cancelled: %v"
-	WorkerFailedToListPods          = "Failed to list pods"
-	WorkerFailedToCreatePod         = "Failed to create pod"
-	WorkerFailedToDeletePod         = "Failed to delete pod"
-	WorkerCountPods                 = "Count pods"
-	WorkerCheckingHealth            = "Checking health pods"
-	CrewWorkerUnit                  = "crew_worker_unit"
-	StartWritingLabelPods           = "Starting to writing label pods with %s=%s"
-	WorkerSucessfully               = "Successfully labeled pods %v=%s"
-	DeploymentScaled                = "Deployment '%s' scaled to '%d'"
-	ScaledDeployment                = "Scaled deployment '%s' to '%d' replicas"
-	ImageSuccessfully               = "Image updated successfully for deployment %s to %s"
-	DeploymentImageUpdated          = "Deployment image updated successfully"
-	UpdatingDeploymentImage         = "Updating deployment image"
-	WorkerSucessfullyCreatePVC      = "Successfully created PVC '%s' in namespace '%s'"
-	WorkerPolicySuccessfullyUpdated = "Policy '%s' updated successfully: %s"
-	NetworkSuccessfullyUpdated      = "NetworkPolicy '%s' updated successfully: %s"
+	WorkerStarted                    = "Worker started"
+	WorkerFinishedProcessingPods     = "Worker finished processing pods"
+	WorkerCancelled                  = "Worker cancelled: %v"
+	WorkerFailedToListPods           = "Failed to list pods"
+	WorkerFailedToCreatePod          = "Failed to create pod"
+	WorkerFailedToDeletePod          = "Failed to delete pod"
+	WorkerCountPods                  = "Count pods"
+	WorkerCheckingHealth             = "Checking health pods"
+	CrewWorkerUnit                   = "crew_worker_unit"
+	StartWritingLabelPods            = "Starting to writing label pods with %s=%s"
+	WorkerSucessfully                = "Successfully labeled pods %v=%s"
+	DeploymentScaled                 = "Deployment '%s' scaled to '%d'"
+	ScaledDeployment                 = "Scaled deployment '%s' to '%d' replicas"
+	ImageSuccessfully                = "Image updated successfully for deployment %s to %s"
+	DeploymentImageUpdated           = "Deployment image updated successfully"
+	UpdatingDeploymentImage          = "Updating deployment image"
+	WorkerSucessfullyCreatePVC       = "Successfully created PVC '%s' in namespace '%s'"
+	WorkerPolicySuccessfullyUpdated  = "Policy '%s' updated successfully: %s"
+	NetworkSuccessfullyUpdated       = "NetworkPolicy '%s' updated successfully: %s"
+	LimitRangeSuccessfullyCreated    = "Successfully created LimitRange '%s' in namespace '%s'"
+	LimitRangeSuccessfullyUpdated    = "Successfully overwrote LimitRange '%s' in namespace '%s'"
+	ObjectApplied                    = "Object '%s' %s successfully"
+	ActionCreated                    = "created"
+	ActionUpdated                    = "updated"
+	PodUsage                         = "Pod '%s' usage: cpu=%s memory=%s"
+	NodeUsage                        = "Node '%s' usage: cpu=%s memory=%s"
+	CPUUsage                         = "cpu_usage"
+	MemoryUsage                      = "memory_usage"
+	ContainerCount                   = "container_count"
+	NodeName                         = "node_name"
+	NodeDeletedSuccessfully          = "Successfully deleted node '%s'"
+	StorageClassSuccessfullyCreated  = "Successfully created StorageClass '%s'"
+	StorageClassSuccessfullyReplaced = "Successfully replaced StorageClass '%s'"
+	OutcomeSuccess                   = "success"
+	OutcomeFailure                   = "failure"
+	OutcomeSkipped                   = "skipped"
+	OutcomeWarning                   = "warning"
+	OutcomeDeadlineExceeded          = "deadline_exceeded"
+	RedactedValue                    = "<redacted>"
+	ScaledDeploymentToZero           = "Scaled deployment '%s' to 0 replicas (previously %d)"
+	RestoredDeploymentReplicas       = "Restored deployment '%s' to %d replicas"
+	WatchConditionMet                = "Condition '%s' met for %d pods"
+	WatchPodEvent                    = "Pod event %s: %s (%s)"
+	WatchExpiredRelisting            = "Watch resource version expired, listing pods again"
+	PodCreatedSuccessfully           = "Successfully created pod '%s' in namespace '%s'"
+	PodCreatedAndReady               = "Successfully created pod '%s' in namespace '%s' and it is ready"
+	PodDeletedSuccessfully           = "Successfully deleted pod '%s' in namespace '%s'"
+	PodAlreadyDeleted                = "Pod '%s' in namespace '%s' does not exist, nothing to delete"
+	DeploymentAnnotated              = "Successfully set annotation '%s' on deployment '%s' (%s)"
+	DeploymentAnnotationUnchanged    = "Annotation '%s' on deployment '%s' (%s) is already set, skipping"
+	StackTrace                       = "stacktrace"
+	SecretMetadata                   = "Secret '%s' type=%s keys=[%s] age=%v"
+	SecretName                       = "secret_name"
+	SecretType                       = "secret_type"
+	SecretKeys                       = "secret_keys"
+	Age                              = "age"
+	DeploymentStrategyUpdated        = "Successfully set strategy of deployment '%s' to %s"
+	RequestID                        = "request_id"
+	TenantID                         = "tenant_id"
+	TraceID                          = "trace_id"
+	DeploymentAlreadyScaled          = "Deployment '%s' already has %d replicas, nothing to do"
+	ImageAlreadyUpToDate             = "Container '%s' of deployment '%s' already uses image '%s', nothing to do"
+	IngressSummary                   = "Ingress '%s' hosts=[%s] backends=[%s] tls=[%s] address=%s"
+	NotAssigned                      = "<pending>"
+	IngressName                      = "ingress_name"
+	IngressHosts                     = "hosts"
+	IngressBackends                  = "backends"
+	IngressTLSSecrets                = "tls_secrets"
+	IngressAddresses                 = "addresses"
+	StatePaused                      = "paused"
+	StateResumed                     = "resumed"
+	DeploymentPausedState            = "Deployment '%s' is now %s"
+	DeploymentAlreadyInPausedState   = "Deployment '%s' is already %s, nothing to do"
+	NodePodsSummary                  = "Node '%s' runs %d pod(s): [%s]"
+	UnscheduledPods                  = "<unscheduled>"
+	PodCount                         = "pod_count"
+	PodNames                         = "pod_names"
+	EndpointsSuccessfullyCreated     = "Endpoints '%s' successfully created in namespace '%s'"
+	EndpointsSuccessfullyUpdated     = "Endpoints '%s' successfully updated in namespace '%s'"
+	TaskSkippedAlreadyCompleted      = "Task '%s' skipped: idempotency key '%s' already completed"
+	ConfigMapKeys                    = "ConfigMap '%s' keys=[%s]"
+	BinaryValueSize                  = "%s=<binary %d bytes>"
+	SecretKeysReport                 = "Secret '%s' type=%s keys=[%s]"
+	ConfigMapName                    = "configmap_name"
+	IngressBackendUpdated            = "Ingress '%s' host '%s' path '%s' backend changed from %s to %s"
+	ServiceEndpointsSummary          = "Service '%s' has %d ready and %d not-ready address(es)"
+	ServiceEndpointAddress           = "Service '%s' endpoint %s is %s"
+	EndpointReady                    = "ready"
+	EndpointNotReady                 = "not ready"
+	ServiceName                      = "service_name"
+	ReadyAddresses                   = "ready_addresses"
+	NotReadyAddresses                = "not_ready_addresses"
+	PodProbeSucceeded                = "Pod '%s' answered on port %d path '%s' with HTTP %d"
+	StatusCode                       = "status_code"
+	TaskSummaryLine                  = "Loaded %d task(s); by type: [%s]; by namespace: [%s]; missing retry settings: %d; concerns: %d"
+	Unset                            = "<unset>"
+	ConcernTaskWithoutName           = "task at index %d has no name"
+	ConcernDuplicateTaskName         = "task name '%s' is used more than once"
+	ConcernTaskWithoutType           = "task '%s' has no type"
+	ConcernTaskWithoutNamespace      = "task '%s' has no shipsNamespace"
+	TotalTasks                       = "total_tasks"
+	TasksByType                      = "tasks_by_type"
+	TasksByNamespace                 = "tasks_by_namespace"
+	MissingRetrySettings             = "missing_retry_settings"
+	Concerns                         = "concerns"
+	RetryDelayClamped                = "Task '%s': retryDelay %v is out of bounds, using %v"
+	DeploymentCloned                 = "Deployment '%s/%s' cloned to '%s/%s'"
+	DeploymentCloneOverwritten       = "Deployment '%s/%s' cloned over existing '%s/%s'"
+	ObjectValid                      = "%s would be accepted by the API server"
+	ObjectRejected                   = "%s would be rejected by the API server: %v"
+	PullSecretsAdded                 = "Image pull secrets [%s] added to %s '%s'"
+	PullSecretsAlreadyPresent        = "Image pull secrets already present on %s '%s'"
+	APIOperation                     = "api_operation"
+	APIResource                      = "api_resource"
+	APILatency                       = "api_latency"
+	TaskTypeField                    = "task_type"
+	TaskTagPrefix                    = "tag."
+	APICallCompleted                 = "API call %s %s took %s"
+	VPASuccessfullyCreated           = "VerticalPodAutoscaler '%s' successfully created in namespace '%s'"
+	VPASuccessfullyUpdated           = "VerticalPodAutoscaler '%s' successfully updated in namespace '%s'"
+	JobsCleanedUp                    = "Deleted %d finished job(s) in namespace '%s': [%s]"
+	JobCompleted                     = "Job '%s' completed with %d/%d succeeded pods"
+	JobProgress                      = "Job '%s' progress: %d/%d succeeded, %d active, %d failed"
+	DeploymentRevisionSummary        = "Revision %d: replicaset '%s', images [%s], created %s"
+	DeploymentCurrentRevisionSummary = "Revision %d (current): replicaset '%s', images [%s], created %s"
+	Revision                         = "revision"
+	ReplicaSetName                   = "replicaset_name"
+	ContainerImages                  = "container_images"
+	CreatedAt                        = "created_at"
+	CurrentRevision                  = "current_revision"
+	ActionApplied                    = "applied"
+	InventoryResourceCount           = "%s: %d"
+	InventoryResourceCountByPhase    = "%s: %d (%s)"
+	InventoryResource                = "inventory_resource"
+	InventoryCount                   = "inventory_count"
+	InventoryByPhase                 = "inventory_by_phase"
+	PDBBlocksDisruption              = "PodDisruptionBudget '%s' allows no disruptions and would block eviction of [%s]"
+	PDBAllowsDisruption              = "PodDisruptionBudget '%s' allows %d disruption(s) for [%s]"
+	PDBName                          = "pdb_name"
+	DisruptionsAllowed               = "disruptions_allowed"
+	PDBBlocking                      = "pdb_blocking"
+	TaskFailedContinuing             = "Task failed but continueOnError is set, continuing: %s"
+	PodLogsRetrieved                 = "Logs of pod '%s' (%d bytes):\n%s"
+	PodLogsTruncated                 = "Logs of pod '%s' (truncated at %d bytes):\n%s"
+	PodLogsFetched                   = "Fetched %d bytes of logs from pod '%s'"
+	UnknownTaskTypeEntry             = "'%s' (type '%s')"
+	SecretDataMerged                 = "Secret '%s' updated, merged keys=[%s]"
+	SecretDataReplaced               = "Secret '%s' data replaced, keys=[%s]"
+	DeploymentNodeSelectorSet        = "Successfully set node selector of deployment '%s' to %s"
+	DeploymentNodeSelectorCleared    = "Successfully cleared node selector of deployment '%s'"
+	ImageMismatch                    = "Deployment '%s' container '%s' runs image '%s' instead of '%s'"
+	ImageMatches                     = "Deployment '%s' container '%s' runs the expected image '%s'"
+	DeploymentName                   = "deployment_name"
+	ContainerName                    = "container_name"
+	ExpectedImage                    = "expected_image"
+	ActualImage                      = "actual_image"
+	PodsDeletedByPhase               = "Deleted %d %s pod(s) in namespace '%s': [%s]"
+	NoResourceQuotas                 = "Namespace '%s' has no ResourceQuotas"
+	QuotaExhausted                   = "ResourceQuota '%s' %s is exhausted: used %s of %s"
+	QuotaNearLimit                   = "ResourceQuota '%s' %s is near its limit: used %s of %s (%d%%), %s remaining"
+	QuotaUsage                       = "ResourceQuota '%s' %s: used %s of %s (%d%%), %s remaining"
+	QuotaName                        = "quota_name"
+	QuotaResource                    = "quota_resource"
+	QuotaUsed                        = "quota_used"
+	QuotaHard                        = "quota_hard"
+	QuotaRemaining                   = "quota_remaining"
+	QuotaPercentUsed                 = "quota_percent_used"
+	ConfigMapSuccessfullyCreated     = "ConfigMap '%s' successfully created in namespace '%s' with %d data and %d binaryData keys"
+	ConfigMapSuccessfullyUpdated     = "ConfigMap '%s' successfully updated in namespace '%s' with %d data and %d binaryData keys"
+	PVCBound                         = "PVC '%s' is bound to volume '%s'"
+	PVCPhase                         = "PVC '%s' phase: %s"
+	PodDeletedForRestart             = "Deleted pod '%s' for restart (%d/%d)"
+	ReplacementPodReady              = "Replacement pod '%s' for '%s' is ready"
+	PodsRestarted                    = "Restarted %d pod(s) in namespace '%s': [%s]"
+	PodOwnerChain                    = "Pod '%s' ownership chain: %s"
+	PodHasNoOwner                    = "Pod '%s' has no owner; it is an orphan"
+	AdditionalOwners                 = "; additional owners: [%s]"
+	OwnerMissing                     = " (not found)"
+	HeadlessServiceCreated           = "Headless service '%s' successfully created in namespace '%s' with %d port(s)"
+	RolloutPaused                    = "Deployment '%s' rollout is paused"
+	RolloutComplete                  = "Deployment '%s' rollout is complete"
+	RolloutProgressing               = "Deployment '%s' rollout is progressing: %d/%d replicas updated, last progress %v ago"
+	RolloutNoProgress                = "no progress for %v, beyond the %v threshold"
+	RolloutStuck                     = "Deployment '%s' rollout is stuck: %s"
+	HealthCheckSummary               = "Health check complete: %d pod(s) checked, %d unhealthy"
+	HealthCheckPartial               = "Health check cancelled: %d of %d pod(s) checked, %d unhealthy"
+	HPAUpdated                       = "Horizontal pod autoscaler '%s' updated: minReplicas=%d maxReplicas=%d"
+	RunDeadlineExceeded              = "Run deadline of %v exceeded, cancelling in-flight tasks and closing the results channel"
+	EndpointSliceSuccessfullyCreated = "EndpointSlice '%s' successfully created in namespace '%s' with %d %s endpoint(s)"
+	EndpointSliceSuccessfullyUpdated = "EndpointSlice '%s' successfully updated in namespace '%s' with %d %s endpoint(s)"
+	ReconcileCycleSummary            = "Reconcile cycle %d finished in %v: %d succeeded, %d failed, %d warning(s), %d skipped, %d interrupted by the run deadline"
+	ReconcileTickSkipped             = "Reconcile cycle %d is still running, skipping this tick"
+	ConflictUnresolved               = "Conflict on task '%s' could not be resolved, retrying with unchanged parameters"
+	PodResources                     = "Pod '%s' resources: cpu requests=%s limits=%s, memory requests=%s limits=%s"
+	PodResourcesTotal                = "Resources of %d pod(s) in namespace '%s': cpu requests=%s limits=%s, memory requests=%s limits=%s; %d pod(s) request nothing"
+	KubernetesClientConfigured       = "Kubernetes client configured (%s)"
+	PodSecurityViolation             = "Pod '%s' container '%s' violates the security policy: %s"
+	PodSecuritySummary               = "Pod security check complete: %d pod(s) checked in namespace '%s', %d container(s) with violations"
+	ViolationRunsAsRoot              = "runs as root (runAsUser 0)"
+	ViolationMayRunAsRoot            = "may run as root (runAsNonRoot not set)"
+	ViolationPrivileged              = "privileged"
+	ViolationPrivilegeEscalation     = "allows privilege escalation"
+	ViolationCapabilitiesNotDropped  = "does not drop ALL capabilities"
+	KindLabeled                      = "%s: labeled %d object(s), %d already labeled"
+	LabelKindsSummary                = "Label '%s=%s' applied across %d kind(s) in namespace '%s': %d object(s) labeled, %d already labeled, %d kind(s) failed"
+	LabeledCount                     = "labeled_count"
+	SkippedCount                     = "skipped_count"
+	OrphanedResource                 = "Unreferenced %s '%s'"
+	OrphanedResourcesSummary         = "Orphaned resource check complete in namespace '%s': %d of %d configmap(s), %d of %d secret(s), and %d of %d persistent volume claim(s) appear unreferenced"
+	ResourceName                     = "resource_name"
+	ReplicasComputedFromPodCount     = "Source selector '%s' matches %d pod(s); deployment '%s' should run %d replica(s)"
+	DesiredReplicas                  = "desired_replicas"
+	CancellationCause                = "cancellation_cause"
 )
 
 const (
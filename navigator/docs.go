@@ -33,6 +33,12 @@
 //	fields := navigator.CreateLogFields("navigation", "starry-sea", zap.String("detail", "additional info"))
 //	navigator.LogInfoWithEmoji("🧭", "Navigating the stars", fields...)
 //
+// NewLogger can build the logger instead, with options such as WithSampling to thin out repetitive
+// messages during large runs while distinct messages still pass:
+//
+//	logger, _ := navigator.NewLogger(navigator.WithSampling(time.Second, 10, 100))
+//	navigator.SetLogger(logger)
+//
 // Important Note:
 // Ensure that SetLogger is invoked before any logging functions to prevent nil pointer
 // dereferences. If the Logger is nil during a logging attempt, an error message will be
@@ -26,6 +26,9 @@ var Logger *zap.Logger
 // mu is used to protect access to the Logger variable to make it safe for concurrent use.
 var mu sync.Mutex
 
+// emojiEnabled reports whether log messages are prefixed with their emoji. It is protected by mu.
+var emojiEnabled = true
+
 // tryLog attempts to log a message if the rate limiter allows it.
 func tryLog(logFunc func(zapcore.Level, string, ...zap.Field), level zapcore.Level, message string, fields ...zap.Field) {
 	if logLimiter.Allow() {
@@ -40,6 +43,7 @@ func logMessage(logFunc func(string, ...zap.Field), message string, fields ...za
 
 // LogWithEmoji logs a message with a given level, emoji, context, and fields.
 // It checks if the Logger is not nil before logging to prevent panics.
+// The emoji prefix is omitted when emojis have been disabled with SetEmojiEnabled.
 // The rateLimited flag determines whether the log should be rate limited.
 func LogWithEmoji(level zapcore.Level, emoji string, context string, rateLimited bool, fields ...zap.Field) {
 	mu.Lock()
@@ -50,7 +54,10 @@ func LogWithEmoji(level zapcore.Level, emoji string, context string, rateLimited
 		return
 	}
 
-	message := emoji + " " + context
+	message := context
+	if emojiEnabled {
+		message = emoji + " " + context
+	}
 	if rateLimited {
 		tryLog(logByLevel, level, message, fields...)
 	} else {
@@ -96,6 +103,15 @@ func SetLogger(logger *zap.Logger) {
 	mu.Unlock()
 }
 
+// SetEmojiEnabled controls, in a thread-safe manner, whether log messages are prefixed with their emoji.
+// Disable it for log ingestion systems or terminals that cannot handle multibyte emoji; the message is
+// then logged as plain text. Emojis are enabled by default.
+func SetEmojiEnabled(enabled bool) {
+	mu.Lock()
+	emojiEnabled = enabled
+	mu.Unlock()
+}
+
 // LogInfoWithEmoji logs an informational message with a given emoji, context, and fields.
 // It checks if the Logger is not nil before logging to prevent panics.
 func LogInfoWithEmoji(emoji string, context string, fields ...zap.Field) {
@@ -0,0 +1,37 @@
+package navigator
+
+import (
+	"testing"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func TestSetEmojiEnabledStripsTheEmojiPrefix(t *testing.T) {
+	core, logs := observer.New(zapcore.InfoLevel)
+	SetLogger(zap.New(core))
+	t.Cleanup(func() {
+		SetEmojiEnabled(true)
+		SetLogger(nil)
+	})
+
+	LogInfoWithEmoji("🏴‍☠️", "Hoist the colours")
+	SetEmojiEnabled(false)
+	LogInfoWithEmoji("🏴‍☠️", "Hoist the colours")
+	LogErrorWithEmoji("⚔️", "Cannon misfired")
+
+	var messages []string
+	for _, entry := range logs.All() {
+		messages = append(messages, entry.Message)
+	}
+	want := []string{"🏴‍☠️ Hoist the colours", "Hoist the colours", "Cannon misfired"}
+	if len(messages) != len(want) {
+		t.Fatalf("got messages %q, want %q", messages, want)
+	}
+	for i := range want {
+		if messages[i] != want[i] {
+			t.Fatalf("got messages %q, want %q", messages, want)
+		}
+	}
+}
@@ -0,0 +1,87 @@
+package navigator
+
+import (
+	"time"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// loggerConfig collects the settings applied by NewLogger.
+type loggerConfig struct {
+	development bool
+	level       zapcore.Level
+	sampling    bool
+	tick        time.Duration
+	initial     int
+	thereafter  int
+}
+
+// LoggerOption is a function that adjusts how NewLogger builds a logger.
+type LoggerOption func(*loggerConfig)
+
+// WithDevelopment builds a human-friendly development logger instead of the JSON production logger.
+func WithDevelopment() LoggerOption {
+	return func(c *loggerConfig) {
+		c.development = true
+	}
+}
+
+// WithLevel sets the minimum level that is logged.
+func WithLevel(level zapcore.Level) LoggerOption {
+	return func(c *loggerConfig) {
+		c.level = level
+	}
+}
+
+// WithSampling enables zap's sampler. Within each tick, the first 'initial' entries with the same
+// level and message are always logged, and after that only every 'thereafter'-th entry is kept.
+// Unlike the rate limiter used by the *RateLimited helpers, which drops any message once the burst
+// is spent, sampling only thins out repetitive messages, so distinct messages still get through.
+//
+// Example usage:
+//
+//	logger, err := navigator.NewLogger(navigator.WithSampling(time.Second, 10, 100))
+func WithSampling(tick time.Duration, initial, thereafter int) LoggerOption {
+	return func(c *loggerConfig) {
+		c.sampling = true
+		c.tick = tick
+		c.initial = initial
+		c.thereafter = thereafter
+	}
+}
+
+// NewLogger builds a zap logger suitable for SetLogger. By default it is a production JSON logger at
+// info level without sampling; the options can switch to a development logger, change the level, or
+// enable sampling to reduce log volume during large labeling or health check runs.
+//
+// Parameters:
+//
+//	opts ...LoggerOption: Options that adjust the logger.
+//
+// Returns:
+//
+//	*zap.Logger: The configured logger.
+//	error: An error if the logger cannot be built.
+func NewLogger(opts ...LoggerOption) (*zap.Logger, error) {
+	cfg := &loggerConfig{level: zapcore.InfoLevel}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	zapConfig := zap.NewProductionConfig()
+	if cfg.development {
+		zapConfig = zap.NewDevelopmentConfig()
+	}
+	zapConfig.Level = zap.NewAtomicLevelAt(cfg.level)
+	// Sampling is configured explicitly below, so zap's built-in default is turned off.
+	zapConfig.Sampling = nil
+
+	var buildOpts []zap.Option
+	if cfg.sampling {
+		buildOpts = append(buildOpts, zap.WrapCore(func(core zapcore.Core) zapcore.Core {
+			return zapcore.NewSamplerWithOptions(core, cfg.tick, cfg.initial, cfg.thereafter)
+		}))
+	}
+	return zapConfig.Build(buildOpts...)
+}
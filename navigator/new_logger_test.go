@@ -0,0 +1,63 @@
+package navigator
+
+import (
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// countLoggedLines builds a logger with the options while standard error, where NewLogger writes, is
+// redirected to a file, lets log write through it, and returns how often each message was logged.
+func countLoggedLines(t *testing.T, log func(logger *zap.Logger), opts ...LoggerOption) map[string]int {
+	t.Helper()
+	output, err := os.CreateTemp(t.TempDir(), "stderr")
+	if err != nil {
+		t.Fatal(err)
+	}
+	stderr := os.Stderr
+	os.Stderr = output
+	logger, err := NewLogger(opts...)
+	os.Stderr = stderr
+	if err != nil {
+		t.Fatalf("NewLogger: %v", err)
+	}
+
+	log(logger)
+	_ = logger.Sync()
+	data, err := os.ReadFile(output.Name())
+	if err != nil {
+		t.Fatal(err)
+	}
+	counts := make(map[string]int)
+	for _, line := range strings.Split(strings.TrimSpace(string(data)), "\n") {
+		for _, message := range []string{"labeled pod", "health check done"} {
+			if strings.Contains(line, message) {
+				counts[message]++
+			}
+		}
+	}
+	return counts
+}
+
+func TestNewLoggerWithSamplingThinsOutRepetitiveMessages(t *testing.T) {
+	flood := func(logger *zap.Logger) {
+		for i := 0; i < 100; i++ {
+			logger.Info("labeled pod")
+		}
+		logger.Info("health check done")
+	}
+
+	sampled := countLoggedLines(t, flood, WithSampling(time.Minute, 5, 20))
+	// The first 5 entries pass, then every 20th of the remaining 95: the 20th, 40th, 60th, and 80th.
+	if sampled["labeled pod"] != 9 || sampled["health check done"] != 1 {
+		t.Fatalf("with sampling got %v, want 9 repetitive and 1 distinct message", sampled)
+	}
+
+	unsampled := countLoggedLines(t, flood)
+	if unsampled["labeled pod"] != 100 {
+		t.Fatalf("without sampling got %v, want every message", unsampled)
+	}
+}
@@ -0,0 +1,131 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/H0llyW00dzZ/K8sBlackPearl/language"
+	"github.com/H0llyW00dzZ/K8sBlackPearl/navigator"
+	"github.com/H0llyW00dzZ/go-urlshortner/logmonitor/constant"
+	"go.uber.org/zap"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/util/retry"
+)
+
+// AnnotateDeployment sets an annotation on a deployment using a strategic merge patch. With the
+// 'metadata' target the deployment's own annotations are changed; with the 'template' target the
+// pod template annotations are changed instead, which triggers a rollout. The patch carries the
+// observed resource version, so concurrent modifications cause a conflict that is retried with
+// a fresh read. Nothing is patched when the annotation already has the requested value.
+//
+// Parameters:
+//
+//	ctx context.Context: Context for cancellation and timeout.
+//	clientset kubernetes.Interface: A Kubernetes clientset for interacting with the Kubernetes API.
+//	namespace string: The namespace of the deployment.
+//	deploymentName string: The name of the deployment to annotate.
+//	target string: Either 'metadata' or 'template'.
+//	annotationKey string: The annotation key to set.
+//	annotationValue string: The annotation value to set.
+//	results chan<- string: A channel to send operation results for logging.
+//	logger *zap.Logger: A logger for structured logging.
+//
+// Returns an error if the deployment cannot be read or patched.
+func AnnotateDeployment(ctx context.Context, clientset kubernetes.Interface, namespace, deploymentName, target, annotationKey, annotationValue string, results chan<- string, logger *zap.Logger) error {
+	skipped := false
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		deployment, err := clientset.AppsV1().Deployments(namespace).Get(ctx, deploymentName, v1.GetOptions{})
+		if err != nil {
+			return fmt.Errorf(language.FailedToGetDeployment, deploymentName, err)
+		}
+
+		current := deployment.Annotations
+		if target == annotationTargetTemplate {
+			current = deployment.Spec.Template.Annotations
+		}
+		if value, found := current[annotationKey]; found && value == annotationValue {
+			skipped = true
+			return nil
+		}
+
+		patch, err := buildAnnotationPatch(target, deployment.ResourceVersion, annotationKey, annotationValue)
+		if err != nil {
+			return err
+		}
+		_, err = clientset.AppsV1().Deployments(namespace).Patch(ctx, deploymentName, types.StrategicMergePatchType, patch, v1.PatchOptions{})
+		return err
+	})
+	if err != nil {
+		errorMessage := fmt.Sprintf(language.ErrorFailedToAnnotateDeployment, deploymentName, err)
+		results <- errorMessage
+		navigator.LogErrorWithEmojiRateLimited(constant.ErrorEmoji, errorMessage, zap.Error(err))
+		return err
+	}
+
+	message := fmt.Sprintf(language.DeploymentAnnotated, annotationKey, deploymentName, target)
+	if skipped {
+		message = fmt.Sprintf(language.DeploymentAnnotationUnchanged, annotationKey, deploymentName, target)
+	}
+	results <- message
+	navigator.LogInfoWithEmoji(constant.SuccessEmoji, message)
+	return nil
+}
+
+// buildAnnotationPatch builds the strategic merge patch that sets a single annotation on the
+// deployment metadata or on its pod template. The resource version is included so the API server
+// rejects the patch with a conflict if the deployment changed since it was read.
+//
+// This unexported function is used internally by AnnotateDeployment.
+func buildAnnotationPatch(target, resourceVersion, annotationKey, annotationValue string) ([]byte, error) {
+	annotations := map[string]interface{}{
+		annotationKey: annotationValue,
+	}
+	patch := map[string]interface{}{
+		metaData: map[string]interface{}{
+			resourceVersioN: resourceVersion,
+		},
+	}
+	if target == annotationTargetTemplate {
+		patch[speC] = map[string]interface{}{
+			templatE: map[string]interface{}{
+				metaData: map[string]interface{}{
+					annotationS: annotations,
+				},
+			},
+		}
+	} else {
+		patch[metaData].(map[string]interface{})[annotationS] = annotations
+	}
+	return json.Marshal(patch)
+}
+
+// extractAnnotateDeploymentParameters extracts and validates the 'deploymentName', 'annotationKey',
+// 'annotationValue', and optional 'target' parameters. The target defaults to 'metadata' and must be
+// either 'metadata' or 'template'.
+//
+// This function is used by task runners that annotate deployments.
+func extractAnnotateDeploymentParameters(parameters map[string]interface{}) (deploymentName, target, annotationKey, annotationValue string, err error) {
+	deploymentName, err = extractDeploymentNameParameter(parameters)
+	if err != nil {
+		return "", "", "", "", err
+	}
+	annotationKey, err = getParamAsString(parameters, annotationKeY)
+	if err != nil || annotationKey == "" {
+		return "", "", "", "", newParameterError(annotationKeY, err, language.ErrorParameterMissing, annotationKeY)
+	}
+	annotationValue, err = getParamAsString(parameters, annotationValuE)
+	if err != nil {
+		return "", "", "", "", err
+	}
+	target, err = getOptionalParamAsString(parameters, targeT, annotationTargetMetadata)
+	if err != nil {
+		return "", "", "", "", err
+	}
+	if target != annotationTargetMetadata && target != annotationTargetTemplate {
+		return "", "", "", "", newParameterError(targeT, nil, language.ErrorInvalidAnnotationTarget, target)
+	}
+	return deploymentName, target, annotationKey, annotationValue, nil
+}
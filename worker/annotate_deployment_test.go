@@ -0,0 +1,57 @@
+package worker
+
+import (
+	"context"
+	"testing"
+
+	"github.com/H0llyW00dzZ/K8sBlackPearl/worker/configuration"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestCrewAnnotateDeploymentTargets(t *testing.T) {
+	for _, target := range []string{"metadata", "template"} {
+		clientset := fake.NewSimpleClientset(&appsv1.Deployment{
+			ObjectMeta: v1.ObjectMeta{Name: "api", Namespace: "default"},
+		})
+		task := configuration.Task{
+			Name: "annotate-api",
+			Type: "CrewAnnotateDeployment",
+			Parameters: map[string]interface{}{
+				"deploymentName":  "api",
+				"annotationKey":   "cost-center",
+				"annotationValue": "black-pearl",
+				"target":          target,
+			},
+		}
+		collector := &resultCollector{}
+		ctx := collector.context(context.Background())
+
+		for run := 0; run < 2; run++ {
+			if err := (&CrewAnnotateDeployment{}).Run(ctx, clientset, "default", task, task.Parameters, 0); err != nil {
+				t.Fatalf("%s: Run: %v", target, err)
+			}
+		}
+
+		deployment, err := clientset.AppsV1().Deployments("default").Get(context.Background(), "api", v1.GetOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		annotated, untouched := deployment.Annotations, deployment.Spec.Template.Annotations
+		if target == "template" {
+			annotated, untouched = untouched, annotated
+		}
+		if annotated["cost-center"] != "black-pearl" || len(untouched) != 0 {
+			t.Fatalf("%s: got metadata annotations %v and template annotations %v", target, deployment.Annotations, deployment.Spec.Template.Annotations)
+		}
+
+		want := []string{
+			"Successfully set annotation 'cost-center' on deployment 'api' (" + target + ")",
+			"Annotation 'cost-center' on deployment 'api' (" + target + ") is already set, skipping",
+		}
+		if results := collector.all(); len(results) != 2 || results[0] != want[0] || results[1] != want[1] {
+			t.Fatalf("%s: got results %q, want %q", target, results, want)
+		}
+	}
+}
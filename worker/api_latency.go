@@ -0,0 +1,156 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/H0llyW00dzZ/K8sBlackPearl/language"
+	"github.com/H0llyW00dzZ/K8sBlackPearl/navigator"
+	"github.com/H0llyW00dzZ/K8sBlackPearl/worker/configuration"
+	"go.uber.org/zap"
+	"k8s.io/client-go/rest"
+)
+
+// apiLatencyLogging reports whether the duration of every Kubernetes API call is logged.
+var apiLatencyLogging atomic.Bool
+
+// EnableAPILatencyLogging turns the logging of Kubernetes API call durations on or off, in a
+// thread-safe manner. When enabled, every call made through a clientset created by this package's
+// constructors is logged with its operation, resource, status code, duration, and the task that
+// issued it, including the task's Tags. It is disabled by default, and the only cost
+// while disabled is a single atomic load per call.
+func EnableAPILatencyLogging(enabled bool) {
+	apiLatencyLogging.Store(enabled)
+}
+
+// apiCallTaskKey is the context key under which the task issuing API calls is stored.
+type apiCallTaskKey struct{}
+
+// apiCallTask identifies the task that issued an API call.
+type apiCallTask struct {
+	name     string
+	taskType string
+	tags     []zap.Field
+}
+
+// withAPICallTask returns a copy of the context that attributes API calls to the given task.
+// The context is returned unchanged while latency logging is disabled.
+//
+// This unexported function is used internally by performTask.
+func withAPICallTask(ctx context.Context, task configuration.Task) context.Context {
+	if !apiLatencyLogging.Load() {
+		return ctx
+	}
+	return context.WithValue(ctx, apiCallTaskKey{}, apiCallTask{name: task.Name, taskType: task.Type, tags: taskTagFields(task)})
+}
+
+// apiLatencyRoundTripper times each Kubernetes API request and logs its duration when
+// latency logging is enabled.
+type apiLatencyRoundTripper struct {
+	next http.RoundTripper
+}
+
+// RoundTrip executes the request and, when latency logging is enabled, logs how long the API server
+// took to respond. For streaming requests the duration covers the time until the stream is opened.
+func (t *apiLatencyRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	if !apiLatencyLogging.Load() {
+		return t.next.RoundTrip(req)
+	}
+
+	start := time.Now()
+	resp, err := t.next.RoundTrip(req)
+	duration := time.Since(start)
+
+	operation, resource := describeAPIRequest(req)
+	fields := []zap.Field{
+		zap.String(language.APIOperation, operation),
+		zap.String(language.APIResource, resource),
+		zap.Duration(language.APILatency, duration),
+	}
+	if task, ok := req.Context().Value(apiCallTaskKey{}).(apiCallTask); ok {
+		fields = append(fields, zap.String(language.Task_Name, task.name), zap.String(language.TaskTypeField, task.taskType))
+		fields = append(fields, task.tags...)
+	}
+	if err != nil {
+		fields = append(fields, zap.Error(err))
+	} else {
+		fields = append(fields, zap.Int(language.StatusCode, resp.StatusCode))
+	}
+	navigator.LogInfoWithEmoji(language.CompassEmoji, fmt.Sprintf(language.APICallCompleted, operation, resource, duration), fields...)
+	return resp, err
+}
+
+// describeAPIRequest derives the Kubernetes verb and the resource of an API request from its method
+// and URL path, for example "list" and "pods" for GET /api/v1/namespaces/default/pods.
+//
+// This unexported function is used internally by apiLatencyRoundTripper.
+func describeAPIRequest(req *http.Request) (operation, resource string) {
+	segments := strings.Split(strings.Trim(req.URL.Path, "/"), "/")
+
+	// Skip the API prefix: "api/<version>" for the core group, "apis/<group>/<version>" otherwise.
+	switch {
+	case len(segments) >= 2 && segments[0] == "api":
+		segments = segments[2:]
+	case len(segments) >= 3 && segments[0] == "apis":
+		segments = segments[3:]
+	default:
+		return strings.ToLower(req.Method), req.URL.Path
+	}
+	if len(segments) >= 2 && segments[0] == "namespaces" && len(segments) != 2 {
+		segments = segments[2:]
+	}
+
+	named := false
+	switch len(segments) {
+	case 0:
+		resource = ""
+	case 1:
+		resource = segments[0]
+	case 2:
+		resource, named = segments[0], true
+	default:
+		resource, named = segments[0]+"/"+segments[2], true
+	}
+
+	switch req.Method {
+	case http.MethodGet:
+		switch {
+		case isStreamingRequest(req) && !named:
+			operation = "watch"
+		case named:
+			operation = "get"
+		default:
+			operation = "list"
+		}
+	case http.MethodPost:
+		operation = "create"
+	case http.MethodPut:
+		operation = "update"
+	case http.MethodPatch:
+		operation = "patch"
+	case http.MethodDelete:
+		operation = "delete"
+		if !named {
+			operation = "deletecollection"
+		}
+	default:
+		operation = strings.ToLower(req.Method)
+	}
+	return operation, resource
+}
+
+// applyAPILatencyLogging installs the latency instrumentation on a client configuration. The wrapper
+// is always installed so that EnableAPILatencyLogging also affects clientsets created earlier.
+//
+// Parameters:
+//
+//	config *rest.Config: The configuration to modify.
+func applyAPILatencyLogging(config *rest.Config) {
+	config.Wrap(func(rt http.RoundTripper) http.RoundTripper {
+		return &apiLatencyRoundTripper{next: rt}
+	})
+}
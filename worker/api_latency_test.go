@@ -0,0 +1,44 @@
+package worker
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+func TestAPILatencyLoggingAddsALatencyField(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"api","namespace":"default"}}`))
+	}))
+	defer server.Close()
+	config := &rest.Config{Host: server.URL}
+	applyAPILatencyLogging(config)
+	clientset, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	logs := observeLogs(t)
+	t.Cleanup(func() { EnableAPILatencyLogging(false) })
+
+	for _, enabled := range []bool{false, true} {
+		EnableAPILatencyLogging(enabled)
+		if _, err := clientset.AppsV1().Deployments("default").Get(context.Background(), "api", v1.GetOptions{}); err != nil {
+			t.Fatalf("Get: %v", err)
+		}
+	}
+
+	entries := logs.FilterFieldKey("api_latency").All()
+	if len(entries) != 1 {
+		t.Fatalf("got %d entries with a latency field, want one for the call made while enabled", len(entries))
+	}
+	fields := entries[0].ContextMap()
+	if fields["api_operation"] != "get" || fields["api_resource"] != "deployments" {
+		t.Fatalf("got fields %v, want the get of deployments", fields)
+	}
+}
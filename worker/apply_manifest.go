@@ -0,0 +1,204 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/H0llyW00dzZ/K8sBlackPearl/language"
+	"github.com/H0llyW00dzZ/K8sBlackPearl/navigator"
+	"github.com/H0llyW00dzZ/go-urlshortner/logmonitor/constant"
+	"go.uber.org/zap"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/util/retry"
+)
+
+// ApplyManifestOptions controls how ApplyManifestObjectsWithOptions writes objects to the cluster.
+//
+// Fields:
+//
+//	ServerSide bool: Use server-side apply instead of create-or-update.
+//	FieldManager string: The field manager recorded for the written fields; empty selects "k8sblackpearl".
+//	Force bool: With ServerSide, take ownership of fields owned by other field managers instead of
+//	  failing with a conflict. Forcing overrides values set by other controllers, which may then
+//	  fight over those fields, so it should only be used when this task is meant to own them.
+type ApplyManifestOptions struct {
+	ServerSide   bool
+	FieldManager string
+	Force        bool
+}
+
+// ApplyManifestObjects creates or updates each of the given objects using the dynamic client.
+// It is equivalent to ApplyManifestObjectsWithOptions with the zero ApplyManifestOptions.
+func ApplyManifestObjects(ctx context.Context, dynamicClient dynamic.Interface, mapper meta.RESTMapper, namespace string, objects []*unstructured.Unstructured, results chan<- string) error {
+	return ApplyManifestObjectsWithOptions(ctx, dynamicClient, mapper, namespace, objects, ApplyManifestOptions{}, results)
+}
+
+// ApplyManifestObjectsWithOptions creates or updates each of the given objects using the dynamic client,
+// or applies them with server-side apply when opts.ServerSide is set.
+// Namespaced objects without a namespace are placed in the provided namespace. Every object's
+// outcome is reported through the results channel, and processing continues past individual
+// failures so that a single bad document does not hide the result of the others.
+//
+// Parameters:
+//
+//	ctx context.Context: Context for cancellation and timeout.
+//	dynamicClient dynamic.Interface: A dynamic client for unstructured resource operations.
+//	mapper meta.RESTMapper: A RESTMapper to resolve object kinds into API resources.
+//	namespace string: The default namespace for namespaced objects that do not declare one.
+//	objects []*unstructured.Unstructured: The decoded manifest objects to apply.
+//	opts ApplyManifestOptions: The apply mode, field manager, and force setting.
+//	results chan<- string: A channel to send per-object results; it must have room for one message per object.
+//
+// Returns an error naming every object that could not be applied, or nil if all succeeded.
+func ApplyManifestObjectsWithOptions(ctx context.Context, dynamicClient dynamic.Interface, mapper meta.RESTMapper, namespace string, objects []*unstructured.Unstructured, opts ApplyManifestOptions, results chan<- string) error {
+	if opts.FieldManager == "" {
+		opts.FieldManager = defaultFieldManager
+	}
+
+	var failed []string
+	for _, obj := range objects {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		objectRef := describeObject(obj)
+		action, err := applyManifestObject(ctx, dynamicClient, mapper, namespace, obj, opts)
+		if err != nil {
+			errorMessage := fmt.Sprintf(language.ErrorFailedToApplyObject, objectRef, err)
+			results <- errorMessage
+			navigator.LogErrorWithEmojiRateLimited(constant.ErrorEmoji, errorMessage, zap.Error(err))
+			failed = append(failed, objectRef)
+			continue
+		}
+
+		successMsg := fmt.Sprintf(language.ObjectApplied, objectRef, action)
+		results <- successMsg
+		navigator.LogInfoWithEmoji(constant.SuccessEmoji, successMsg)
+	}
+
+	if len(failed) > 0 {
+		return fmt.Errorf(language.ErrorApplyManifestFailed, len(failed), strings.Join(failed, ", "))
+	}
+	return nil
+}
+
+// applyManifestObject creates a single object, or updates it when it already exists.
+// Updates carry over the live resource version and are retried on conflicts. With server-side
+// apply, the object is applied in a single request instead.
+//
+// This unexported function is used internally by ApplyManifestObjectsWithOptions.
+func applyManifestObject(ctx context.Context, dynamicClient dynamic.Interface, mapper meta.RESTMapper, namespace string, obj *unstructured.Unstructured, opts ApplyManifestOptions) (string, error) {
+	resourceClient, mapping, err := resourceInterfaceFor(dynamicClient, mapper, obj, namespace)
+	if err != nil {
+		return "", err
+	}
+
+	if opts.ServerSide {
+		return serverSideApplyObject(ctx, resourceClient, mapping, obj, opts)
+	}
+
+	_, err = resourceClient.Create(ctx, obj, v1.CreateOptions{FieldManager: opts.FieldManager})
+	if err == nil {
+		return language.ActionCreated, nil
+	}
+	if !apierrors.IsAlreadyExists(err) {
+		return "", wrapForbiddenClusterScoped(mapping, obj, err)
+	}
+
+	err = retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		existing, getErr := resourceClient.Get(ctx, obj.GetName(), v1.GetOptions{})
+		if getErr != nil {
+			return getErr
+		}
+		obj.SetResourceVersion(existing.GetResourceVersion())
+		_, updateErr := resourceClient.Update(ctx, obj, v1.UpdateOptions{FieldManager: opts.FieldManager})
+		return updateErr
+	})
+	if err != nil {
+		return "", wrapForbiddenClusterScoped(mapping, obj, err)
+	}
+	return language.ActionUpdated, nil
+}
+
+// serverSideApplyObject applies a single object with server-side apply. A conflict means that another
+// field manager owns some of the fields; retrying cannot resolve it, so it is reported as a
+// non-retriable error suggesting 'force' unless the apply was already forced.
+//
+// This unexported function is used internally by applyManifestObject.
+func serverSideApplyObject(ctx context.Context, resourceClient dynamic.ResourceInterface, mapping *meta.RESTMapping, obj *unstructured.Unstructured, opts ApplyManifestOptions) (string, error) {
+	_, err := resourceClient.Apply(ctx, obj.GetName(), obj, v1.ApplyOptions{FieldManager: opts.FieldManager, Force: opts.Force})
+	if apierrors.IsConflict(err) && !opts.Force {
+		return "", markNonRetriable(fmt.Errorf(language.ErrorApplyFieldConflict, describeObject(obj), err))
+	}
+	if err != nil {
+		return "", wrapForbiddenClusterScoped(mapping, obj, err)
+	}
+	return language.ActionApplied, nil
+}
+
+// wrapForbiddenClusterScoped turns a Forbidden error on a cluster-scoped resource into a
+// clear message stating that the caller lacks permission for that cluster-wide object.
+// Other errors are returned unchanged.
+//
+// This unexported function is used internally by applyManifestObject and serverSideApplyObject.
+func wrapForbiddenClusterScoped(mapping *meta.RESTMapping, obj *unstructured.Unstructured, err error) error {
+	if apierrors.IsForbidden(err) && mapping.Scope.Name() != meta.RESTScopeNameNamespace {
+		return fmt.Errorf(language.ErrorForbiddenClusterScoped, obj.GetKind(), obj.GetName(), err)
+	}
+	return err
+}
+
+// describeObject returns a short human readable reference for an object, such as "Deployment/web".
+//
+// This unexported function is used internally for result messages.
+func describeObject(obj *unstructured.Unstructured) string {
+	return fmt.Sprintf("%s/%s", obj.GetKind(), obj.GetName())
+}
+
+// extractManifestParameter extracts and validates the 'manifest' parameter and decodes
+// it into unstructured objects.
+//
+// This function is used by task runners that operate on raw manifests.
+func extractManifestParameter(parameters map[string]interface{}) ([]*unstructured.Unstructured, error) {
+	manifest, err := getParamAsString(parameters, manifesT)
+	if err != nil || strings.TrimSpace(manifest) == "" {
+		return nil, newParameterError(manifesT, nil, language.ErrorParameterManifest)
+	}
+
+	objects, err := decodeManifest(manifest)
+	if err != nil {
+		return nil, fmt.Errorf(language.ErrorDecodingManifest, err)
+	}
+	if len(objects) == 0 {
+		return nil, newParameterError(manifesT, nil, language.ErrorParameterManifest)
+	}
+	return objects, nil
+}
+
+// extractApplyManifestOptions extracts and validates the optional 'serverSideApply', 'fieldManager',
+// and 'force' parameters. 'force' is only accepted together with server-side apply.
+//
+// This function is used by task runners that apply manifests.
+func extractApplyManifestOptions(parameters map[string]interface{}) (ApplyManifestOptions, error) {
+	serverSide, err := getOptionalParamAsBool(parameters, serverSideApplY, false)
+	if err != nil {
+		return ApplyManifestOptions{}, err
+	}
+	fieldManager, err := getOptionalParamAsString(parameters, fieldManageR, defaultFieldManager)
+	if err != nil {
+		return ApplyManifestOptions{}, err
+	}
+	force, err := getOptionalParamAsBool(parameters, forcE, false)
+	if err != nil {
+		return ApplyManifestOptions{}, err
+	}
+	if force && !serverSide {
+		return ApplyManifestOptions{}, newParameterError(forcE, nil, language.ErrorForceRequiresServerSide)
+	}
+	return ApplyManifestOptions{ServerSide: serverSide, FieldManager: fieldManager, Force: force}, nil
+}
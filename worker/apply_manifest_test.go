@@ -0,0 +1,96 @@
+package worker
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/H0llyW00dzZ/K8sBlackPearl/worker/configuration"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+// fieldOwnershipServer wraps a fake dynamic client, which ignores apply options, the way an API server
+// answers a server-side apply of fields owned by another controller: the apply conflicts unless it is
+// forced. Every apply's options are recorded.
+type fieldOwnershipServer struct {
+	dynamic.Interface
+	applies *[]v1.ApplyOptions
+}
+
+// Resource returns a resource client that serves applies.
+func (s fieldOwnershipServer) Resource(resource schema.GroupVersionResource) dynamic.NamespaceableResourceInterface {
+	return fieldOwnershipResource{NamespaceableResourceInterface: s.Interface.Resource(resource), applies: s.applies}
+}
+
+// fieldOwnershipResource serves applies for a single resource.
+type fieldOwnershipResource struct {
+	dynamic.NamespaceableResourceInterface
+	applies *[]v1.ApplyOptions
+}
+
+// Namespace returns a client for the namespace that serves applies.
+func (r fieldOwnershipResource) Namespace(namespace string) dynamic.ResourceInterface {
+	inner := r.NamespaceableResourceInterface.Namespace(namespace).(dynamic.NamespaceableResourceInterface)
+	return fieldOwnershipResource{NamespaceableResourceInterface: inner, applies: r.applies}
+}
+
+// Apply records the options and conflicts unless the apply is forced.
+func (r fieldOwnershipResource) Apply(_ context.Context, name string, obj *unstructured.Unstructured, options v1.ApplyOptions, _ ...string) (*unstructured.Unstructured, error) {
+	*r.applies = append(*r.applies, options)
+	if !options.Force {
+		return nil, apierrors.NewApplyConflict([]v1.StatusCause{{
+			Type:    v1.CauseTypeFieldManagerConflict,
+			Message: `conflict with "other-controller": .data.mode`,
+			Field:   ".data.mode",
+		}}, "Apply failed with 1 conflict")
+	}
+	return obj, nil
+}
+
+func TestCrewApplyManifestForceResolvesFieldOwnershipConflicts(t *testing.T) {
+	clientset := fake.NewSimpleClientset()
+	forgetDynamicClient(t, clientset)
+	scheme := runtime.NewScheme()
+	if err := corev1.AddToScheme(scheme); err != nil {
+		t.Fatalf("AddToScheme: %v", err)
+	}
+	var applies []v1.ApplyOptions
+	mapper := meta.NewDefaultRESTMapper([]schema.GroupVersion{corev1.SchemeGroupVersion})
+	mapper.Add(corev1.SchemeGroupVersion.WithKind("ConfigMap"), meta.RESTScopeNamespace)
+	SetDynamicClient(clientset, fieldOwnershipServer{Interface: dynamicfake.NewSimpleDynamicClient(scheme), applies: &applies}, mapper)
+
+	parameters := map[string]interface{}{
+		"manifest":        "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: settings\ndata:\n  mode: storm\n",
+		"serverSideApply": true,
+		"fieldManager":    "pearl",
+	}
+	task := configuration.Task{Name: "apply", Type: "CrewApplyManifest", ShipsNamespace: "default", Parameters: parameters}
+	collector := &resultCollector{}
+	ctx := collector.context(context.Background())
+
+	if err := (&CrewApplyManifest{}).Run(ctx, clientset, "default", task, parameters, 0); err == nil {
+		t.Fatal("an unforced apply of fields owned by another manager succeeded")
+	}
+	if results := collector.all(); len(results) != 1 || !strings.Contains(results[0], "force") {
+		t.Fatalf("got results %q, want the conflict to suggest forcing", results)
+	}
+
+	parameters["force"] = true
+	if err := (&CrewApplyManifest{}).Run(ctx, clientset, "default", task, parameters, 0); err != nil {
+		t.Fatalf("forced apply: %v", err)
+	}
+	want := []v1.ApplyOptions{{FieldManager: "pearl"}, {FieldManager: "pearl", Force: true}}
+	if !reflect.DeepEqual(applies, want) {
+		t.Fatalf("got apply options %+v, want %+v", applies, want)
+	}
+}
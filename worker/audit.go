@@ -0,0 +1,219 @@
+package worker
+
+import (
+	"encoding/json"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/H0llyW00dzZ/K8sBlackPearl/language"
+	"github.com/H0llyW00dzZ/K8sBlackPearl/navigator"
+	"github.com/H0llyW00dzZ/K8sBlackPearl/worker/configuration"
+	"go.uber.org/zap"
+)
+
+// AuditRecord describes the terminal outcome of a single task execution.
+// Parameters are taken from the task configuration and redacted before being recorded,
+// so values resolved from Secrets never reach the audit log.
+type AuditRecord struct {
+	Timestamp   time.Time              `json:"timestamp"`
+	TaskName    string                 `json:"taskName"`
+	TaskType    string                 `json:"taskType"`
+	Namespace   string                 `json:"namespace"`
+	WorkerIndex int                    `json:"workerIndex"`
+	Outcome     string                 `json:"outcome"`
+	Attempts    int                    `json:"attempts"`
+	Error       string                 `json:"error,omitempty"`
+	Parameters  map[string]interface{} `json:"parameters,omitempty"`
+}
+
+// AuditSink receives an AuditRecord for every task that reaches a terminal outcome.
+// Implementations must be safe for concurrent use and must not block task execution.
+type AuditSink interface {
+	Record(record AuditRecord)
+}
+
+// auditSink is the package-level sink used by the workers; nil disables auditing.
+var (
+	auditSink   AuditSink
+	auditSinkMu sync.RWMutex
+)
+
+// SetAuditSink sets the sink that records the outcome of every task in a thread-safe manner.
+// Passing nil disables auditing, which is the default.
+func SetAuditSink(sink AuditSink) {
+	auditSinkMu.Lock()
+	auditSink = sink
+	auditSinkMu.Unlock()
+}
+
+// recordAudit builds an AuditRecord for the task outcome and hands it to the configured sink, if any.
+//
+// Parameters:
+//
+//	task configuration.Task: The task that reached a terminal outcome.
+//	shipsNamespace string: The namespace the task ran in.
+//	workerIndex int: The index of the worker that ran the task.
+//	attempts int: The number of attempts made.
+//	err error: The final error, or nil when the task succeeded.
+func recordAudit(task configuration.Task, shipsNamespace string, workerIndex int, attempts int, err error) {
+	auditSinkMu.RLock()
+	sink := auditSink
+	auditSinkMu.RUnlock()
+	if sink == nil {
+		return
+	}
+
+	record := AuditRecord{
+		Timestamp:   time.Now().UTC(),
+		TaskName:    task.Name,
+		TaskType:    task.Type,
+		Namespace:   shipsNamespace,
+		WorkerIndex: workerIndex,
+		Outcome:     taskOutcome(task, err),
+		Attempts:    attempts,
+		Parameters:  redactParameters(task.Parameters),
+	}
+	if err != nil {
+		record.Error = err.Error()
+	}
+	sink.Record(record)
+}
+
+// redactParameters returns a copy of the parameters in which secret references and values stored
+// under sensitive-looking keys (such as passwords or tokens) are replaced by a redaction marker.
+//
+// This unexported function is used internally by recordAudit.
+func redactParameters(parameters map[string]interface{}) map[string]interface{} {
+	if parameters == nil {
+		return nil
+	}
+	redacted := make(map[string]interface{}, len(parameters))
+	for key, value := range parameters {
+		redacted[key] = redactValue(key, value)
+	}
+	return redacted
+}
+
+// redactValue redacts a single parameter value, descending into nested maps and lists.
+//
+// This unexported function is used internally by redactParameters.
+func redactValue(key string, value interface{}) interface{} {
+	if isSensitiveKey(key) {
+		return language.RedactedValue
+	}
+	switch v := value.(type) {
+	case []interface{}:
+		list := make([]interface{}, len(v))
+		for i, item := range v {
+			list[i] = redactValue("", item)
+		}
+		return list
+	case map[string]interface{}, map[interface{}]interface{}:
+		entries, ok := toStringInterfaceMap(v)
+		if !ok {
+			return language.RedactedValue
+		}
+		if _, isRef := entries[secretReF]; isRef {
+			return language.RedactedValue
+		}
+		return redactParameters(entries)
+	default:
+		return value
+	}
+}
+
+// isSensitiveKey reports whether a parameter key suggests that its value is a credential.
+func isSensitiveKey(key string) bool {
+	lowered := strings.ToLower(key)
+	for _, marker := range sensitiveKeyMarkers {
+		if strings.Contains(lowered, marker) {
+			return true
+		}
+	}
+	return false
+}
+
+// JSONLFileAuditSink is an AuditSink that appends one JSON line per record to a file.
+// Records are queued in a buffered channel and written by a single background goroutine,
+// so concurrent workers never block on disk I/O. When the buffer is full, or the sink is
+// closed, the record is dropped and the drop is logged instead.
+type JSONLFileAuditSink struct {
+	file     *os.File
+	records  chan AuditRecord
+	done     chan struct{}
+	mu       sync.Mutex // Guards closed and the sends on records.
+	closed   bool
+	once     sync.Once
+	closeErr error
+}
+
+// NewJSONLFileAuditSink opens (or creates) the file at path in append mode and starts the
+// background writer.
+//
+// Parameters:
+//
+//	path string: The path of the JSON lines file to append to.
+//	bufferSize int: The number of records that may be queued before new records are dropped.
+//
+// Returns:
+//
+//	*JSONLFileAuditSink: A pointer to the running sink.
+//	error: An error if the file cannot be opened.
+func NewJSONLFileAuditSink(path string, bufferSize int) (*JSONLFileAuditSink, error) {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, err
+	}
+	if bufferSize <= 0 {
+		bufferSize = 1
+	}
+	sink := &JSONLFileAuditSink{
+		file:    file,
+		records: make(chan AuditRecord, bufferSize),
+		done:    make(chan struct{}),
+	}
+	go sink.writeLoop()
+	return sink, nil
+}
+
+// Record queues the record for writing without blocking. If the buffer is full, or the sink
+// is closed, the record is dropped and a rate-limited error is logged.
+func (s *JSONLFileAuditSink) Record(record AuditRecord) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !s.closed {
+		select {
+		case s.records <- record:
+			return
+		default:
+		}
+	}
+	navigator.LogErrorWithEmojiRateLimited(language.WarningEmoji, language.ErrorAuditRecordDropped, zap.String(language.Task_Name, record.TaskName))
+}
+
+// Close stops accepting records, waits until every queued record has been written, and closes the file.
+// It is safe to call more than once; every call returns the result of closing the file.
+func (s *JSONLFileAuditSink) Close() error {
+	s.once.Do(func() {
+		s.mu.Lock()
+		s.closed = true
+		close(s.records)
+		s.mu.Unlock()
+		<-s.done
+		s.closeErr = s.file.Close()
+	})
+	return s.closeErr
+}
+
+// writeLoop writes queued records to the file until the sink is closed.
+func (s *JSONLFileAuditSink) writeLoop() {
+	defer close(s.done)
+	encoder := json.NewEncoder(s.file)
+	for record := range s.records {
+		if err := encoder.Encode(record); err != nil {
+			navigator.LogErrorWithEmojiRateLimited(language.WarningEmoji, language.ErrorAuditWriteFailed, zap.Error(err))
+		}
+	}
+}
@@ -0,0 +1,93 @@
+package worker
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/H0llyW00dzZ/K8sBlackPearl/language"
+	"github.com/H0llyW00dzZ/K8sBlackPearl/worker/configuration"
+)
+
+// recordingAuditSink keeps every record it receives.
+type recordingAuditSink struct {
+	mu      sync.Mutex
+	records []AuditRecord
+}
+
+// Record keeps the record.
+func (s *recordingAuditSink) Record(record AuditRecord) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.records = append(s.records, record)
+}
+
+func TestRecordAuditClassifiesOutcomesLikeTheCycleTally(t *testing.T) {
+	sink := &recordingAuditSink{}
+	SetAuditSink(sink)
+	t.Cleanup(func() { SetAuditSink(nil) })
+
+	task := configuration.Task{Name: "deploy", Type: "CrewScaleDeployments"}
+	lenient := configuration.Task{Name: "deploy", Type: "CrewScaleDeployments", ContinueOnError: true}
+	interrupted := fmt.Errorf("stopped: %w", ErrRunDeadlineExceeded)
+	cases := []struct {
+		task configuration.Task
+		err  error
+	}{
+		{task, nil},
+		{task, errors.New("boom")},
+		{lenient, errors.New("boom")},
+		{lenient, interrupted},
+	}
+	for _, tc := range cases {
+		recordAudit(tc.task, "default", 0, 1, tc.err)
+	}
+
+	for i, tc := range cases {
+		if got, want := sink.records[i].Outcome, taskOutcome(tc.task, tc.err); got != want {
+			t.Fatalf("record %d: got outcome %q, want %q", i, got, want)
+		}
+	}
+	if got := sink.records[3].Outcome; got != language.OutcomeDeadlineExceeded {
+		t.Fatalf("a task interrupted by the run deadline was audited as %q", got)
+	}
+}
+
+func TestJSONLFileAuditSinkCloseIsIdempotent(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+	sink, err := NewJSONLFileAuditSink(path, 4)
+	if err != nil {
+		t.Fatalf("NewJSONLFileAuditSink: %v", err)
+	}
+
+	sink.Record(AuditRecord{TaskName: "deploy", Parameters: redactParameters(map[string]interface{}{"dbPassword": "hunter2"})})
+	if err := sink.Close(); err != nil {
+		t.Fatalf("first Close: %v", err)
+	}
+	if err := sink.Close(); err != nil {
+		t.Fatalf("second Close: %v", err)
+	}
+	// Recording after Close drops the record instead of panicking.
+	sink.Record(AuditRecord{TaskName: "late"})
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("read audit log: %v", err)
+	}
+	lines := strings.Split(strings.TrimSpace(string(content)), "\n")
+	if len(lines) != 1 {
+		t.Fatalf("got %d audit lines, want 1: %q", len(lines), content)
+	}
+	var record AuditRecord
+	if err := json.Unmarshal([]byte(lines[0]), &record); err != nil {
+		t.Fatalf("decode audit line: %v", err)
+	}
+	if record.TaskName != "deploy" || record.Parameters["dbPassword"] != language.RedactedValue {
+		t.Fatalf("unexpected audit record %+v", record)
+	}
+}
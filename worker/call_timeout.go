@@ -0,0 +1,75 @@
+package worker
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"time"
+
+	"k8s.io/client-go/rest"
+)
+
+// callTimeoutRoundTripper bounds every Kubernetes API request with its own deadline, so a hung API
+// server stalls a single call instead of the whole task. A timed-out call fails with a context
+// deadline error, which the retry policy treats as retriable like any other transient failure.
+// Streaming requests (watches and followed logs) are left unbounded.
+type callTimeoutRoundTripper struct {
+	next    http.RoundTripper
+	timeout time.Duration
+}
+
+// RoundTrip executes the request with a deadline derived from the request's own context.
+// The deadline is released when the response body is closed.
+func (t *callTimeoutRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	if isStreamingRequest(req) {
+		return t.next.RoundTrip(req)
+	}
+
+	ctx, cancel := context.WithTimeout(req.Context(), t.timeout)
+	resp, err := t.next.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	resp.Body = &cancelOnCloseBody{ReadCloser: resp.Body, cancel: cancel}
+	return resp, nil
+}
+
+// cancelOnCloseBody releases the per-call deadline once the caller has finished reading the response.
+type cancelOnCloseBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+// Close closes the underlying body and releases the per-call deadline.
+func (b *cancelOnCloseBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
+
+// isStreamingRequest reports whether the request opens a long-lived stream, such as a watch or a
+// followed log, which must not be cut off by the per-call timeout.
+func isStreamingRequest(req *http.Request) bool {
+	query := req.URL.Query()
+	return query.Get(watcH) == "true" || query.Get(watcH) == "1" || query.Get(followQuery) == "true"
+}
+
+// applyCallTimeout installs the per-call timeout on a client configuration. A zero timeout selects
+// defaultCallTimeout and a negative timeout disables the bound.
+//
+// Parameters:
+//
+//	config *rest.Config: The configuration to modify.
+//	timeout time.Duration: The maximum duration of a single API call.
+func applyCallTimeout(config *rest.Config, timeout time.Duration) {
+	if timeout == 0 {
+		timeout = defaultCallTimeout
+	}
+	if timeout < 0 {
+		return
+	}
+	config.Wrap(func(rt http.RoundTripper) http.RoundTripper {
+		return &callTimeoutRoundTripper{next: rt, timeout: timeout}
+	})
+}
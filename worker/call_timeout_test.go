@@ -0,0 +1,41 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+func TestCallTimeoutBoundsAHungAPIServer(t *testing.T) {
+	// The server never answers; it only lets go once the client gives up on the request.
+	server := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, req *http.Request) {
+		<-req.Context().Done()
+	}))
+	defer server.Close()
+
+	config := &rest.Config{Host: server.URL}
+	applyCallTimeout(config, 100*time.Millisecond)
+	clientset, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	start := time.Now()
+	_, err = clientset.AppsV1().Deployments("default").Get(context.Background(), "api", v1.GetOptions{})
+	if err == nil {
+		t.Fatal("the call to a hung API server succeeded")
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Fatalf("the call returned after %v, want it bounded by the 100ms call timeout", elapsed)
+	}
+	if !errors.Is(err, context.DeadlineExceeded) || isNonRetriable(err) {
+		t.Fatalf("the timed-out call %v is not a retriable deadline error", err)
+	}
+}
@@ -0,0 +1,40 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/H0llyW00dzZ/K8sBlackPearl/language"
+	"go.uber.org/zap"
+)
+
+// ErrShutdownRequested is the cause of the shared context once the shutdown function returned by
+// CaptainTellWorkers or CaptainTellWorkersPerNamespace is called, so that tasks stopped by it can be
+// told apart from tasks stopped by the run deadline or by the cancellation of the parent context.
+var ErrShutdownRequested = errors.New(language.ErrorShutdownRequested)
+
+// contextError returns the error of a done context, wrapping its cause when the cause says more
+// than the error itself, such as ErrShutdownRequested or ErrRunDeadlineExceeded. Both remain
+// reachable with errors.Is.
+//
+// This unexported function is used internally to report why an operation was cancelled.
+func contextError(ctx context.Context) error {
+	err := ctx.Err()
+	if err == nil {
+		return nil
+	}
+	if cause := context.Cause(ctx); cause != nil && cause != err {
+		return fmt.Errorf(language.ErrorContextCancelledWithCause, err, cause)
+	}
+	return err
+}
+
+// cancellationFields returns the structured log fields describing why a context was cancelled.
+//
+// This unexported function is used internally to enrich cancellation logs.
+func cancellationFields(ctx context.Context) []zap.Field {
+	return []zap.Field{
+		zap.NamedError(language.CancellationCause, context.Cause(ctx)),
+	}
+}
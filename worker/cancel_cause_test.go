@@ -0,0 +1,87 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/H0llyW00dzZ/K8sBlackPearl/worker/configuration"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestContextErrorWrapsTheCause(t *testing.T) {
+	if err := contextError(context.Background()); err != nil {
+		t.Fatalf("got %v for a live context, want nil", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := contextError(ctx); err != context.Canceled {
+		t.Fatalf("got %v for a plain cancellation, want context.Canceled as is", err)
+	}
+
+	ctx, cancelCause := context.WithCancelCause(context.Background())
+	cancelCause(ErrShutdownRequested)
+	err := contextError(ctx)
+	if !errors.Is(err, context.Canceled) || !errors.Is(err, ErrShutdownRequested) {
+		t.Fatalf("got %v, want both context.Canceled and the shutdown cause", err)
+	}
+}
+
+func TestInterruptedTasksReportTheCancellationCause(t *testing.T) {
+	started := make(chan struct{}, 1)
+	registerTestRunner(t, "TestUntilCancelled", func(ctx context.Context, _ kubernetes.Interface, _ string, _ configuration.Task, _ map[string]interface{}, _ int) error {
+		started <- struct{}{}
+		<-ctx.Done()
+		return ctx.Err()
+	})
+	tasks := []configuration.Task{{Name: "vigil", Type: "TestUntilCancelled", ShipsNamespace: "default", MaxRetries: 3, RetryDelay: "1ms"}}
+	operatorAbort := errors.New("operator abort")
+
+	for name, tc := range map[string]struct {
+		stop      func(shutdown func(), cancelParent context.CancelCauseFunc)
+		wantCause string
+	}{
+		"shutdown":         {func(shutdown func(), _ context.CancelCauseFunc) { shutdown() }, "shutdown requested"},
+		"parent cancelled": {func(_ func(), cancelParent context.CancelCauseFunc) { cancelParent(operatorAbort) }, "operator abort"},
+	} {
+		parent, cancelParent := context.WithCancelCause(context.Background())
+		results, shutdown := CaptainTellWorkers(parent, fake.NewSimpleClientset(), tasks, 1)
+		<-started
+		tc.stop(shutdown, cancelParent)
+
+		select {
+		case result := <-results:
+			if !strings.Contains(result, tc.wantCause) {
+				t.Fatalf("%s: got result %q, want it to name the cause %q", name, result, tc.wantCause)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("%s: the interrupted task was never reported", name)
+		}
+		shutdown()
+		cancelParent(nil)
+		for range results {
+		}
+	}
+}
+
+func TestCancellationLogsCarryTheCause(t *testing.T) {
+	logs := observeLogs(t)
+	ctx, cancel := context.WithCancelCause(context.Background())
+	cancel(ErrShutdownRequested)
+	results := make(chan string, 1)
+
+	CrewProcessPods(ctx, []corev1.Pod{{}}, results)
+
+	entries := logs.FilterFieldKey("cancellation_cause").All()
+	if len(entries) != 1 || entries[0].ContextMap()["cancellation_cause"] != "shutdown requested" {
+		t.Fatalf("got %d entries with a cancellation cause, want one naming the shutdown", len(entries))
+	}
+	if result := <-results; !strings.Contains(result, "shutdown requested") {
+		t.Fatalf("got result %q, want it to name the shutdown", result)
+	}
+}
@@ -3,8 +3,10 @@ package worker
 import (
 	"context"
 	"sync"
+	"time"
 
 	"github.com/H0llyW00dzZ/K8sBlackPearl/language"
+	"github.com/H0llyW00dzZ/K8sBlackPearl/navigator"
 	"github.com/H0llyW00dzZ/K8sBlackPearl/worker/configuration"
 	"go.uber.org/zap"
 	"k8s.io/client-go/kubernetes"
@@ -13,11 +15,14 @@ import (
 // CaptainTellWorkers launches worker goroutines to execute tasks within a Kubernetes namespace.
 // It returns a channel to receive task results and a function to initiate a graceful shutdown.
 // The shutdown function ensures all workers are stopped and the results channel is closed.
+// When SetMaxRunDuration bounds the run, the shutdown is also initiated once the deadline expires.
+// The cause of the cancellation, ErrShutdownRequested, ErrRunDeadlineExceeded, or the cause of the
+// parent context, is logged and reported with the tasks it interrupts.
 //
 // Parameters:
 //
 //	ctx context.Context: Parent context to control the lifecycle of the workers.
-//	clientset *kubernetes.Clientset: Kubernetes API client for task operations.
+//	clientset kubernetes.Interface: Kubernetes API client for task operations.
 //	tasks []configuration.Task: Slice of Task structs to be executed by the workers.
 //	workerCount int: Number of worker goroutines to start.
 //
@@ -25,27 +30,20 @@ import (
 //
 //	<-chan string: A read-only channel to receive task results.
 //	func()): A function to call for initiating a graceful shutdown of the workers.
-func CaptainTellWorkers(ctx context.Context, clientset *kubernetes.Clientset, tasks []configuration.Task, workerCount int) (<-chan string, func()) {
+func CaptainTellWorkers(ctx context.Context, clientset kubernetes.Interface, tasks []configuration.Task, workerCount int) (<-chan string, func()) {
 	results := make(chan string)
-	var wg sync.WaitGroup
-	var once sync.Once               // Use sync.Once to ensure shutdown is only called once
-	taskStatus := NewTaskStatusMap() // Tracks the claiming of tasks to avoid duplication.
+	var once sync.Once // Use sync.Once to ensure shutdown is only called once
 
-	shutdownCtx, cancelFunc := context.WithCancel(ctx) // Derived context to signal shutdown.
+	shutdownCtx, cancelFunc, runDeadline := newRunContext(ctx, tasks) // Derived context to signal shutdown.
 
-	for i := 0; i < workerCount; i++ {
-		wg.Add(1)
-		go func(workerIndex int) {
-			defer wg.Done()
-			workerLogger := zap.L().With(zap.Int(language.Worker_Name, workerIndex))
-			CrewWorker(shutdownCtx, clientset, tasks, results, workerLogger, taskStatus, workerIndex)
-		}(i)
-	}
+	logTaskSummary(tasks)
+
+	wg := startCrew(shutdownCtx, clientset, tasks, workerCount, 0, results, newRunClaimStore())
 
 	// shutdown is called to initiate a graceful shutdown of all workers.
 	shutdown := func() {
 		once.Do(func() { // Ensure this block only runs once
-			cancelFunc() // Signal workers to stop by cancelling the context.
+			cancelFunc(ErrShutdownRequested) // Signal workers to stop by cancelling the context.
 
 			// Ensure channel closure happens after all workers have finished.
 			go func() {
@@ -55,5 +53,63 @@ func CaptainTellWorkers(ctx context.Context, clientset *kubernetes.Clientset, ta
 		})
 	}
 
+	// Close the results channel on its own once the run deadline, if any, expires.
+	if runDeadline > 0 {
+		go watchRunDeadline(shutdownCtx, runDeadline, shutdown)
+	}
+
 	return results, shutdown
 }
+
+// newRunContext derives the shared context of a crew run from the parent context: it is bounded by
+// the run deadline, as described by withRunDeadline, and carries a secret cache and a task output
+// store shared by every task of the run, which tracks the completion of the given tasks.
+//
+// This unexported function is used internally by CaptainTellWorkers and CaptainTellWorkersPerNamespace.
+func newRunContext(ctx context.Context, tasks []configuration.Task) (context.Context, context.CancelCauseFunc, time.Duration) {
+	runCtx, cancel, runDeadline := withRunDeadline(ctx)
+	runCtx = WithSecretCache(runCtx, NewSecretCache())                 // Share secret reads across the run.
+	runCtx = WithTaskOutputStore(runCtx, newRunTaskOutputStore(tasks)) // Share task outputs across the run.
+	return runCtx, cancel, runDeadline
+}
+
+// startCrew starts workerCount workers that process the tasks, claiming them through the given store
+// so that each task runs once, and returns a WaitGroup that is done once every worker has returned.
+// The workers are numbered from firstWorkerIndex, and the given fields are added to their loggers.
+//
+// This unexported function is used internally by CaptainTellWorkers, CaptainTellWorkersPerNamespace,
+// and RunContinuous.
+func startCrew(ctx context.Context, clientset kubernetes.Interface, tasks []configuration.Task, workerCount, firstWorkerIndex int, results chan<- string, claims ClaimStore, fields ...zap.Field) *sync.WaitGroup {
+	var wg sync.WaitGroup
+	for i := firstWorkerIndex; i < firstWorkerIndex+workerCount; i++ {
+		wg.Add(1)
+		go func(workerIndex int) {
+			defer wg.Done()
+			// Last line of defense: a panic outside a runner must not crash the process.
+			defer func() {
+				if r := recover(); r != nil {
+					_ = recoveredPanicError("", workerIndex, r)
+				}
+			}()
+			workerLogger := zap.L().With(zap.Int(language.Worker_Name, workerIndex))
+			workerLogger = workerLogger.With(fields...).With(requestMetadataFields(ctx)...)
+			CrewWorker(ctx, clientset, tasks, results, workerLogger, claims, workerIndex)
+		}(i)
+	}
+	return &wg
+}
+
+// logTaskSummary logs a sanity summary of the loaded tasks before the workers start: counts by type
+// and namespace, the number of tasks missing retry settings, and any validation concerns.
+//
+// This unexported function is used internally by CaptainTellWorkers.
+func logTaskSummary(tasks []configuration.Task) {
+	summary := configuration.NewTaskSummary(tasks)
+	navigator.LogInfoWithEmoji(language.PirateEmoji, summary.String(),
+		zap.Int(language.TotalTasks, summary.Total),
+		zap.Any(language.TasksByType, summary.ByType),
+		zap.Any(language.TasksByNamespace, summary.ByNamespace),
+		zap.Int(language.MissingRetrySettings, summary.MissingRetrySettings),
+		zap.Strings(language.Concerns, summary.Concerns),
+	)
+}
@@ -0,0 +1,121 @@
+package worker
+
+import (
+	"context"
+	"sync"
+
+	"github.com/H0llyW00dzZ/K8sBlackPearl/language"
+	"github.com/H0llyW00dzZ/K8sBlackPearl/worker/configuration"
+	"go.uber.org/zap"
+	"k8s.io/client-go/kubernetes"
+)
+
+// namespaceTasks is the group of tasks that target a single namespace.
+type namespaceTasks struct {
+	namespace string
+	tasks     []configuration.Task
+}
+
+// CaptainTellWorkersPerNamespace launches a separate pool of workers for each namespace targeted by
+// the tasks, so a flood of slow or failing tasks in one namespace cannot starve the others. Each pool
+// only runs the tasks of its namespace, and all pools report through a single merged results channel.
+// As with CaptainTellWorkers, every task of the run shares a secret cache and a task output store.
+// The shutdown function cancels every pool and closes the results channel exactly once, after all
+// workers have returned, and is also initiated once the deadline set by SetMaxRunDuration expires.
+//
+// When the number of tasks executing at once is capped, each pool may hold at most its share of the
+// cap, the cap divided by the number of namespaces and at least one slot, so the slots a pool cannot
+// take stay free for the others. With more namespaces than slots, every pool gets one slot and the
+// pools take turns on the slots of the cap.
+//
+// Parameters:
+//
+//	ctx context.Context: Parent context to control the lifecycle of the workers.
+//	clientset kubernetes.Interface: Kubernetes API client for task operations.
+//	tasks []configuration.Task: Slice of Task structs to be executed by the workers.
+//	workersPerNamespace int: Number of worker goroutines per namespace; a namespace never gets more
+//	workers than it has tasks.
+//	maxConcurrentTasks int: Global cap on the number of tasks executing at once across all pools, which
+//	replaces the limit set with SetMaxConcurrentTasks for this run, or zero to keep that limit.
+//
+// Returns:
+//
+//	<-chan string: A read-only channel to receive task results from every pool.
+//	func(): A function to call for initiating a graceful shutdown of all pools.
+func CaptainTellWorkersPerNamespace(ctx context.Context, clientset kubernetes.Interface, tasks []configuration.Task, workersPerNamespace, maxConcurrentTasks int) (<-chan string, func()) {
+	results := make(chan string)
+	var once sync.Once
+	claims := newRunClaimStore()
+
+	shutdownCtx, cancelFunc, runDeadline := newRunContext(ctx, tasks)
+	shutdownCtx = withTaskSlots(shutdownCtx, maxConcurrentTasks)
+
+	logTaskSummary(tasks)
+
+	groups := groupTasksByNamespace(tasks)
+	poolTaskSlots := poolTaskSlotShare(maxConcurrentTasks, len(groups))
+	var crews []*sync.WaitGroup
+	workerIndex := 0
+	for _, group := range groups {
+		poolSize := min(workersPerNamespace, len(group.tasks))
+		poolCtx := withPoolTaskSlots(shutdownCtx, poolTaskSlots)
+		crews = append(crews, startCrew(poolCtx, clientset, group.tasks, poolSize, workerIndex, results, claims,
+			zap.String(language.Ships_Namespace, group.namespace)))
+		workerIndex += poolSize
+	}
+
+	// shutdown is called to initiate a graceful shutdown of all pools.
+	shutdown := func() {
+		once.Do(func() {
+			cancelFunc(ErrShutdownRequested)
+
+			go func() {
+				for _, crew := range crews {
+					crew.Wait()
+				}
+				close(results)
+			}()
+		})
+	}
+
+	if runDeadline > 0 {
+		go watchRunDeadline(shutdownCtx, runDeadline, shutdown)
+	}
+
+	return results, shutdown
+}
+
+// poolTaskSlotShare returns the number of task slots each of the pools may hold: the cap of the run,
+// or the limit set with SetMaxConcurrentTasks when the run has none, divided by the number of pools
+// and at least one. It returns zero when the number of tasks executing at once is not capped.
+//
+// This unexported function is used internally by CaptainTellWorkersPerNamespace.
+func poolTaskSlotShare(runLimit, pools int) int {
+	limit := runLimit
+	if limit <= 0 {
+		limit = maxConcurrentTasksLimit()
+	}
+	if limit <= 0 || pools == 0 {
+		return 0
+	}
+	return max(1, limit/pools)
+}
+
+// groupTasksByNamespace groups the tasks by their ShipsNamespace, keeping the order in which each
+// namespace first appears and the order of the tasks within a namespace.
+//
+// This unexported function is used internally by CaptainTellWorkersPerNamespace.
+func groupTasksByNamespace(tasks []configuration.Task) []namespaceTasks {
+	var groups []namespaceTasks
+	index := make(map[string]int)
+	for _, task := range tasks {
+		i, exists := index[task.ShipsNamespace]
+		if !exists {
+			i = len(groups)
+			index[task.ShipsNamespace] = i
+			groups = append(groups, namespaceTasks{namespace: task.ShipsNamespace})
+		}
+		groups[i].tasks = append(groups[i].tasks, task)
+	}
+	return groups
+}
@@ -0,0 +1,145 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/H0llyW00dzZ/K8sBlackPearl/worker/configuration"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+// collectPerNamespaceRun drains the results of a per-namespace run until every task has reported, then shuts it down.
+func collectPerNamespaceRun(t *testing.T, results <-chan string, shutdown func(), taskCount int) []string {
+	t.Helper()
+	var collected []string
+	timeout := time.After(10 * time.Second)
+	for len(collected) < taskCount {
+		select {
+		case result := <-results:
+			collected = append(collected, result)
+		case <-timeout:
+			shutdown()
+			t.Fatalf("got %d results, want %d: %v", len(collected), taskCount, collected)
+		}
+	}
+	shutdown()
+	for range results {
+	}
+	return collected
+}
+
+func TestCaptainTellWorkersPerNamespaceSharesTaskOutputs(t *testing.T) {
+	registerTestRunner(t, "TestProduceOutput", func(ctx context.Context, _ kubernetes.Interface, _ string, _ configuration.Task, _ map[string]interface{}, _ int) error {
+		PublishTaskOutput(ctx, "name", "pearl")
+		return nil
+	})
+	consumed := make(chan interface{}, 1)
+	registerTestRunner(t, "TestConsumeOutput", func(_ context.Context, _ kubernetes.Interface, _ string, _ configuration.Task, parameters map[string]interface{}, _ int) error {
+		consumed <- parameters["name"]
+		return nil
+	})
+
+	tasks := []configuration.Task{
+		{Name: "produce", Type: "TestProduceOutput", ShipsNamespace: "default", MaxRetries: 1, RetryDelay: "1ms"},
+		{Name: "consume", Type: "TestConsumeOutput", ShipsNamespace: "default", MaxRetries: 1, RetryDelay: "1ms",
+			Parameters: map[string]interface{}{"name": "${tasks.produce.output.name}"}},
+	}
+	// A single worker runs the tasks of the namespace in order, so the producer always runs first.
+	results, shutdown := CaptainTellWorkersPerNamespace(context.Background(), fake.NewSimpleClientset(), tasks, 1, 0)
+	collectPerNamespaceRun(t, results, shutdown, len(tasks))
+
+	select {
+	case got := <-consumed:
+		if got != "pearl" {
+			t.Fatalf("consumer got name %v, want the output of the producer", got)
+		}
+	default:
+		t.Fatal("consumer did not run")
+	}
+}
+
+func TestCaptainTellWorkersPerNamespaceCapsConcurrentTasks(t *testing.T) {
+	var tracker inFlightTracker
+	registerTestRunner(t, "TestTrackConcurrency", func(_ context.Context, _ kubernetes.Interface, _ string, _ configuration.Task, _ map[string]interface{}, _ int) error {
+		defer tracker.enter()()
+		time.Sleep(10 * time.Millisecond)
+		return nil
+	})
+
+	var tasks []configuration.Task
+	for ns := 0; ns < 4; ns++ {
+		for i := 0; i < 3; i++ {
+			tasks = append(tasks, configuration.Task{
+				Name:           fmt.Sprintf("task-%d-%d", ns, i),
+				Type:           "TestTrackConcurrency",
+				ShipsNamespace: fmt.Sprintf("ns-%d", ns),
+				MaxRetries:     1,
+				RetryDelay:     "1ms",
+			})
+		}
+	}
+	results, shutdown := CaptainTellWorkersPerNamespace(context.Background(), fake.NewSimpleClientset(), tasks, 3, 2)
+	collectPerNamespaceRun(t, results, shutdown, len(tasks))
+
+	if peak := tracker.peak.Load(); peak > 2 {
+		t.Fatalf("%d tasks ran at once, want at most 2", peak)
+	}
+}
+
+func TestCaptainTellWorkersPerNamespaceKeepsSlotsForEveryNamespace(t *testing.T) {
+	release := make(chan struct{})
+	registerTestRunner(t, "TestBlockUntilReleased", func(ctx context.Context, _ kubernetes.Interface, _ string, _ configuration.Task, _ map[string]interface{}, _ int) error {
+		select {
+		case <-release:
+		case <-ctx.Done():
+		}
+		return nil
+	})
+	finished := make(chan struct{}, 2)
+	registerTestRunner(t, "TestFinishRightAway", func(_ context.Context, _ kubernetes.Interface, _ string, _ configuration.Task, _ map[string]interface{}, _ int) error {
+		finished <- struct{}{}
+		return nil
+	})
+
+	tasks := []configuration.Task{
+		{Name: "stuck-1", Type: "TestBlockUntilReleased", ShipsNamespace: "stuck", MaxRetries: 1},
+		{Name: "stuck-2", Type: "TestBlockUntilReleased", ShipsNamespace: "stuck", MaxRetries: 1},
+		{Name: "free-1", Type: "TestFinishRightAway", ShipsNamespace: "free", MaxRetries: 1},
+		{Name: "free-2", Type: "TestFinishRightAway", ShipsNamespace: "free", MaxRetries: 1},
+	}
+	// With a cap of two, the stuck namespace may hold one slot, so the other stays free for its neighbour.
+	results, shutdown := CaptainTellWorkersPerNamespace(context.Background(), fake.NewSimpleClientset(), tasks, 2, 2)
+	go func() {
+		for i := 0; i < 2; i++ {
+			<-finished
+		}
+		close(release)
+	}()
+	collectPerNamespaceRun(t, results, shutdown, len(tasks))
+}
+
+func TestPoolTaskSlotShare(t *testing.T) {
+	SetMaxConcurrentTasks(6)
+	t.Cleanup(func() { SetMaxConcurrentTasks(0) })
+
+	for name, tc := range map[string]struct {
+		runLimit, pools, want int
+	}{
+		"the cap is divided among the pools": {runLimit: 4, pools: 2, want: 2},
+		"every pool gets at least one slot":  {runLimit: 2, pools: 4, want: 1},
+		"the package-level limit is divided": {runLimit: 0, pools: 3, want: 2},
+		"no pools hold no slots":             {runLimit: 4, pools: 0, want: 0},
+	} {
+		if got := poolTaskSlotShare(tc.runLimit, tc.pools); got != tc.want {
+			t.Fatalf("%s: got %d slots per pool, want %d", name, got, tc.want)
+		}
+	}
+
+	SetMaxConcurrentTasks(0)
+	if got := poolTaskSlotShare(0, 3); got != 0 {
+		t.Fatalf("got %d slots per pool without a cap, want 0", got)
+	}
+}
@@ -0,0 +1,312 @@
+package worker
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"sync"
+	"time"
+
+	"github.com/H0llyW00dzZ/K8sBlackPearl/language"
+	"github.com/H0llyW00dzZ/K8sBlackPearl/navigator"
+	"go.uber.org/zap"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/client-go/kubernetes"
+)
+
+// ClaimStore coordinates which worker executes a task, so that a task is only run once even when
+// several workers, or several replicas of a controller, process the same task list. Implementations
+// must be safe for concurrent use, and bound their calls by the given context. TaskStatusMap is the
+// in-memory implementation.
+type ClaimStore interface {
+	// Claim marks the task as claimed and reports whether the caller obtained the claim.
+	Claim(ctx context.Context, taskName string) bool
+	// Release gives up the claim on the task, making it available to be claimed again.
+	Release(ctx context.Context, taskName string)
+	// IsClaimed reports whether the task is currently claimed.
+	IsClaimed(ctx context.Context, taskName string) bool
+}
+
+// ClaimRenewer is implemented by claim stores whose claims expire on their own, such as
+// LeaseClaimStore. processTask keeps the claim of a task alive through it for as long as the task
+// runs, so a task running longer than a claim lasts is not claimed by another replica meanwhile.
+type ClaimRenewer interface {
+	// KeepClaimed renews the claim on the task in the background until the returned function is
+	// called or the context is done. The returned function waits for the renewal to stop, and may
+	// be called more than once.
+	KeepClaimed(ctx context.Context, taskName string) (stop func())
+}
+
+// TaskStatusMap is the default, in-memory ClaimStore, and LeaseClaimStore renews the claims it holds.
+var (
+	_ ClaimStore   = (*TaskStatusMap)(nil)
+	_ ClaimRenewer = (*LeaseClaimStore)(nil)
+)
+
+// claimStore is the package-level store shared by every run; nil means each run tracks its claims
+// in a TaskStatusMap of its own.
+var (
+	claimStore   ClaimStore
+	claimStoreMu sync.RWMutex
+)
+
+// SetClaimStore sets the store through which runs started afterwards claim their tasks, in a
+// thread-safe manner. By default each run claims its tasks in a fresh TaskStatusMap, which only
+// coordinates the workers of that run; use a distributed store such as LeaseClaimStore so that
+// several controller replicas running the same tasks coordinate. Passing nil restores the default.
+func SetClaimStore(store ClaimStore) {
+	claimStoreMu.Lock()
+	claimStore = store
+	claimStoreMu.Unlock()
+}
+
+// keepClaimed renews the claim on the task while it runs when the store supports it, and returns the
+// function that stops the renewal.
+//
+// This unexported function is used internally by processTask.
+func keepClaimed(ctx context.Context, claims ClaimStore, taskName string) func() {
+	if renewer, ok := claims.(ClaimRenewer); ok {
+		return renewer.KeepClaimed(ctx, taskName)
+	}
+	return func() {}
+}
+
+// newRunClaimStore returns the configured claim store, or a fresh TaskStatusMap when none is set.
+//
+// This unexported function is used internally by CaptainTellWorkers, CaptainTellWorkersPerNamespace,
+// and RunContinuous.
+func newRunClaimStore() ClaimStore {
+	claimStoreMu.RLock()
+	defer claimStoreMu.RUnlock()
+	if claimStore != nil {
+		return claimStore
+	}
+	return NewTaskStatusMap()
+}
+
+// LeaseClaimStore is a distributed ClaimStore that claims each task by holding a coordination/v1
+// Lease named after it, so that several controller replicas sharing the same store namespace never
+// execute a task at the same time. Exactly one of the claimants creating or taking over a Lease
+// succeeds, which also coordinates the workers within a replica. The Lease of a running task is
+// renewed every third of the lease duration, so a task may run for longer than a claim lasts. Once
+// the task is done, the claim lasts until it is released after a failure, or until its Lease
+// expires, so that the tasks of a replica that stopped are picked up again; successful tasks keep
+// their claim until then. When a task list is run repeatedly,
+// the lease duration should therefore be shorter than the interval between runs. API errors are
+// logged and treated as a lost claim, so a task is never run without coordination.
+type LeaseClaimStore struct {
+	clientset     kubernetes.Interface
+	namespace     string
+	identity      string
+	leaseDuration time.Duration
+}
+
+// NewLeaseClaimStore creates a LeaseClaimStore that keeps its Leases in the given namespace.
+//
+// Parameters:
+//
+//	clientset kubernetes.Interface: A Kubernetes clientset for interacting with the Kubernetes API.
+//	namespace string: The namespace of the Leases.
+//	identity string: A name unique to this replica, such as its pod name, recorded as the holder.
+//	leaseDuration time.Duration: How long a claim lasts unless it is released or renewed; zero or less
+//	selects defaultClaimLeaseDuration. Leases count whole seconds, so the duration is rounded up to
+//	the next second.
+func NewLeaseClaimStore(clientset kubernetes.Interface, namespace, identity string, leaseDuration time.Duration) *LeaseClaimStore {
+	if leaseDuration <= 0 {
+		leaseDuration = defaultClaimLeaseDuration
+	}
+	// Truncating would turn a sub-second duration into a Lease that expires as soon as it is created.
+	if remainder := leaseDuration % time.Second; remainder != 0 {
+		leaseDuration += time.Second - remainder
+	}
+	return &LeaseClaimStore{clientset: clientset, namespace: namespace, identity: identity, leaseDuration: leaseDuration}
+}
+
+// Claim creates the task's Lease, or takes over a Lease that was released or has expired, and
+// reports whether this replica now holds it. A Lease held by anyone, this replica included, is not
+// claimed again.
+func (s *LeaseClaimStore) Claim(ctx context.Context, taskName string) bool {
+	ctx, cancel := context.WithTimeout(ctx, claimCallTimeout)
+	defer cancel()
+
+	leases := s.clientset.CoordinationV1().Leases(s.namespace)
+	now := v1.NewMicroTime(time.Now())
+	durationSeconds := int32(s.leaseDuration / time.Second)
+	lease, err := leases.Get(ctx, claimLeaseName(taskName), v1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		lease = &coordinationv1.Lease{
+			ObjectMeta: v1.ObjectMeta{Name: claimLeaseName(taskName)},
+			Spec: coordinationv1.LeaseSpec{
+				HolderIdentity:       &s.identity,
+				LeaseDurationSeconds: &durationSeconds,
+				AcquireTime:          &now,
+				RenewTime:            &now,
+			},
+		}
+		_, err = leases.Create(ctx, lease, v1.CreateOptions{})
+		return s.claimed(taskName, err)
+	}
+	if err != nil {
+		return s.claimed(taskName, err)
+	}
+	if leaseHeld(lease, now.Time) {
+		return false
+	}
+
+	lease.Spec.HolderIdentity = &s.identity
+	lease.Spec.LeaseDurationSeconds = &durationSeconds
+	lease.Spec.AcquireTime = &now
+	lease.Spec.RenewTime = &now
+	// The update carries the resource version that was read, so only one claimant can take over.
+	_, err = leases.Update(ctx, lease, v1.UpdateOptions{})
+	return s.claimed(taskName, err)
+}
+
+// Release clears the holder of the task's Lease, if this replica holds it.
+func (s *LeaseClaimStore) Release(ctx context.Context, taskName string) {
+	ctx, cancel := context.WithTimeout(ctx, claimCallTimeout)
+	defer cancel()
+
+	leases := s.clientset.CoordinationV1().Leases(s.namespace)
+	lease, err := leases.Get(ctx, claimLeaseName(taskName), v1.GetOptions{})
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			logClaimStoreError(taskName, err)
+		}
+		return
+	}
+	if lease.Spec.HolderIdentity == nil || *lease.Spec.HolderIdentity != s.identity {
+		return
+	}
+	lease.Spec.HolderIdentity = nil
+	if _, err := leases.Update(ctx, lease, v1.UpdateOptions{}); err != nil {
+		logClaimStoreError(taskName, err)
+	}
+}
+
+// KeepClaimed renews the task's Lease every third of the lease duration, for as long as this replica
+// holds it, until the returned function is called or the context is done.
+func (s *LeaseClaimStore) KeepClaimed(ctx context.Context, taskName string) func() {
+	stop := make(chan struct{})
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		ticker := time.NewTicker(s.leaseDuration / 3)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-stop:
+				return
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if !s.renew(ctx, taskName) {
+					return
+				}
+			}
+		}
+	}()
+	var once sync.Once
+	return func() {
+		once.Do(func() { close(stop) })
+		<-done
+	}
+}
+
+// renew moves the renew time of the task's Lease to now, and reports whether this replica still
+// holds it. Errors are logged and end the renewal.
+//
+// This unexported method is used internally by KeepClaimed.
+func (s *LeaseClaimStore) renew(ctx context.Context, taskName string) bool {
+	ctx, cancel := context.WithTimeout(ctx, claimCallTimeout)
+	defer cancel()
+
+	leases := s.clientset.CoordinationV1().Leases(s.namespace)
+	lease, err := leases.Get(ctx, claimLeaseName(taskName), v1.GetOptions{})
+	if err != nil {
+		logClaimStoreError(taskName, err)
+		return false
+	}
+	if lease.Spec.HolderIdentity == nil || *lease.Spec.HolderIdentity != s.identity {
+		return false
+	}
+	now := v1.NewMicroTime(time.Now())
+	lease.Spec.RenewTime = &now
+	if _, err := leases.Update(ctx, lease, v1.UpdateOptions{}); err != nil {
+		logClaimStoreError(taskName, err)
+		return false
+	}
+	return true
+}
+
+// IsClaimed reports whether the task's Lease is held and has not expired. Errors are logged and
+// reported as claimed.
+func (s *LeaseClaimStore) IsClaimed(ctx context.Context, taskName string) bool {
+	ctx, cancel := context.WithTimeout(ctx, claimCallTimeout)
+	defer cancel()
+
+	lease, err := s.clientset.CoordinationV1().Leases(s.namespace).Get(ctx, claimLeaseName(taskName), v1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return false
+	}
+	if err != nil {
+		logClaimStoreError(taskName, err)
+		return true
+	}
+	return leaseHeld(lease, time.Now())
+}
+
+// claimed reports whether a claim attempt succeeded. Losing a race to another claimant is expected
+// and silent; any other error is logged.
+//
+// This unexported method is used internally by Claim.
+func (s *LeaseClaimStore) claimed(taskName string, err error) bool {
+	if err == nil {
+		return true
+	}
+	if !apierrors.IsAlreadyExists(err) && !apierrors.IsConflict(err) {
+		logClaimStoreError(taskName, err)
+	}
+	return false
+}
+
+// leaseHeld reports whether the Lease has a holder whose claim has not expired at the given time.
+//
+// This unexported function is used internally by LeaseClaimStore.
+func leaseHeld(lease *coordinationv1.Lease, now time.Time) bool {
+	spec := lease.Spec
+	if spec.HolderIdentity == nil || *spec.HolderIdentity == "" {
+		return false
+	}
+	if spec.RenewTime == nil || spec.LeaseDurationSeconds == nil {
+		return true
+	}
+	expiry := spec.RenewTime.Add(time.Duration(*spec.LeaseDurationSeconds) * time.Second)
+	return now.Before(expiry)
+}
+
+// claimLeaseName returns the name of the Lease that claims a task: the task name with a fixed
+// prefix when that is a valid object name, or its SHA-256 digest otherwise.
+//
+// This unexported function is used internally by LeaseClaimStore.
+func claimLeaseName(taskName string) string {
+	name := claimLeasePrefix + taskName
+	if len(validation.IsDNS1123Subdomain(name)) == 0 {
+		return name
+	}
+	digest := sha256.Sum256([]byte(taskName))
+	return claimLeasePrefix + "sha256-" + hex.EncodeToString(digest[:])
+}
+
+// logClaimStoreError logs a failed claim store operation.
+//
+// This unexported function is used internally by LeaseClaimStore.
+func logClaimStoreError(taskName string, err error) {
+	navigator.LogErrorWithEmojiRateLimited(language.WarningEmoji, language.ErrorClaimStore,
+		zap.String(language.Task_Name, taskName),
+		zap.Error(err),
+	)
+}
@@ -0,0 +1,101 @@
+package worker
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestLeaseClaimStoreCoordinatesReplicas(t *testing.T) {
+	ctx := context.Background()
+	clientset := fake.NewSimpleClientset()
+	first := NewLeaseClaimStore(clientset, "default", "replica-a", time.Minute)
+	second := NewLeaseClaimStore(clientset, "default", "replica-b", time.Minute)
+
+	if !first.Claim(ctx, "deploy") {
+		t.Fatal("the first replica did not claim a free task")
+	}
+	if second.Claim(ctx, "deploy") || first.Claim(ctx, "deploy") {
+		t.Fatal("a held task was claimed again")
+	}
+	if !second.IsClaimed(ctx, "deploy") {
+		t.Fatal("the held task is not reported as claimed")
+	}
+
+	second.Release(ctx, "deploy")
+	if !first.IsClaimed(ctx, "deploy") {
+		t.Fatal("a replica released a claim it does not hold")
+	}
+	first.Release(ctx, "deploy")
+	if first.IsClaimed(ctx, "deploy") {
+		t.Fatal("the released task is still reported as claimed")
+	}
+	if !second.Claim(ctx, "deploy") {
+		t.Fatal("the released task could not be claimed by another replica")
+	}
+}
+
+func TestNewLeaseClaimStoreRoundsUpToWholeSeconds(t *testing.T) {
+	for _, tc := range []struct {
+		duration time.Duration
+		want     int32
+	}{
+		{500 * time.Millisecond, 1},
+		{1500 * time.Millisecond, 2},
+		{2 * time.Second, 2},
+	} {
+		clientset := fake.NewSimpleClientset()
+		store := NewLeaseClaimStore(clientset, "default", "replica-a", tc.duration)
+		if !store.Claim(context.Background(), "deploy") {
+			t.Fatalf("%v: the task was not claimed", tc.duration)
+		}
+		lease, err := clientset.CoordinationV1().Leases("default").Get(context.Background(), claimLeaseName("deploy"), v1.GetOptions{})
+		if err != nil {
+			t.Fatalf("%v: get lease: %v", tc.duration, err)
+		}
+		if got := *lease.Spec.LeaseDurationSeconds; got != tc.want {
+			t.Fatalf("%v: lease lasts %ds, want %ds", tc.duration, got, tc.want)
+		}
+	}
+}
+
+func TestLeaseClaimStoreKeepClaimedRenewsTheLease(t *testing.T) {
+	ctx := context.Background()
+	clientset := fake.NewSimpleClientset()
+	store := NewLeaseClaimStore(clientset, "default", "replica-a", time.Second)
+	if !store.Claim(ctx, "deploy") {
+		t.Fatal("the task was not claimed")
+	}
+	leases := clientset.CoordinationV1().Leases("default")
+	claimed, err := leases.Get(ctx, claimLeaseName("deploy"), v1.GetOptions{})
+	if err != nil {
+		t.Fatalf("get lease: %v", err)
+	}
+
+	stop := store.KeepClaimed(ctx, "deploy")
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		lease, err := leases.Get(ctx, claimLeaseName("deploy"), v1.GetOptions{})
+		if err != nil {
+			t.Fatalf("get lease: %v", err)
+		}
+		if lease.Spec.RenewTime.After(claimed.Spec.RenewTime.Time) {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("the lease was not renewed while the task was running")
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+	stop()
+	stop()
+
+	// Once renewal stops, the one-second lease expires and another replica can take the task over.
+	time.Sleep(1100 * time.Millisecond)
+	if !NewLeaseClaimStore(clientset, "default", "replica-b", time.Second).Claim(ctx, "deploy") {
+		t.Fatal("the lease was still held after renewal stopped")
+	}
+}
@@ -0,0 +1,167 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/H0llyW00dzZ/K8sBlackPearl/language"
+	"github.com/H0llyW00dzZ/K8sBlackPearl/navigator"
+	"github.com/H0llyW00dzZ/go-urlshortner/logmonitor/constant"
+	"go.uber.org/zap"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// jobCleanupCriteria selects the finished Jobs to delete.
+type jobCleanupCriteria struct {
+	labelSelector string
+	olderThan     time.Duration
+	status        batchv1.JobConditionType // Empty matches both completed and failed Jobs.
+}
+
+// CleanupJobs deletes the finished Jobs in a namespace that match the given criteria. A Job matches
+// when it has finished with the requested status (Complete or Failed, or either when no status is set)
+// at least olderThan ago. Jobs are deleted with Background propagation so their pods are removed by
+// the garbage collector. The context is checked before every deletion, and a single summary naming
+// the deleted Jobs is reported through the results channel.
+//
+// Parameters:
+//
+//	ctx context.Context: Context for cancellation and timeout.
+//	clientset kubernetes.Interface: A Kubernetes clientset for interacting with the Kubernetes API.
+//	namespace string: The namespace whose Jobs are cleaned up.
+//	criteria jobCleanupCriteria: The label selector, minimum age, and status of the Jobs to delete.
+//	results chan<- string: A channel to send operation results for logging.
+//	logger *zap.Logger: A logger for structured logging.
+//
+// Returns an error if the Jobs cannot be listed, if any deletion fails, or if the context is cancelled.
+func CleanupJobs(ctx context.Context, clientset kubernetes.Interface, namespace string, criteria jobCleanupCriteria, results chan<- string, logger *zap.Logger) error {
+	jobs, err := listFinishedJobs(ctx, clientset, namespace, criteria)
+	if err != nil {
+		return reportCleanupJobsFailure(results, nil, fmt.Errorf(language.ErrorListingJobs, err))
+	}
+
+	propagation := v1.DeletePropagationBackground
+	var deleted, failed []string
+	for _, jobName := range jobs {
+		if err := ctx.Err(); err != nil {
+			return reportCleanupJobsFailure(results, deleted, err)
+		}
+		err := clientset.BatchV1().Jobs(namespace).Delete(ctx, jobName, v1.DeleteOptions{PropagationPolicy: &propagation})
+		if err != nil && !apierrors.IsNotFound(err) {
+			navigator.LogErrorWithEmojiRateLimited(constant.ErrorEmoji, fmt.Sprintf(language.ErrorFailedToDeleteJob, jobName, err), zap.Error(err))
+			failed = append(failed, jobName)
+			continue
+		}
+		deleted = append(deleted, jobName)
+	}
+
+	if len(failed) > 0 {
+		return reportCleanupJobsFailure(results, deleted, fmt.Errorf(language.ErrorDeletingJobs, len(failed), strings.Join(failed, ", ")))
+	}
+
+	successMsg := fmt.Sprintf(language.JobsCleanedUp, len(deleted), namespace, strings.Join(deleted, ", "))
+	results <- successMsg
+	navigator.LogInfoWithEmoji(constant.SuccessEmoji, successMsg)
+	return nil
+}
+
+// listFinishedJobs lists every page of Jobs matching the label selector and returns the names of
+// those that satisfy the status and age criteria.
+//
+// This unexported function is used internally by CleanupJobs.
+func listFinishedJobs(ctx context.Context, clientset kubernetes.Interface, namespace string, criteria jobCleanupCriteria) ([]string, error) {
+	listOptions := v1.ListOptions{LabelSelector: criteria.labelSelector, Limit: defaultPageSize}
+	cutoff := time.Now().Add(-criteria.olderThan)
+
+	var names []string
+	for {
+		page, err := clientset.BatchV1().Jobs(namespace).List(ctx, listOptions)
+		if err != nil {
+			return nil, err
+		}
+		for i := range page.Items {
+			finishedAt, ok := jobFinishedAt(&page.Items[i], criteria.status)
+			if ok && !finishedAt.After(cutoff) {
+				names = append(names, page.Items[i].Name)
+			}
+		}
+		if page.Continue == "" {
+			return names, nil
+		}
+		listOptions.Continue = page.Continue
+	}
+}
+
+// jobFinishedAt returns when the Job finished with the given status, taken from the transition time
+// of its Complete or Failed condition. An empty status matches either condition. It returns false if
+// the Job has not finished with a matching status.
+//
+// This unexported function is used internally by listFinishedJobs.
+func jobFinishedAt(job *batchv1.Job, status batchv1.JobConditionType) (time.Time, bool) {
+	for _, condition := range job.Status.Conditions {
+		if condition.Status != corev1.ConditionTrue {
+			continue
+		}
+		if condition.Type != batchv1.JobComplete && condition.Type != batchv1.JobFailed {
+			continue
+		}
+		if status != "" && condition.Type != status {
+			continue
+		}
+		if condition.Type == batchv1.JobComplete && job.Status.CompletionTime != nil {
+			return job.Status.CompletionTime.Time, true
+		}
+		return condition.LastTransitionTime.Time, true
+	}
+	return time.Time{}, false
+}
+
+// reportCleanupJobsFailure sends an error message naming the Jobs deleted so far to the results
+// channel, logs the failure, and returns the error so callers can propagate it directly.
+//
+// This unexported function is used internally by CleanupJobs to report failures.
+func reportCleanupJobsFailure(results chan<- string, deleted []string, err error) error {
+	errorMessage := fmt.Sprintf(language.ErrorFailedToCleanupJobs, len(deleted), strings.Join(deleted, ", "), err)
+	results <- errorMessage
+	navigator.LogErrorWithEmojiRateLimited(constant.ErrorEmoji, errorMessage, zap.Error(err))
+	return err
+}
+
+// extractCleanupJobsParameters extracts and validates the optional 'labelSelector', 'olderThan', and
+// 'status' parameters. 'olderThan' is a duration such as "24h" and defaults to zero, and 'status' must
+// be 'Complete' or 'Failed' when given.
+//
+// This function is used by task runners that clean up Jobs.
+func extractCleanupJobsParameters(parameters map[string]interface{}) (jobCleanupCriteria, error) {
+	selector, err := getOptionalParamAsString(parameters, labelSelector, "")
+	if err != nil {
+		return jobCleanupCriteria{}, err
+	}
+
+	olderThanStr, err := getOptionalParamAsString(parameters, olderThaN, "0s")
+	if err != nil {
+		return jobCleanupCriteria{}, err
+	}
+	olderThan, err := time.ParseDuration(olderThanStr)
+	if err != nil || olderThan < 0 {
+		return jobCleanupCriteria{}, newParameterError(olderThaN, err, language.ErrorParameterInvalid, olderThaN)
+	}
+
+	status, err := getOptionalParamAsString(parameters, jobStatuS, "")
+	if err != nil {
+		return jobCleanupCriteria{}, err
+	}
+	switch batchv1.JobConditionType(status) {
+	case "", batchv1.JobComplete, batchv1.JobFailed:
+	default:
+		return jobCleanupCriteria{}, newParameterError(jobStatuS, nil, language.ErrorInvalidJobStatus, status)
+	}
+
+	return jobCleanupCriteria{labelSelector: selector, olderThan: olderThan, status: batchv1.JobConditionType(status)}, nil
+}
@@ -0,0 +1,201 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/H0llyW00dzZ/K8sBlackPearl/language"
+	"github.com/H0llyW00dzZ/K8sBlackPearl/navigator"
+	"github.com/H0llyW00dzZ/go-urlshortner/logmonitor/constant"
+	"go.uber.org/zap"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/util/retry"
+)
+
+// cloneDeploymentRequest describes which deployment to copy and where to create the copy.
+type cloneDeploymentRequest struct {
+	sourceNamespace string
+	deploymentName  string
+	targetNamespace string
+	targetName      string
+	imageRewrite    map[string]string
+	overwrite       bool
+}
+
+// CloneDeployment copies a deployment into another namespace, optionally under another name. The copy
+// keeps the labels, annotations, and spec of the source, while server-populated metadata and status are
+// dropped. Container images can be moved to another registry with the request's image rewrite map. If
+// the target already exists and overwrite is enabled, its labels, annotations, and spec are replaced,
+// retrying on conflicts.
+//
+// Parameters:
+//
+//	ctx context.Context: Context for cancellation and timeout.
+//	clientset kubernetes.Interface: A Kubernetes clientset for interacting with the Kubernetes API.
+//	request cloneDeploymentRequest: The source, target, image rewrites, and overwrite flag.
+//	results chan<- string: A channel to send operation results for logging.
+//	logger *zap.Logger: A logger for structured logging.
+//
+// Returns an error if the source cannot be read or the copy cannot be created or overwritten.
+func CloneDeployment(ctx context.Context, clientset kubernetes.Interface, request cloneDeploymentRequest, results chan<- string, logger *zap.Logger) error {
+	source, err := clientset.AppsV1().Deployments(request.sourceNamespace).Get(ctx, request.deploymentName, v1.GetOptions{})
+	if err != nil {
+		return reportCloneFailure(results, request, fmt.Errorf(language.ErrorGettingDeployment, request.deploymentName, err))
+	}
+	clone := cloneDeploymentObject(source, request.targetNamespace, request.targetName)
+	rewriteImages(&clone.Spec.Template.Spec, request.imageRewrite)
+	applyDefaultObjectMeta(clone)
+
+	deployments := clientset.AppsV1().Deployments(request.targetNamespace)
+	created, err := deployments.Create(ctx, clone, v1.CreateOptions{})
+	if err == nil {
+		publishCreatedObject(ctx, created)
+		successMsg := fmt.Sprintf(language.DeploymentCloned, request.sourceNamespace, request.deploymentName, request.targetNamespace, request.targetName)
+		results <- successMsg
+		navigator.LogInfoWithEmoji(constant.SuccessEmoji, successMsg)
+		return nil
+	}
+	if !apierrors.IsAlreadyExists(err) {
+		return reportCloneFailure(results, request, err)
+	}
+	if !request.overwrite {
+		return reportCloneFailure(results, request, fmt.Errorf(language.ErrorDeploymentAlreadyExists, request.targetName, request.targetNamespace))
+	}
+
+	err = retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		current, getErr := deployments.Get(ctx, request.targetName, v1.GetOptions{})
+		if getErr != nil {
+			return getErr
+		}
+		current.Labels = clone.Labels
+		current.Annotations = clone.Annotations
+		current.Spec = clone.Spec
+		_, updateErr := deployments.Update(ctx, current, v1.UpdateOptions{})
+		return updateErr
+	})
+	if err != nil {
+		return reportCloneFailure(results, request, err)
+	}
+
+	successMsg := fmt.Sprintf(language.DeploymentCloneOverwritten, request.sourceNamespace, request.deploymentName, request.targetNamespace, request.targetName)
+	results <- successMsg
+	navigator.LogInfoWithEmoji(constant.SuccessEmoji, successMsg)
+	return nil
+}
+
+// cloneDeploymentObject returns a copy of the deployment that can be created as a new object: only the
+// name, namespace, labels, annotations, and spec are kept, and the annotations maintained by the
+// deployment controller and kubectl are removed.
+//
+// This unexported function is used internally by CloneDeployment.
+func cloneDeploymentObject(source *appsv1.Deployment, namespace, name string) *appsv1.Deployment {
+	copied := source.DeepCopy()
+	clone := &appsv1.Deployment{
+		ObjectMeta: v1.ObjectMeta{
+			Name:        name,
+			Namespace:   namespace,
+			Labels:      copied.Labels,
+			Annotations: copied.Annotations,
+		},
+		Spec: copied.Spec,
+	}
+	delete(clone.Annotations, deploymentRevisionAnnotation)
+	delete(clone.Annotations, corev1.LastAppliedConfigAnnotation)
+	return clone
+}
+
+// rewriteImages replaces the registry prefix of every container image that starts with one of the keys
+// of the rewrite map by the corresponding value. When several keys match, the longest one wins.
+//
+// This unexported function is used internally by CloneDeployment.
+func rewriteImages(podSpec *corev1.PodSpec, rewrite map[string]string) {
+	if len(rewrite) == 0 {
+		return
+	}
+	rewriteImage := func(image string) string {
+		match := ""
+		for from := range rewrite {
+			if strings.HasPrefix(image, from) && len(from) > len(match) {
+				match = from
+			}
+		}
+		if match == "" {
+			return image
+		}
+		return rewrite[match] + strings.TrimPrefix(image, match)
+	}
+	for i := range podSpec.InitContainers {
+		podSpec.InitContainers[i].Image = rewriteImage(podSpec.InitContainers[i].Image)
+	}
+	for i := range podSpec.Containers {
+		podSpec.Containers[i].Image = rewriteImage(podSpec.Containers[i].Image)
+	}
+}
+
+// reportCloneFailure sends an error message to the results channel, logs the failure,
+// and returns the error so callers can propagate it directly.
+//
+// This unexported function is used internally by CloneDeployment to report failures.
+func reportCloneFailure(results chan<- string, request cloneDeploymentRequest, err error) error {
+	errorMessage := fmt.Sprintf(language.ErrorFailedToCloneDeployment, request.sourceNamespace, request.deploymentName, request.targetNamespace, err)
+	results <- errorMessage
+	navigator.LogErrorWithEmojiRateLimited(constant.ErrorEmoji, errorMessage, zap.Error(err))
+	return err
+}
+
+// extractCloneDeploymentParameters extracts and validates the 'sourceNamespace', 'deploymentName',
+// 'targetNamespace', 'nameOverride', 'imageRewrite', and 'overwrite' parameters. The source namespace
+// defaults to the task namespace, and the copy keeps the source name unless 'nameOverride' is set.
+//
+// This function is used by task runners that clone deployments.
+func extractCloneDeploymentParameters(parameters map[string]interface{}, shipsNamespace string) (cloneDeploymentRequest, error) {
+	deploymentName, err := extractDeploymentNameParameter(parameters)
+	if err != nil {
+		return cloneDeploymentRequest{}, err
+	}
+	sourceNamespace, err := getOptionalParamAsString(parameters, sourceNamespacE, shipsNamespace)
+	if err != nil {
+		return cloneDeploymentRequest{}, err
+	}
+	targetNamespace, err := getParamAsString(parameters, targetNamespacE)
+	if err != nil || targetNamespace == "" {
+		return cloneDeploymentRequest{}, newParameterError(targetNamespacE, err, language.ErrorParameterMissing, targetNamespacE)
+	}
+	targetName, err := getOptionalParamAsString(parameters, nameOverridE, deploymentName)
+	if err != nil {
+		return cloneDeploymentRequest{}, err
+	}
+	if targetName == "" {
+		targetName = deploymentName
+	}
+	if sourceNamespace == targetNamespace && targetName == deploymentName {
+		return cloneDeploymentRequest{}, newParameterError(targetNamespacE, nil, language.ErrorCloneOntoItself)
+	}
+
+	var imageRewrite map[string]string
+	if _, exists := parameters[imageRewritE]; exists {
+		imageRewrite, err = getParamAsStringMap(parameters, imageRewritE)
+		if err != nil {
+			return cloneDeploymentRequest{}, err
+		}
+	}
+
+	overwrite, err := getOptionalParamAsBool(parameters, overwritE, false)
+	if err != nil {
+		return cloneDeploymentRequest{}, err
+	}
+
+	return cloneDeploymentRequest{
+		sourceNamespace: sourceNamespace,
+		deploymentName:  deploymentName,
+		targetNamespace: targetNamespace,
+		targetName:      targetName,
+		imageRewrite:    imageRewrite,
+		overwrite:       overwrite,
+	}, nil
+}
@@ -0,0 +1,99 @@
+package worker
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/H0llyW00dzZ/K8sBlackPearl/worker/configuration"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+// newSourceDeployment returns a deployment carrying the server-populated metadata and status that a
+// clone must not copy.
+func newSourceDeployment() *appsv1.Deployment {
+	replicas := int32(2)
+	return &appsv1.Deployment{
+		ObjectMeta: v1.ObjectMeta{
+			Name:              "api",
+			Namespace:         "default",
+			UID:               "0f1c6a4e-source",
+			ResourceVersion:   "4242",
+			Generation:        7,
+			CreationTimestamp: v1.Now(),
+			Labels:            map[string]string{"app": "api"},
+			Annotations: map[string]string{
+				"deployment.kubernetes.io/revision":                "3",
+				"kubectl.kubernetes.io/last-applied-configuration": "{}",
+				"owner": "sparrow",
+			},
+		},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: &replicas,
+			Template: corev1.PodTemplateSpec{Spec: corev1.PodSpec{
+				InitContainers: []corev1.Container{{Name: "migrate", Image: "registry.old/tools/migrate:1"}},
+				Containers:     []corev1.Container{{Name: "app", Image: "registry.old/api:1"}, {Name: "proxy", Image: "docker.io/envoy:1"}},
+			}},
+		},
+		Status: appsv1.DeploymentStatus{Replicas: 2, ReadyReplicas: 2, ObservedGeneration: 7},
+	}
+}
+
+func TestCloneDeploymentObjectStripsServerMetadata(t *testing.T) {
+	source := newSourceDeployment()
+
+	clone := cloneDeploymentObject(source, "staging", "api-green")
+
+	if clone.Name != "api-green" || clone.Namespace != "staging" {
+		t.Fatalf("got %s/%s, want staging/api-green", clone.Namespace, clone.Name)
+	}
+	if clone.UID != "" || clone.ResourceVersion != "" || clone.Generation != 0 || !clone.CreationTimestamp.IsZero() {
+		t.Fatalf("the clone keeps server metadata: %+v", clone.ObjectMeta)
+	}
+	if !reflect.DeepEqual(clone.Status, appsv1.DeploymentStatus{}) {
+		t.Fatalf("the clone keeps the status %+v", clone.Status)
+	}
+	if want := map[string]string{"owner": "sparrow"}; !reflect.DeepEqual(clone.Annotations, want) {
+		t.Fatalf("got annotations %v, want %v", clone.Annotations, want)
+	}
+	if len(source.Annotations) != 3 {
+		t.Fatalf("cloning changed the source annotations to %v", source.Annotations)
+	}
+}
+
+func TestCrewCloneDeploymentRewritesImagesAndHonorsOverwrite(t *testing.T) {
+	clientset := fake.NewSimpleClientset(newSourceDeployment())
+	parameters := map[string]interface{}{
+		"deploymentName":  "api",
+		"targetNamespace": "staging",
+		"nameOverride":    "api-green",
+		"imageRewrite":    map[string]interface{}{"registry.old/": "registry.new/", "registry.old/tools/": "tools.new/"},
+	}
+	task := configuration.Task{Name: "clone-api", Type: "CrewCloneDeployment", Parameters: parameters}
+	run := func() error {
+		return (&CrewCloneDeployment{}).Run(context.Background(), clientset, "default", task, parameters, 0)
+	}
+
+	if err := run(); err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+	clone, err := clientset.AppsV1().Deployments("staging").Get(context.Background(), "api-green", v1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	podSpec := clone.Spec.Template.Spec
+	if podSpec.InitContainers[0].Image != "tools.new/migrate:1" || podSpec.Containers[0].Image != "registry.new/api:1" || podSpec.Containers[1].Image != "docker.io/envoy:1" {
+		t.Fatalf("got images %q, %q, and %q", podSpec.InitContainers[0].Image, podSpec.Containers[0].Image, podSpec.Containers[1].Image)
+	}
+
+	if err := run(); err == nil {
+		t.Fatal("cloning onto an existing deployment succeeded without overwrite")
+	}
+	parameters["overwrite"] = true
+	if err := run(); err != nil {
+		t.Fatalf("Run with overwrite: %v", err)
+	}
+}
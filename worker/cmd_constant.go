@@ -1,5 +1,7 @@
 package worker
 
+import "time"
+
 // Note: This constant used for dir machine
 // defined in worker/cmd_constant.go
 const (
@@ -11,34 +13,194 @@ const (
 	errConfig       = "could not retrieve Kubernetes configuration: %v"
 	cannotCreateK8s = "could not create Kubernetes client: %v"
 	errEnvVar       = "environment variable %s not set"
+
+	errClusterUnreachable = "kubernetes API server is unreachable: %v"
+	errHealthCheckTimeout = "no response within %v"
+	errUnknownClientMode  = "unknown kubernetes client mode %q: must be auto, incluster, or kubeconfig"
 )
 
 // defined object
 const (
-	metaData         = "metadata"
-	labeLs           = "labels"
-	labeLKey         = "labelKey"
-	labeLValue       = "labelValue"
-	labelSelector    = "labelSelector"
-	fieldSelector    = "fieldSelector"
-	limIt            = "limit"
-	deploYmentName   = "deploymentName"
-	contaInerName    = "containerName"
-	newImAge         = "newImage"
-	repliCas         = "replicas"
-	deploymenT       = "deployment"
-	scalE            = "scale"
-	storageClassName = "storageClassName"
-	pvcName          = "pvcName"
-	storageSize      = "storageSize"
-	policyNamE       = "policyName"
-	policySpeC       = "policySpec"
-	retryDelay       = "retryDelay"
-	tasK             = "task"
-	attempT          = "attempt"
-	maXRetries       = "maxRetries"
+	metaData                       = "metadata"
+	labeLs                         = "labels"
+	labeLKey                       = "labelKey"
+	labeLValue                     = "labelValue"
+	labelSelector                  = "labelSelector"
+	fieldSelector                  = "fieldSelector"
+	limIt                          = "limit"
+	deploYmentName                 = "deploymentName"
+	contaInerName                  = "containerName"
+	newImAge                       = "newImage"
+	repliCas                       = "replicas"
+	deploymenT                     = "deployment"
+	scalE                          = "scale"
+	storageClassName               = "storageClassName"
+	pvcName                        = "pvcName"
+	storageSize                    = "storageSize"
+	policyNamE                     = "policyName"
+	policySpeC                     = "policySpec"
+	retryDelay                     = "retryDelay"
+	tasK                           = "task"
+	attempT                        = "attempt"
+	maXRetries                     = "maxRetries"
+	limitRangeNamE                 = "limitRangeName"
+	limiTs                         = "limits"
+	limitTypE                      = "type"
+	defaulT                        = "default"
+	defaultRequesT                 = "defaultRequest"
+	maX                            = "max"
+	miN                            = "min"
+	overwritE                      = "overwrite"
+	manifesT                       = "manifest"
+	secretReF                      = "secretRef"
+	secretRefName                  = "name"
+	secretRefKey                   = "key"
+	nodeNamE                       = "nodeName"
+	requireCordoneD                = "requireCordoned"
+	requireEmptY                   = "requireEmpty"
+	specNodeName                   = "spec.nodeName"
+	kindDaemonSet                  = "DaemonSet"
+	paginatE                       = "paginate"
+	maxItemS                       = "maxItems"
+	provisioneR                    = "provisioner"
+	provisionerParameters          = "parameters"
+	reclaimPolicY                  = "reclaimPolicy"
+	volumeBindingModE              = "volumeBindingMode"
+	allowVolumeExpansioN           = "allowVolumeExpansion"
+	previousReplicasAnnotation     = "k8sblackpearl.io/previousReplicas"
+	conditioN                      = "condition"
+	timeouT                        = "timeout"
+	metadataName                   = "metadata.name"
+	watchConditionReady            = "ready"
+	watchConditionDeleted          = "deleted"
+	defaultWatchTimeout            = "5m"
+	defaultRolloutStuckThreshold   = "10m"
+	imagE                          = "image"
+	commanD                        = "command"
+	enV                            = "env"
+	restartPolicY                  = "restartPolicy"
+	waiT                           = "wait"
+	gracePeriodSecondS             = "gracePeriodSeconds"
+	ignoreNotFounD                 = "ignoreNotFound"
+	annotationKeY                  = "annotationKey"
+	annotationValuE                = "annotationValue"
+	targeT                         = "target"
+	annotationTargetMetadata       = "metadata"
+	annotationTargetTemplate       = "template"
+	resourceVersioN                = "resourceVersion"
+	speC                           = "spec"
+	templatE                       = "template"
+	annotationS                    = "annotations"
+	strategyTypE                   = "strategyType"
+	maxSurgE                       = "maxSurge"
+	maxUnavailablE                 = "maxUnavailable"
+	watcH                          = "watch"
+	followQuery                    = "follow"
+	serviceNamE                    = "serviceName"
+	addresseS                      = "addresses"
+	iP                             = "ip"
+	porT                           = "port"
+	hostnamE                       = "hostname"
+	protocoL                       = "protocol"
+	configMapNamE                  = "configMapName"
+	showValueS                     = "showValues"
+	secretNamE                     = "secretName"
+	ingressNamE                    = "ingressName"
+	hosT                           = "host"
+	patH                           = "path"
+	servicePorT                    = "servicePort"
+	requireReadY                   = "requireReady"
+	expectedStatuS                 = "expectedStatus"
+	defaultProbeTimeout            = "10s"
+	sourceNamespacE                = "sourceNamespace"
+	targetNamespacE                = "targetNamespace"
+	nameOverridE                   = "nameOverride"
+	imageRewritE                   = "imageRewrite"
+	deploymentRevisionAnnotation   = "deployment.kubernetes.io/revision"
+	serviceAccountNamE             = "serviceAccountName"
+	secretNameS                    = "secretNames"
+	pullSecretTargetServiceAccount = "serviceAccount"
+	pullSecretTargetDeployment     = "deployment"
+	targetNamE                     = "targetName"
+	updateModE                     = "updateMode"
+	vpaNamE                        = "vpaName"
+	minAlloweD                     = "minAllowed"
+	maxAlloweD                     = "maxAllowed"
+	olderThaN                      = "olderThan"
+	jobStatuS                      = "status"
+	jobNamE                        = "jobName"
+	serverSideApplY                = "serverSideApply"
+	fieldManageR                   = "fieldManager"
+	forcE                          = "force"
+	defaultFieldManager            = "k8sblackpearl"
+	failIfBlockeD                  = "failIfBlocked"
+	sinceSecondS                   = "sinceSeconds"
+	sinceTimE                      = "sinceTime"
+	previouS                       = "previous"
+	limitByteS                     = "limitBytes"
+	stringDatA                     = "stringData"
+	replacE                        = "replace"
+	nodeSelectoR                   = "nodeSelector"
+	expecteD                       = "expected"
+	failOnMismatcH                 = "failOnMismatch"
+	outputNamE                     = "name"
+	outputUID                      = "uid"
+	phasE                          = "phase"
+	statusPhase                    = "status.phase"
+	failIfExhausteD                = "failIfExhausted"
+	datA                           = "data"
+	binaryDatA                     = "binaryData"
+	rollinG                        = "rolling"
+	selectoR                       = "selector"
+	porTs                          = "ports"
+	portNamE                       = "name"
+	targetPorT                     = "targetPort"
+	publishNotReadyAddresseS       = "publishNotReadyAddresses"
+	thresholD                      = "threshold"
+	failIfStucK                    = "failIfStuck"
+	failIfUnhealthY                = "failIfUnhealthy"
+	hpaNamE                        = "hpaName"
+	minReplicaS                    = "minReplicas"
+	maxReplicaS                    = "maxReplicas"
+	targetCPUUtilizationPercentagE = "targetCPUUtilizationPercentage"
+	endpointSliceNamE              = "endpointSliceName"
+	addressTypE                    = "addressType"
+	endpointS                      = "endpoints"
+	conditionS                     = "conditions"
+	servinG                        = "serving"
+	terminatinG                    = "terminating"
+	perPoD                         = "perPod"
+	claimLeasePrefix               = "k8sblackpearl-claim-"
+	policY                         = "policy"
+	requireRunAsNonRooT            = "requireRunAsNonRoot"
+	forbidPrivilegeD               = "forbidPrivileged"
+	forbidPrivilegeEscalatioN      = "forbidPrivilegeEscalation"
+	requireDropAllCapabilitieS     = "requireDropAllCapabilities"
+	failIfViolatioN                = "failIfViolation"
+	kindS                          = "kinds"
+	sourceSelectoR                 = "sourceSelector"
+	targetDeploymenT               = "targetDeployment"
+	ratiO                          = "ratio"
+	formulA                        = "formula"
+)
+
+// defined limits
+const (
+	defaultPageSize            int64 = 500              // Page size used when listing every pod in a namespace.
+	podPollInterval                  = 2 * time.Second  // Interval between checks while waiting for a pod.
+	defaultCallTimeout               = 30 * time.Second // Maximum duration of a single Kubernetes API call.
+	defaultProbeStatus               = 200              // HTTP status expected from a pod probe by default.
+	defaultPodLogLimitBytes          = 64 * 1024        // Maximum bytes of pod logs fetched by default.
+	quotaNearLimitPercent            = 90               // Percentage of a quota at which a resource is flagged as near its limit.
+	maxAttemptHistory                = 20               // Maximum number of failed attempts recorded per task.
+	defaultInformerSyncTimeout       = time.Minute      // Maximum time to wait for a pod informer cache to sync.
+	defaultClaimLeaseDuration        = 5 * time.Minute  // How long a task claim held by a LeaseClaimStore lasts.
+	claimCallTimeout                 = 10 * time.Second // Maximum duration of a single LeaseClaimStore API call.
 )
 
+// defined sensitive parameter key markers used for audit redaction
+var sensitiveKeyMarkers = []string{"password", "passwd", "token", "secret", "credential", "apikey"}
+
 // defined notice message just like human would type
 
 const (
@@ -0,0 +1,196 @@
+package worker
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/H0llyW00dzZ/K8sBlackPearl/language"
+	"github.com/H0llyW00dzZ/K8sBlackPearl/navigator"
+	"github.com/H0llyW00dzZ/K8sBlackPearl/worker/configuration"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/util/retry"
+)
+
+// CompletionStore records the idempotency keys of tasks that completed successfully, so that a task
+// declaring an already completed key is skipped, even by a controller that has since restarted.
+// Implementations must be safe for concurrent use.
+type CompletionStore interface {
+	// IsCompleted reports whether the key has been recorded as completed.
+	IsCompleted(ctx context.Context, key string) (bool, error)
+	// MarkCompleted records the key as completed.
+	MarkCompleted(ctx context.Context, key string) error
+}
+
+// completionStore is the package-level store consulted for tasks that declare an idempotency key.
+var (
+	completionStore   CompletionStore = NewInMemoryCompletionStore()
+	completionStoreMu sync.RWMutex
+)
+
+// SetCompletionStore sets the store used to deduplicate tasks by idempotency key in a thread-safe manner.
+// The default is an in-memory store, which only deduplicates within a single process; use a persistent
+// store such as ConfigMapCompletionStore to deduplicate across restarts. Passing nil disables the check.
+func SetCompletionStore(store CompletionStore) {
+	completionStoreMu.Lock()
+	completionStore = store
+	completionStoreMu.Unlock()
+}
+
+// currentCompletionStore returns the configured completion store, or nil if deduplication is disabled.
+func currentCompletionStore() CompletionStore {
+	completionStoreMu.RLock()
+	defer completionStoreMu.RUnlock()
+	return completionStore
+}
+
+// InMemoryCompletionStore is a CompletionStore that keeps completed keys in memory.
+// Its state is lost when the process exits.
+type InMemoryCompletionStore struct {
+	mu        sync.RWMutex
+	completed map[string]time.Time
+}
+
+// NewInMemoryCompletionStore creates an empty InMemoryCompletionStore.
+func NewInMemoryCompletionStore() *InMemoryCompletionStore {
+	return &InMemoryCompletionStore{completed: make(map[string]time.Time)}
+}
+
+// IsCompleted reports whether the key has been recorded as completed.
+func (s *InMemoryCompletionStore) IsCompleted(ctx context.Context, key string) (bool, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	_, found := s.completed[key]
+	return found, nil
+}
+
+// MarkCompleted records the key as completed.
+func (s *InMemoryCompletionStore) MarkCompleted(ctx context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.completed[key] = time.Now().UTC()
+	return nil
+}
+
+// ConfigMapCompletionStore is a CompletionStore that persists completed keys in the data of a ConfigMap,
+// with the completion time as the value. The ConfigMap is created on first use. Keys that are not valid
+// ConfigMap data keys are stored under their SHA-256 digest.
+type ConfigMapCompletionStore struct {
+	clientset kubernetes.Interface
+	namespace string
+	name      string
+}
+
+// NewConfigMapCompletionStore creates a ConfigMapCompletionStore backed by the named ConfigMap.
+//
+// Parameters:
+//
+//	clientset kubernetes.Interface: A Kubernetes clientset for interacting with the Kubernetes API.
+//	namespace string: The namespace of the ConfigMap.
+//	name string: The name of the ConfigMap.
+func NewConfigMapCompletionStore(clientset kubernetes.Interface, namespace, name string) *ConfigMapCompletionStore {
+	return &ConfigMapCompletionStore{clientset: clientset, namespace: namespace, name: name}
+}
+
+// IsCompleted reports whether the key is present in the ConfigMap. A missing ConfigMap means no key
+// has been completed yet.
+func (s *ConfigMapCompletionStore) IsCompleted(ctx context.Context, key string) (bool, error) {
+	configMap, err := s.clientset.CoreV1().ConfigMaps(s.namespace).Get(ctx, s.name, v1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf(language.ErrorReadingCompletionStore, s.namespace, s.name, err)
+	}
+	_, found := configMap.Data[completionDataKey(key)]
+	return found, nil
+}
+
+// MarkCompleted adds the key to the ConfigMap, creating the ConfigMap if needed and retrying on conflicts.
+func (s *ConfigMapCompletionStore) MarkCompleted(ctx context.Context, key string) error {
+	dataKey := completionDataKey(key)
+	completedAt := time.Now().UTC().Format(time.RFC3339)
+
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		configMaps := s.clientset.CoreV1().ConfigMaps(s.namespace)
+		configMap, err := configMaps.Get(ctx, s.name, v1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			configMap = &corev1.ConfigMap{
+				ObjectMeta: v1.ObjectMeta{Name: s.name},
+				Data:       map[string]string{dataKey: completedAt},
+			}
+			_, err = configMaps.Create(ctx, configMap, v1.CreateOptions{})
+			if apierrors.IsAlreadyExists(err) {
+				// Another worker created the ConfigMap first; retry the update path.
+				return apierrors.NewConflict(corev1.Resource("configmaps"), s.name, err)
+			}
+			return err
+		}
+		if err != nil {
+			return err
+		}
+		if _, found := configMap.Data[dataKey]; found {
+			return nil
+		}
+		if configMap.Data == nil {
+			configMap.Data = make(map[string]string)
+		}
+		configMap.Data[dataKey] = completedAt
+		_, err = configMaps.Update(ctx, configMap, v1.UpdateOptions{})
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf(language.ErrorWritingCompletionStore, s.namespace, s.name, err)
+	}
+	return nil
+}
+
+// completionDataKey returns the key unchanged if it is a valid ConfigMap data key,
+// or its SHA-256 digest otherwise.
+func completionDataKey(key string) string {
+	if len(validation.IsConfigMapKey(key)) == 0 {
+		return key
+	}
+	digest := sha256.Sum256([]byte(key))
+	return "sha256-" + hex.EncodeToString(digest[:])
+}
+
+// isTaskAlreadyCompleted reports whether the task declares an idempotency key that the completion
+// store has already recorded. Store errors are logged and treated as not completed, so an unreachable
+// store never prevents work from running.
+//
+// This unexported function is used internally by processTask.
+func isTaskAlreadyCompleted(ctx context.Context, task configuration.Task) bool {
+	store := currentCompletionStore()
+	if task.IdempotencyKey == "" || store == nil {
+		return false
+	}
+	completed, err := store.IsCompleted(ctx, task.IdempotencyKey)
+	if err != nil {
+		navigator.LogErrorWithEmojiRateLimited(language.WarningEmoji, err.Error(), zap.String(language.Task_Name, task.Name))
+		return false
+	}
+	return completed
+}
+
+// markTaskCompleted records the task's idempotency key in the completion store, if the task declares one.
+// A failure to record the key is logged; the task itself has already succeeded.
+//
+// This unexported function is used internally by processTask.
+func markTaskCompleted(ctx context.Context, task configuration.Task) {
+	store := currentCompletionStore()
+	if task.IdempotencyKey == "" || store == nil {
+		return
+	}
+	if err := store.MarkCompleted(ctx, task.IdempotencyKey); err != nil {
+		navigator.LogErrorWithEmojiRateLimited(language.WarningEmoji, err.Error(), zap.String(language.Task_Name, task.Name))
+	}
+}
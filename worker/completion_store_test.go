@@ -0,0 +1,102 @@
+package worker
+
+import (
+	"context"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/H0llyW00dzZ/K8sBlackPearl/worker/configuration"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestInMemoryCompletionStore(t *testing.T) {
+	ctx := context.Background()
+	store := NewInMemoryCompletionStore()
+
+	if completed, _ := store.IsCompleted(ctx, "deploy-v1"); completed {
+		t.Fatal("a fresh store reports the key as completed")
+	}
+	if err := store.MarkCompleted(ctx, "deploy-v1"); err != nil {
+		t.Fatalf("MarkCompleted: %v", err)
+	}
+	if completed, _ := store.IsCompleted(ctx, "deploy-v1"); !completed {
+		t.Fatal("the marked key is not reported as completed")
+	}
+	if completed, _ := store.IsCompleted(ctx, "deploy-v2"); completed {
+		t.Fatal("an unmarked key is reported as completed")
+	}
+}
+
+func TestConfigMapCompletionStore(t *testing.T) {
+	ctx := context.Background()
+	clientset := fake.NewSimpleClientset()
+	store := NewConfigMapCompletionStore(clientset, "crew", "completed-tasks")
+
+	if completed, err := store.IsCompleted(ctx, "deploy-v1"); err != nil || completed {
+		t.Fatalf("IsCompleted before the ConfigMap exists = %v, %v; want false, nil", completed, err)
+	}
+
+	invalidKey := "deploy/v2 with spaces"
+	for _, key := range []string{"deploy-v1", invalidKey} {
+		if err := store.MarkCompleted(ctx, key); err != nil {
+			t.Fatalf("MarkCompleted(%q): %v", key, err)
+		}
+	}
+	for _, key := range []string{"deploy-v1", invalidKey} {
+		if completed, err := store.IsCompleted(ctx, key); err != nil || !completed {
+			t.Fatalf("IsCompleted(%q) = %v, %v; want true, nil", key, completed, err)
+		}
+	}
+
+	configMap, err := clientset.CoreV1().ConfigMaps("crew").Get(ctx, "completed-tasks", v1.GetOptions{})
+	if err != nil {
+		t.Fatalf("getting the ConfigMap: %v", err)
+	}
+	if _, found := configMap.Data["deploy-v1"]; !found {
+		t.Error("the valid key is not stored as is")
+	}
+	if _, found := configMap.Data[completionDataKey(invalidKey)]; !found || !strings.HasPrefix(completionDataKey(invalidKey), "sha256-") {
+		t.Error("the invalid key is not stored under its digest")
+	}
+}
+
+func TestProcessTaskSkipsCompletedTaskOnce(t *testing.T) {
+	previous := currentCompletionStore()
+	t.Cleanup(func() { SetCompletionStore(previous) })
+	store := NewInMemoryCompletionStore()
+	SetCompletionStore(store)
+	if err := store.MarkCompleted(context.Background(), "already-done"); err != nil {
+		t.Fatal(err)
+	}
+
+	var runs atomic.Int32
+	registerTestRunner(t, "TestIdempotentTask", func(context.Context, kubernetes.Interface, string, configuration.Task, map[string]interface{}, int) error {
+		runs.Add(1)
+		return nil
+	})
+	tasks := []configuration.Task{
+		{Name: "done", Type: "TestIdempotentTask", MaxRetries: 1, IdempotencyKey: "already-done"},
+		{Name: "pending", Type: "TestIdempotentTask", MaxRetries: 1, IdempotencyKey: "not-yet"},
+	}
+
+	results := runCrewToCompletion(context.Background(), fake.NewSimpleClientset(), tasks, 4)
+
+	skips := 0
+	for _, result := range results {
+		if strings.Contains(result, "skipped") {
+			skips++
+		}
+	}
+	if skips != 1 {
+		t.Errorf("got %d skip results from 4 workers, want exactly 1: %q", skips, results)
+	}
+	if got := runs.Load(); got != 1 {
+		t.Errorf("the runner ran %d times, want 1 (only the pending task)", got)
+	}
+	if completed, _ := store.IsCompleted(context.Background(), "not-yet"); !completed {
+		t.Error("the successful task's key was not recorded")
+	}
+}
@@ -0,0 +1,114 @@
+package worker
+
+import (
+	"context"
+	"sync"
+)
+
+// taskSlots is the package-level semaphore bounding the number of tasks executing at the same time;
+// nil means no bound beyond the number of workers.
+var (
+	taskSlots   chan struct{}
+	taskSlotsMu sync.RWMutex
+)
+
+// SetMaxConcurrentTasks limits how many tasks may execute at the same time across all workers, in a
+// thread-safe manner. It is independent of the worker count passed to CaptainTellWorkers, so a large
+// number of workers can scan tasks while only a few mutate the cluster at once. A value of zero or
+// less removes the limit, which is the default. The limit applies to tasks started after the call.
+func SetMaxConcurrentTasks(n int) {
+	taskSlotsMu.Lock()
+	defer taskSlotsMu.Unlock()
+	if n <= 0 {
+		taskSlots = nil
+		return
+	}
+	taskSlots = make(chan struct{}, n)
+}
+
+// taskSlotsKey is the context key under which the task slots of a single run are stored.
+type taskSlotsKey struct{}
+
+// withTaskSlots returns a copy of the context carrying a semaphore of n task slots, which bounds the
+// run using the context in place of the limit set with SetMaxConcurrentTasks. A value of zero or less
+// leaves the context unchanged.
+//
+// This unexported function is used internally by CaptainTellWorkersPerNamespace.
+func withTaskSlots(ctx context.Context, n int) context.Context {
+	if n <= 0 {
+		return ctx
+	}
+	return context.WithValue(ctx, taskSlotsKey{}, make(chan struct{}, n))
+}
+
+// poolTaskSlotsKey is the context key under which the task slots of a single worker pool are stored.
+type poolTaskSlotsKey struct{}
+
+// withPoolTaskSlots returns a copy of the context carrying a semaphore of n task slots for a single
+// worker pool. A task run with the context holds one of these slots in addition to a slot of the run,
+// so the pool can never take more than n of the run's slots. A value of zero or less leaves the
+// context unchanged.
+//
+// This unexported function is used internally by CaptainTellWorkersPerNamespace.
+func withPoolTaskSlots(ctx context.Context, n int) context.Context {
+	if n <= 0 {
+		return ctx
+	}
+	return context.WithValue(ctx, poolTaskSlotsKey{}, make(chan struct{}, n))
+}
+
+// maxConcurrentTasksLimit returns the limit set with SetMaxConcurrentTasks, or zero if there is none.
+//
+// This unexported function is used internally by CaptainTellWorkersPerNamespace.
+func maxConcurrentTasksLimit() int {
+	taskSlotsMu.RLock()
+	defer taskSlotsMu.RUnlock()
+	return cap(taskSlots)
+}
+
+// acquireTaskSlot waits for a free task slot of the worker pool, if the context carries pool slots,
+// and then for a slot of the run, taken from the semaphore carried by the context, if any, or from
+// the one set with SetMaxConcurrentTasks. It returns a function that releases the slots, and false if
+// the context is cancelled before the slots become available.
+//
+// This unexported function is used internally by processTask.
+func acquireTaskSlot(ctx context.Context) (func(), bool) {
+	poolSlots, _ := ctx.Value(poolTaskSlotsKey{}).(chan struct{})
+	releasePoolSlot, acquired := acquireSlot(ctx, poolSlots)
+	if !acquired {
+		return nil, false
+	}
+
+	runSlots, _ := ctx.Value(taskSlotsKey{}).(chan struct{})
+	if runSlots == nil {
+		taskSlotsMu.RLock()
+		runSlots = taskSlots
+		taskSlotsMu.RUnlock()
+	}
+	releaseRunSlot, acquired := acquireSlot(ctx, runSlots)
+	if !acquired {
+		releasePoolSlot()
+		return nil, false
+	}
+	return func() {
+		releaseRunSlot()
+		releasePoolSlot()
+	}, true
+}
+
+// acquireSlot waits for a free slot of the semaphore, where a nil semaphore has no bound. It returns
+// a function that releases the slot, and false if the context is cancelled before a slot is free.
+//
+// This unexported function is used internally by acquireTaskSlot.
+func acquireSlot(ctx context.Context, slots chan struct{}) (func(), bool) {
+	if slots == nil {
+		return func() {}, ctx.Err() == nil
+	}
+
+	select {
+	case slots <- struct{}{}:
+		return func() { <-slots }, true
+	case <-ctx.Done():
+		return nil, false
+	}
+}
@@ -0,0 +1,43 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/H0llyW00dzZ/K8sBlackPearl/worker/configuration"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestSetMaxConcurrentTasksBoundsRunningTasks(t *testing.T) {
+	SetMaxConcurrentTasks(2)
+	t.Cleanup(func() { SetMaxConcurrentTasks(0) })
+
+	var tracker inFlightTracker
+	registerTestRunner(t, "TestTrackConcurrency", func(_ context.Context, _ kubernetes.Interface, _ string, _ configuration.Task, _ map[string]interface{}, _ int) error {
+		defer tracker.enter()()
+		time.Sleep(5 * time.Millisecond)
+		return nil
+	})
+
+	tasks := make([]configuration.Task, 24)
+	for i := range tasks {
+		tasks[i] = configuration.Task{
+			Name:           fmt.Sprintf("task-%d", i),
+			Type:           "TestTrackConcurrency",
+			ShipsNamespace: "default",
+			MaxRetries:     1,
+			RetryDelay:     "1ms",
+		}
+	}
+	results := runCrewToCompletion(context.Background(), fake.NewSimpleClientset(), tasks, 16)
+
+	if len(results) != len(tasks) {
+		t.Fatalf("got %d results, want %d", len(results), len(tasks))
+	}
+	if peak := tracker.peak.Load(); peak > 2 {
+		t.Fatalf("%d tasks ran at once, want at most 2", peak)
+	}
+}
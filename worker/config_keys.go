@@ -0,0 +1,107 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/H0llyW00dzZ/K8sBlackPearl/language"
+	"github.com/H0llyW00dzZ/K8sBlackPearl/navigator"
+	"github.com/H0llyW00dzZ/go-urlshortner/logmonitor/constant"
+	"go.uber.org/zap"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// GetConfigMapKeys reports the keys of a ConfigMap through the results channel. Keys from both data
+// and binaryData are reported; when showValues is set, the values of data keys are included and
+// binaryData keys are shown with their size. A missing ConfigMap is reported as a NotFound error
+// that is not retried.
+//
+// Parameters:
+//
+//	ctx context.Context: Context for cancellation and timeout.
+//	clientset kubernetes.Interface: A Kubernetes clientset for interacting with the Kubernetes API.
+//	namespace string: The namespace of the ConfigMap.
+//	configMapName string: The name of the ConfigMap.
+//	showValues bool: Whether the values of the keys should be reported.
+//	results chan<- string: A channel to send operation results for logging.
+//	logger *zap.Logger: A logger for structured logging.
+//
+// Returns an error if the ConfigMap cannot be read.
+func GetConfigMapKeys(ctx context.Context, clientset kubernetes.Interface, namespace, configMapName string, showValues bool, results chan<- string, logger *zap.Logger) error {
+	configMap, err := clientset.CoreV1().ConfigMaps(namespace).Get(ctx, configMapName, v1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return reportConfigKeysFailure(results, configMapName, markNonRetriable(fmt.Errorf(language.ErrorConfigMapNotFound, configMapName, namespace, err)))
+	}
+	if err != nil {
+		return reportConfigKeysFailure(results, configMapName, err)
+	}
+
+	entries := make([]string, 0, len(configMap.Data)+len(configMap.BinaryData))
+	for key, value := range configMap.Data {
+		if showValues {
+			key = key + "=" + value
+		}
+		entries = append(entries, key)
+	}
+	for key, value := range configMap.BinaryData {
+		if showValues {
+			key = fmt.Sprintf(language.BinaryValueSize, key, len(value))
+		}
+		entries = append(entries, key)
+	}
+	sort.Strings(entries)
+
+	message := fmt.Sprintf(language.ConfigMapKeys, configMapName, strings.Join(entries, ", "))
+	results <- message
+	navigator.LogInfoWithEmoji(constant.SuccessEmoji, message, zap.String(language.ConfigMapName, configMapName))
+	return nil
+}
+
+// GetSecretKeys reports the key names of a Secret through the results channel. Secret values are
+// never reported. A missing Secret is reported as a NotFound error that is not retried.
+//
+// Parameters:
+//
+//	ctx context.Context: Context for cancellation and timeout.
+//	clientset kubernetes.Interface: A Kubernetes clientset for interacting with the Kubernetes API.
+//	namespace string: The namespace of the Secret.
+//	secretName string: The name of the Secret.
+//	results chan<- string: A channel to send operation results for logging.
+//	logger *zap.Logger: A logger for structured logging.
+//
+// Returns an error if the Secret cannot be read.
+func GetSecretKeys(ctx context.Context, clientset kubernetes.Interface, namespace, secretName string, results chan<- string, logger *zap.Logger) error {
+	secret, err := clientset.CoreV1().Secrets(namespace).Get(ctx, secretName, v1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return reportConfigKeysFailure(results, secretName, markNonRetriable(fmt.Errorf(language.ErrorSecretNotFound, secretName, namespace, err)))
+	}
+	if err != nil {
+		return reportConfigKeysFailure(results, secretName, err)
+	}
+
+	keys := make([]string, 0, len(secret.Data))
+	for key := range secret.Data {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	message := fmt.Sprintf(language.SecretKeysReport, secretName, secret.Type, strings.Join(keys, ", "))
+	results <- message
+	navigator.LogInfoWithEmoji(constant.SuccessEmoji, message, zap.String(language.SecretName, secretName), zap.Strings(language.SecretKeys, keys))
+	return nil
+}
+
+// reportConfigKeysFailure sends an error message to the results channel, logs the failure,
+// and returns the error so callers can propagate it directly.
+//
+// This unexported function is used internally by GetConfigMapKeys and GetSecretKeys to report failures.
+func reportConfigKeysFailure(results chan<- string, name string, err error) error {
+	errorMessage := fmt.Sprintf(language.ErrorFailedToReadKeys, name, err)
+	results <- errorMessage
+	navigator.LogErrorWithEmojiRateLimited(constant.ErrorEmoji, errorMessage, zap.Error(err))
+	return err
+}
@@ -28,6 +28,17 @@
 //	    fmt.Printf("Task: %+v\n", task)
 //	}
 //
+// Retry delays can be bounded at load time with SetRetryDelayBounds, either clamping values such as
+// '24h' or '1ns' to the nearest bound or rejecting them:
+//
+//	configuration.SetRetryDelayBounds(configuration.RetryDelayBounds{
+//	    Min:    100 * time.Millisecond,
+//	    Max:    5 * time.Minute,
+//	    Policy: configuration.RetryDelayReject,
+//	})
+//
+// A task that omits retryDelay but sets maxRetries above one is retried every DefaultRetryDelay.
+//
 // Important Note:
 // Always validate task configurations after loading to prevent issues during runtime.
 // The package is designed to be flexible and extensible, allowing for additional task
@@ -0,0 +1,71 @@
+package configuration
+
+import (
+	"fmt"
+	"strings"
+	"text/template"
+
+	"github.com/H0llyW00dzZ/K8sBlackPearl/language"
+)
+
+// MessageData is the data available to the 'successMessage' and 'failureMessage' templates of a task.
+// For example, "{{.Name}} done for ticket {{.Parameters.ticket}}" renders the task name and the
+// value of its 'ticket' parameter.
+type MessageData struct {
+	// Name is the name of the task.
+	Name string
+	// Type is the task type.
+	Type string
+	// Namespace is the namespace the task ran in.
+	Namespace string
+	// WorkerIndex is the index of the worker that ran the task.
+	WorkerIndex int
+	// Attempts is the number of attempts made.
+	Attempts int
+	// Error is the final error message; it is empty for successful tasks.
+	Error string
+	// Parameters are the task parameters as configured, before any secret references are resolved.
+	Parameters map[string]interface{}
+}
+
+// parseMessageTemplate parses a message template, naming it after the task for clearer errors.
+func parseMessageTemplate(name, text string) (*template.Template, error) {
+	return template.New(name).Parse(text)
+}
+
+// validateMessageTemplates checks that the optional message templates of a task parse correctly,
+// so that malformed templates are rejected when the tasks are loaded rather than when they finish.
+func validateMessageTemplates(task Task) error {
+	for _, text := range []string{task.SuccessMessage, task.FailureMessage} {
+		if text == "" {
+			continue
+		}
+		if _, err := parseMessageTemplate(task.Name, text); err != nil {
+			return fmt.Errorf(language.ErrorInvalidMessageTemplate, err)
+		}
+	}
+	return nil
+}
+
+// RenderMessage renders a task message template with the given data.
+//
+// Parameters:
+//
+//	text string: The template text, such as a task's SuccessMessage or FailureMessage.
+//	data MessageData: The values available to the template.
+//
+// Returns:
+//
+//	string: The rendered message.
+//	error: An error if the template cannot be parsed or executed.
+func RenderMessage(text string, data MessageData) (string, error) {
+	tmpl, err := parseMessageTemplate(data.Name, text)
+	if err != nil {
+		return "", fmt.Errorf(language.ErrorInvalidMessageTemplate, err)
+	}
+	var rendered strings.Builder
+	if err := tmpl.Execute(&rendered, data); err != nil {
+		return "", fmt.Errorf(language.ErrorRenderingMessageTemplate, err)
+	}
+	return rendered.String(), nil
+}
@@ -0,0 +1,92 @@
+package configuration
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/H0llyW00dzZ/K8sBlackPearl/language"
+	"github.com/H0llyW00dzZ/K8sBlackPearl/navigator"
+	"go.uber.org/zap"
+)
+
+// RetryDelayPolicy selects what happens to a retryDelay outside the configured bounds.
+type RetryDelayPolicy int
+
+const (
+	// RetryDelayClamp brings an out-of-bounds delay to the nearest bound and logs a warning.
+	RetryDelayClamp RetryDelayPolicy = iota
+	// RetryDelayReject fails loading the tasks when a delay is out of bounds.
+	RetryDelayReject
+)
+
+// DefaultRetryDelay is the delay used for a task that configures retries without a retryDelay.
+const DefaultRetryDelay = 5 * time.Second
+
+// RetryDelayBounds limits the retryDelay accepted when tasks are loaded. A zero Min or Max leaves
+// that side unbounded, so the zero value applies no bounds at all.
+type RetryDelayBounds struct {
+	// Min is the smallest accepted delay.
+	Min time.Duration
+	// Max is the largest accepted delay.
+	Max time.Duration
+	// Policy selects whether out-of-bounds delays are clamped or rejected.
+	Policy RetryDelayPolicy
+}
+
+// retryDelayBounds are the bounds applied by parseTasks; the zero value disables them.
+var (
+	retryDelayBounds   RetryDelayBounds
+	retryDelayBoundsMu sync.RWMutex
+)
+
+// SetRetryDelayBounds sets the bounds applied to the retryDelay of every task loaded afterwards,
+// in a thread-safe manner. Passing the zero value removes the bounds, which is the default.
+func SetRetryDelayBounds(bounds RetryDelayBounds) {
+	retryDelayBoundsMu.Lock()
+	retryDelayBounds = bounds
+	retryDelayBoundsMu.Unlock()
+}
+
+// applyRetryDelayBounds checks a task's parsed retry delay against the configured bounds and
+// either clamps it, logging a warning, or rejects it, depending on the policy.
+//
+// This unexported function is used internally by parseTasks.
+func applyRetryDelayBounds(taskName string, delay time.Duration) (time.Duration, error) {
+	retryDelayBoundsMu.RLock()
+	bounds := retryDelayBounds
+	retryDelayBoundsMu.RUnlock()
+
+	bounded := delay
+	if bounds.Min > 0 && delay < bounds.Min {
+		bounded = bounds.Min
+	}
+	if bounds.Max > 0 && delay > bounds.Max {
+		bounded = bounds.Max
+	}
+	if bounded == delay {
+		return delay, nil
+	}
+
+	if bounds.Policy == RetryDelayReject {
+		return 0, fmt.Errorf(language.ErrorRetryDelayOutOfBounds, delay, bounds.Min, bounds.Max)
+	}
+	navigator.LogErrorWithEmojiRateLimited(language.WarningEmoji, fmt.Sprintf(language.RetryDelayClamped, taskName, delay, bounded),
+		zap.String(language.Task_Name, taskName))
+	return bounded, nil
+}
+
+// parseRetryDelay parses a task's retryDelay. An empty retryDelay is not an error: it defaults to
+// DefaultRetryDelay when the task is retried, that is when maxRetries is greater than one, and to
+// zero otherwise, since the delay is then never used. Only an explicit, malformed value is rejected.
+//
+// This unexported function is used internally by parseTasks.
+func parseRetryDelay(task Task) (time.Duration, error) {
+	if task.RetryDelay != "" {
+		return ParseDuration(task.RetryDelay)
+	}
+	if task.MaxRetries > 1 {
+		return DefaultRetryDelay, nil
+	}
+	return 0, nil
+}
@@ -0,0 +1,68 @@
+package configuration
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/H0llyW00dzZ/K8sBlackPearl/navigator"
+	"go.uber.org/zap"
+)
+
+// setRetryDelayBounds applies the bounds for the duration of the test.
+func setRetryDelayBounds(t *testing.T, bounds RetryDelayBounds) {
+	t.Helper()
+	navigator.SetLogger(zap.NewNop())
+	SetRetryDelayBounds(bounds)
+	t.Cleanup(func() { SetRetryDelayBounds(RetryDelayBounds{}) })
+}
+
+// boundsTestTasks returns tasks with a delay below, above, and within one second to one minute.
+func boundsTestTasks() []Task {
+	return []Task{
+		{Name: "hasty", Type: "CrewGetPods", MaxRetries: 3, RetryDelay: "1ns"},
+		{Name: "sleepy", Type: "CrewGetPods", MaxRetries: 3, RetryDelay: "24h"},
+		{Name: "steady", Type: "CrewGetPods", MaxRetries: 3, RetryDelay: "5s"},
+	}
+}
+
+func TestParseTasksClampsOutOfBoundsRetryDelays(t *testing.T) {
+	setRetryDelayBounds(t, RetryDelayBounds{Min: time.Second, Max: time.Minute, Policy: RetryDelayClamp})
+
+	tasks, err := parseTasks(boundsTestTasks())
+	if err != nil {
+		t.Fatalf("parseTasks: %v", err)
+	}
+	for i, want := range []time.Duration{time.Second, time.Minute, 5 * time.Second} {
+		if got := tasks[i].RetryDelayDuration; got != want {
+			t.Fatalf("task %s got delay %v, want %v", tasks[i].Name, got, want)
+		}
+	}
+}
+
+func TestParseTasksRejectsOutOfBoundsRetryDelays(t *testing.T) {
+	setRetryDelayBounds(t, RetryDelayBounds{Min: time.Second, Max: time.Minute, Policy: RetryDelayReject})
+
+	_, err := parseTasks(boundsTestTasks())
+	if err == nil {
+		t.Fatal("parseTasks accepted out-of-bounds delays")
+	}
+	for _, name := range []string{"hasty", "sleepy"} {
+		if !strings.Contains(err.Error(), name) {
+			t.Fatalf("the error %q does not name task %s", err, name)
+		}
+	}
+	if strings.Contains(err.Error(), "steady") {
+		t.Fatalf("the error %q names the task within bounds", err)
+	}
+}
+
+func TestParseTasksWithoutBoundsKeepsRetryDelays(t *testing.T) {
+	tasks, err := parseTasks(boundsTestTasks())
+	if err != nil {
+		t.Fatalf("parseTasks: %v", err)
+	}
+	if tasks[0].RetryDelayDuration != time.Nanosecond || tasks[1].RetryDelayDuration != 24*time.Hour {
+		t.Fatalf("got delays %v and %v, want them unchanged without bounds", tasks[0].RetryDelayDuration, tasks[1].RetryDelayDuration)
+	}
+}
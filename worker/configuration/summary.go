@@ -0,0 +1,86 @@
+package configuration
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/H0llyW00dzZ/K8sBlackPearl/language"
+)
+
+// TaskSummary aggregates a loaded task list into the counts operators check before execution.
+type TaskSummary struct {
+	// Total is the number of tasks.
+	Total int
+	// ByType counts the tasks of each type.
+	ByType map[string]int
+	// ByNamespace counts the tasks targeting each namespace.
+	ByNamespace map[string]int
+	// MissingRetrySettings counts the tasks without a positive maxRetries or without a retryDelay.
+	MissingRetrySettings int
+	// Concerns describes tasks that are likely misconfigured, such as missing names, types, or
+	// namespaces, and duplicate names.
+	Concerns []string
+}
+
+// NewTaskSummary builds a TaskSummary for the given tasks.
+func NewTaskSummary(tasks []Task) TaskSummary {
+	summary := TaskSummary{
+		Total:       len(tasks),
+		ByType:      make(map[string]int),
+		ByNamespace: make(map[string]int),
+	}
+	seen := make(map[string]bool, len(tasks))
+	for i, task := range tasks {
+		summary.ByType[task.Type]++
+		summary.ByNamespace[task.ShipsNamespace]++
+		if task.MaxRetries <= 0 || task.RetryDelay == "" {
+			summary.MissingRetrySettings++
+		}
+
+		switch {
+		case task.Name == "":
+			summary.Concerns = append(summary.Concerns, fmt.Sprintf(language.ConcernTaskWithoutName, i))
+		case seen[task.Name]:
+			summary.Concerns = append(summary.Concerns, fmt.Sprintf(language.ConcernDuplicateTaskName, task.Name))
+		}
+		seen[task.Name] = true
+		if task.Type == "" {
+			summary.Concerns = append(summary.Concerns, fmt.Sprintf(language.ConcernTaskWithoutType, task.Name))
+		}
+		if task.ShipsNamespace == "" {
+			summary.Concerns = append(summary.Concerns, fmt.Sprintf(language.ConcernTaskWithoutNamespace, task.Name))
+		}
+	}
+	return summary
+}
+
+// String renders the summary on a single line, with counts sorted by key so the output is stable.
+func (s TaskSummary) String() string {
+	return fmt.Sprintf(language.TaskSummaryLine, s.Total, formatCounts(s.ByType), formatCounts(s.ByNamespace), s.MissingRetrySettings, len(s.Concerns))
+}
+
+// SummarizeTasks returns a one-line summary of the tasks: counts by type and namespace, the number of
+// tasks missing retry settings, and the number of validation concerns.
+func SummarizeTasks(tasks []Task) string {
+	return NewTaskSummary(tasks).String()
+}
+
+// formatCounts renders a map of counts as "key=count" pairs sorted by key.
+func formatCounts(counts map[string]int) string {
+	keys := make([]string, 0, len(counts))
+	for key := range counts {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	pairs := make([]string, 0, len(keys))
+	for _, key := range keys {
+		name := key
+		if name == "" {
+			name = language.Unset
+		}
+		pairs = append(pairs, fmt.Sprintf("%s=%d", name, counts[key]))
+	}
+	return strings.Join(pairs, ", ")
+}
@@ -0,0 +1,43 @@
+package configuration
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSummarizeMixedTasks(t *testing.T) {
+	tasks := []Task{
+		{Name: "scale-api", Type: "CrewScaleDeployments", ShipsNamespace: "default", MaxRetries: 3, RetryDelay: "1s"},
+		{Name: "label-pods", Type: "CrewWriteLabelPods", ShipsNamespace: "default", MaxRetries: 3, RetryDelay: "1s"},
+		{Name: "scale-api", Type: "CrewScaleDeployments", ShipsNamespace: "crew", RetryDelay: "1s"},
+		{Name: "", Type: "CrewGetPods", ShipsNamespace: "crew", MaxRetries: 1},
+		{Name: "orphan", Type: "", ShipsNamespace: ""},
+	}
+
+	summary := NewTaskSummary(tasks)
+
+	if summary.Total != 5 || summary.MissingRetrySettings != 3 {
+		t.Fatalf("got %d tasks with %d missing retry settings, want 5 and 3", summary.Total, summary.MissingRetrySettings)
+	}
+	if want := map[string]int{"CrewScaleDeployments": 2, "CrewWriteLabelPods": 1, "CrewGetPods": 1, "": 1}; !reflect.DeepEqual(summary.ByType, want) {
+		t.Fatalf("got counts by type %v, want %v", summary.ByType, want)
+	}
+	if want := map[string]int{"default": 2, "crew": 2, "": 1}; !reflect.DeepEqual(summary.ByNamespace, want) {
+		t.Fatalf("got counts by namespace %v, want %v", summary.ByNamespace, want)
+	}
+	wantConcerns := []string{
+		"task name 'scale-api' is used more than once",
+		"task at index 3 has no name",
+		"task 'orphan' has no type",
+		"task 'orphan' has no shipsNamespace",
+	}
+	if !reflect.DeepEqual(summary.Concerns, wantConcerns) {
+		t.Fatalf("got concerns %q, want %q", summary.Concerns, wantConcerns)
+	}
+
+	want := "Loaded 5 task(s); by type: [<unset>=1, CrewGetPods=1, CrewScaleDeployments=2, CrewWriteLabelPods=1]; " +
+		"by namespace: [<unset>=1, crew=2, default=2]; missing retry settings: 3; concerns: 4"
+	if got := SummarizeTasks(tasks); got != want {
+		t.Fatalf("got summary %q, want %q", got, want)
+	}
+}
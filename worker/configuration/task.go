@@ -2,6 +2,7 @@ package configuration
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -35,6 +36,20 @@ type Task struct {
 	Type string `json:"type" yaml:"type"`
 	// Parameters is a map of key-value pairs that provide additional details required to execute the task.
 	Parameters map[string]interface{} `json:"parameters" yaml:"parameters"`
+	// SuccessMessage is an optional text/template rendered with MessageData in place of the default success message.
+	SuccessMessage string `json:"successMessage,omitempty" yaml:"successMessage,omitempty"`
+	// FailureMessage is an optional text/template rendered with MessageData in place of the default failure message.
+	FailureMessage string `json:"failureMessage,omitempty" yaml:"failureMessage,omitempty"`
+	// IdempotencyKey optionally identifies the work done by the task; once a task with this key succeeds,
+	// tasks declaring the same key are skipped, across restarts when a persistent CompletionStore is set.
+	IdempotencyKey string `json:"idempotencyKey,omitempty" yaml:"idempotencyKey,omitempty"`
+	// ContinueOnError marks a best-effort task: when it fails after all retries, the failure is reported
+	// as a warning instead of a failure, so it does not fail the overall run.
+	ContinueOnError bool `json:"continueOnError,omitempty" yaml:"continueOnError,omitempty"`
+	// Tags are arbitrary key-value pairs, such as environment, team, or ticket, attached as structured
+	// fields to every log entry of the task and to its API latency records, where they serve as metric
+	// labels. Every distinct value creates a new series in a metrics backend, so keep values low-cardinality.
+	Tags map[string]string `json:"tags,omitempty" yaml:"tags,omitempty"`
 }
 
 // LoadTasksFromJSON reads a JSON file from the provided file path, unmarshals it into a slice of Task structs,
@@ -92,16 +107,55 @@ func unmarshalYAML(file []byte, tasks *[]Task) error {
 }
 
 // parseTasks iterates through a slice of tasks, parsing the RetryDelay string into a time.Duration
-// and updating the RetryDelayDuration field for each task. It returns the updated slice of tasks
-// and any error that occurs during the parsing of the retry delay.
+// and updating the RetryDelayDuration field for each task. An empty RetryDelay is defaulted rather
+// than rejected, as described by parseRetryDelay, and a RetryDelay shared by several tasks is only
+// parsed once. It also checks that every task has a type and validates the optional message
+// templates. Every task is checked even after a problem is found, so the returned error lists each
+// offending task, with its index and name, at once. It returns the updated slice of tasks, or nil
+// and the aggregated error.
 func parseTasks(tasks []Task) ([]Task, error) {
+	var errs []error
+	durations := make(map[string]time.Duration)
 	for i, task := range tasks {
-		duration, err := ParseDuration(task.RetryDelay)
-		if err != nil {
-			// Wrapping the error with the task name to provide context.
-			return nil, fmt.Errorf("%s: %w", task.Name, err)
+		// Wrapping every error with the task index and name to provide context.
+		invalid := func(err error) {
+			errs = append(errs, fmt.Errorf(language.ErrorInvalidTask, i, task.Name, err))
+		}
+
+		if task.Type == "" {
+			invalid(errors.New(language.ErrorTaskTypeMissing))
+		}
+		if err := validateMessageTemplates(task); err != nil {
+			invalid(err)
+		}
+
+		duration, cached := durations[task.RetryDelay]
+		if !cached {
+			var err error
+			if duration, err = parseRetryDelay(task); err != nil {
+				invalid(err)
+				continue
+			}
+			if task.RetryDelay != "" {
+				durations[task.RetryDelay] = duration
+			}
+		}
+		// A task that is never retried and sets no delay has nothing to bound.
+		if task.RetryDelay != "" || task.MaxRetries > 1 {
+			var err error
+			if duration, err = applyRetryDelayBounds(task.Name, duration); err != nil {
+				invalid(err)
+				continue
+			}
 		}
 		tasks[i].RetryDelayDuration = duration
+		if task.RetryDelay == "" {
+			// Record the defaulted delay, so runners parsing RetryDelay see the same value.
+			tasks[i].RetryDelay = duration.String()
+		}
+	}
+	if len(errs) > 0 {
+		return nil, fmt.Errorf(language.ErrorInvalidTasks, len(errs), errors.Join(errs...))
 	}
 	return tasks, nil
 }
@@ -0,0 +1,55 @@
+package configuration
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseTasksDefaultsAnOmittedRetryDelay(t *testing.T) {
+	tasks, err := parseTasks([]Task{
+		{Name: "retried", Type: "CrewGetPods", MaxRetries: 3, RetryDelay: ""},
+		{Name: "once", Type: "CrewGetPods", MaxRetries: 1},
+		{Name: "explicit", Type: "CrewGetPods", MaxRetries: 3, RetryDelay: "2s"},
+	})
+	if err != nil {
+		t.Fatalf("parseTasks: %v", err)
+	}
+	for i, want := range []time.Duration{DefaultRetryDelay, 0, 2 * time.Second} {
+		if got := tasks[i].RetryDelayDuration; got != want {
+			t.Fatalf("task %s got delay %v, want %v", tasks[i].Name, got, want)
+		}
+	}
+}
+
+func TestParseTasksRejectsAMalformedRetryDelay(t *testing.T) {
+	if _, err := parseTasks([]Task{{Name: "typo", Type: "CrewGetPods", MaxRetries: 3, RetryDelay: "soon"}}); err == nil {
+		t.Fatal("parseTasks accepted a malformed retryDelay")
+	}
+}
+
+func TestParseTasksReportsEveryInvalidTask(t *testing.T) {
+	_, err := parseTasks([]Task{
+		{Name: "steady", Type: "CrewGetPods", MaxRetries: 3, RetryDelay: "5s"},
+		{Name: "vague", Type: "CrewGetPods", MaxRetries: 3, RetryDelay: "soon"},
+		{Name: "typeless", MaxRetries: 3, RetryDelay: "5s"},
+		{Name: "astronomer", Type: "CrewGetPods", MaxRetries: 3, RetryDelay: "5 parsecs"},
+		{Name: "again", Type: "CrewGetPods", MaxRetries: 3, RetryDelay: "soon"},
+	})
+	if err == nil {
+		t.Fatal("parseTasks accepted invalid tasks")
+	}
+
+	message := err.Error()
+	if !strings.HasPrefix(message, "4 task problem(s) found:") {
+		t.Fatalf("got error %q, want four problems counted", message)
+	}
+	for _, want := range []string{"task 1 (vague)", "task 2 (typeless)", "task 3 (astronomer)", "task 4 (again)"} {
+		if !strings.Contains(message, want) {
+			t.Fatalf("error %q does not report %s", message, want)
+		}
+	}
+	if strings.Contains(message, "steady") {
+		t.Fatalf("error %q reports the valid task", message)
+	}
+}
@@ -0,0 +1,51 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/H0llyW00dzZ/K8sBlackPearl/worker/configuration"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestContinueOnErrorFailureDoesNotFailTheRun(t *testing.T) {
+	registerTestRunner(t, "TestBestEffort", func(_ context.Context, _ kubernetes.Interface, _ string, _ configuration.Task, _ map[string]interface{}, _ int) error {
+		return markNonRetriable(errors.New("nothing to clean up"))
+	})
+	registerTestRunner(t, "TestSucceed", func(_ context.Context, _ kubernetes.Interface, _ string, _ configuration.Task, _ map[string]interface{}, _ int) error {
+		return nil
+	})
+	tasks := []configuration.Task{
+		{Name: "cleanup", Type: "TestBestEffort", ShipsNamespace: "default", MaxRetries: 2, RetryDelay: "1ms", ContinueOnError: true},
+		{Name: "scale", Type: "TestSucceed", ShipsNamespace: "default", MaxRetries: 2, RetryDelay: "1ms"},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	results, err := RunContinuous(ctx, fake.NewSimpleClientset(), tasks, 1, time.Hour)
+	if err != nil {
+		t.Fatalf("RunContinuous: %v", err)
+	}
+	var cycle []string
+	for result := range results {
+		cycle = append(cycle, result)
+		if strings.HasPrefix(result, "Reconcile cycle") {
+			cancel()
+		}
+	}
+
+	summary := cycle[len(cycle)-1]
+	if !strings.Contains(summary, "1 succeeded, 0 failed, 1 warning(s)") {
+		t.Fatalf("got summary %q, want the best-effort failure counted as a warning", summary)
+	}
+	if outcome := taskOutcome(tasks[0], errors.New("failed")); outcome != "warning" {
+		t.Fatalf("got outcome %q for a continueOnError failure, want warning", outcome)
+	}
+	if outcome := taskOutcome(tasks[1], errors.New("failed")); outcome != "failure" {
+		t.Fatalf("got outcome %q for a regular failure, want failure", outcome)
+	}
+}
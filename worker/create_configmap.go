@@ -0,0 +1,127 @@
+package worker
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+
+	"github.com/H0llyW00dzZ/K8sBlackPearl/language"
+	"github.com/H0llyW00dzZ/K8sBlackPearl/navigator"
+	"github.com/H0llyW00dzZ/go-urlshortner/logmonitor/constant"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/util/retry"
+)
+
+// CreateConfigMap creates a ConfigMap holding string data and binary data. If it already exists and
+// overwrite is enabled, its data and binary data are replaced, retrying on conflicts.
+//
+// Parameters:
+//
+//	ctx context.Context: Context for cancellation and timeout.
+//	clientset kubernetes.Interface: A Kubernetes clientset for interacting with the Kubernetes API.
+//	namespace string: The namespace in which to create the ConfigMap.
+//	configMap *corev1.ConfigMap: The ConfigMap to create.
+//	overwrite bool: Whether the data of an existing ConfigMap should be replaced.
+//	results chan<- string: A channel to send operation results for logging.
+//	logger *zap.Logger: A logger for structured logging.
+//
+// Returns an error if the ConfigMap cannot be created or overwritten.
+func CreateConfigMap(ctx context.Context, clientset kubernetes.Interface, namespace string, configMap *corev1.ConfigMap, overwrite bool, results chan<- string, logger *zap.Logger) error {
+	name := configMap.Name
+	applyDefaultObjectMeta(configMap)
+	created, err := clientset.CoreV1().ConfigMaps(namespace).Create(ctx, configMap, v1.CreateOptions{})
+	if err == nil {
+		publishCreatedObject(ctx, created)
+		successMsg := fmt.Sprintf(language.ConfigMapSuccessfullyCreated, name, namespace, len(configMap.Data), len(configMap.BinaryData))
+		results <- successMsg
+		navigator.LogInfoWithEmoji(constant.SuccessEmoji, successMsg)
+		return nil
+	}
+
+	if !apierrors.IsAlreadyExists(err) {
+		return reportConfigMapFailure(results, name, fmt.Errorf(language.ErrorCreatingConfigMap, err))
+	}
+	if !overwrite {
+		return reportConfigMapFailure(results, name, fmt.Errorf(language.ErrorConfigMapAlreadyExists, name))
+	}
+
+	err = retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		current, getErr := clientset.CoreV1().ConfigMaps(namespace).Get(ctx, name, v1.GetOptions{})
+		if getErr != nil {
+			return getErr
+		}
+		current.Data = configMap.Data
+		current.BinaryData = configMap.BinaryData
+		_, updateErr := clientset.CoreV1().ConfigMaps(namespace).Update(ctx, current, v1.UpdateOptions{})
+		return updateErr
+	})
+	if err != nil {
+		return reportConfigMapFailure(results, name, err)
+	}
+
+	successMsg := fmt.Sprintf(language.ConfigMapSuccessfullyUpdated, name, namespace, len(configMap.Data), len(configMap.BinaryData))
+	results <- successMsg
+	navigator.LogInfoWithEmoji(constant.SuccessEmoji, successMsg)
+	return nil
+}
+
+// reportConfigMapFailure sends an error message to the results channel, logs the failure,
+// and returns the error so callers can propagate it directly.
+//
+// This unexported function is used internally by CreateConfigMap to report failures.
+func reportConfigMapFailure(results chan<- string, name string, err error) error {
+	errorMessage := fmt.Sprintf(language.ErrorFailedToCreateConfigMap, name, err)
+	results <- errorMessage
+	navigator.LogErrorWithEmojiRateLimited(constant.ErrorEmoji, errorMessage, zap.Error(err))
+	return err
+}
+
+// extractConfigMapParameters extracts and validates the 'configMapName', 'data', 'binaryData', and
+// 'overwrite' parameters. 'data' maps keys to strings and 'binaryData' maps keys to base64-encoded
+// values, which are decoded here; at least one of them must be given, and a key may not appear in both.
+//
+// This function is used by task runners that create ConfigMaps.
+func extractConfigMapParameters(parameters map[string]interface{}) (*corev1.ConfigMap, bool, error) {
+	name, err := getParamAsString(parameters, configMapNamE)
+	if err != nil || name == "" {
+		return nil, false, newParameterError(configMapNamE, err, language.ErrorParameterMissing, configMapNamE)
+	}
+
+	configMap := &corev1.ConfigMap{ObjectMeta: v1.ObjectMeta{Name: name}}
+	if _, exists := parameters[datA]; exists {
+		if configMap.Data, err = getParamAsStringMap(parameters, datA); err != nil {
+			return nil, false, err
+		}
+	}
+	if _, exists := parameters[binaryDatA]; exists {
+		encoded, err := getParamAsStringMap(parameters, binaryDatA)
+		if err != nil {
+			return nil, false, err
+		}
+		configMap.BinaryData = make(map[string][]byte, len(encoded))
+		for key, value := range encoded {
+			decoded, err := base64.StdEncoding.DecodeString(value)
+			if err != nil {
+				return nil, false, newParameterError(binaryDatA, err, language.ErrorInvalidBinaryData, key, err)
+			}
+			if _, duplicate := configMap.Data[key]; duplicate {
+				return nil, false, newParameterError(binaryDatA, nil, language.ErrorDuplicateConfigMapKey, key)
+			}
+			configMap.BinaryData[key] = decoded
+		}
+	}
+	if len(configMap.Data) == 0 && len(configMap.BinaryData) == 0 {
+		return nil, false, newParameterError(datA, nil, language.ErrorConfigMapDataMissing)
+	}
+
+	overwrite, err := getOptionalParamAsBool(parameters, overwritE, false)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return configMap, overwrite, nil
+}
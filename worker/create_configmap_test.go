@@ -0,0 +1,65 @@
+package worker
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/H0llyW00dzZ/K8sBlackPearl/worker/configuration"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestCrewCreateConfigMapDecodesBinaryData(t *testing.T) {
+	clientset := fake.NewSimpleClientset()
+	parameters := map[string]interface{}{
+		"configMapName": "certs",
+		"data":          map[string]interface{}{"mode": "strict"},
+		"binaryData":    map[string]interface{}{"ca.der": "AAH/fw=="},
+	}
+	task := configuration.Task{Name: "certs", Type: "CrewCreateConfigMap", Parameters: parameters}
+
+	if err := (&CrewCreateConfigMap{}).Run(context.Background(), clientset, "default", task, parameters, 0); err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+
+	configMap, err := clientset.CoreV1().ConfigMaps("default").Get(context.Background(), "certs", v1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := configMap.BinaryData["ca.der"]; !bytes.Equal(got, []byte{0x00, 0x01, 0xff, 0x7f}) {
+		t.Fatalf("got binary data %v, want the decoded bytes", got)
+	}
+	if configMap.Data["mode"] != "strict" {
+		t.Fatalf("got data %v, want the string data kept", configMap.Data)
+	}
+}
+
+func TestCrewCreateConfigMapRejectsInvalidBinaryData(t *testing.T) {
+	for name, tc := range map[string]struct {
+		parameters map[string]interface{}
+		wantInErr  string
+	}{
+		"invalid base64": {
+			parameters: map[string]interface{}{"configMapName": "certs", "binaryData": map[string]interface{}{"ca.der": "not base64!"}},
+			wantInErr:  "ca.der",
+		},
+		"key in both data and binaryData": {
+			parameters: map[string]interface{}{"configMapName": "certs", "data": map[string]interface{}{"ca.der": "x"}, "binaryData": map[string]interface{}{"ca.der": "AAH/fw=="}},
+			wantInErr:  "ca.der",
+		},
+	} {
+		clientset := fake.NewSimpleClientset()
+		task := configuration.Task{Name: "certs", Type: "CrewCreateConfigMap", Parameters: tc.parameters}
+
+		err := (&CrewCreateConfigMap{}).Run(context.Background(), clientset, "default", task, tc.parameters, 0)
+		if !errors.Is(err, ErrInvalidParameter) || !strings.Contains(err.Error(), tc.wantInErr) {
+			t.Fatalf("%s: got error %v, want an invalid parameter error naming %q", name, err, tc.wantInErr)
+		}
+		if actions := clientset.Actions(); len(actions) != 0 {
+			t.Fatalf("%s: got %d API calls, want the task rejected before any", name, len(actions))
+		}
+	}
+}
@@ -0,0 +1,189 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/H0llyW00dzZ/K8sBlackPearl/language"
+	"github.com/H0llyW00dzZ/K8sBlackPearl/navigator"
+	"github.com/H0llyW00dzZ/go-urlshortner/logmonitor/constant"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// podSpecParameters holds the pod definition and wait settings extracted from task parameters.
+type podSpecParameters struct {
+	pod     *corev1.Pod
+	wait    bool
+	timeout time.Duration
+}
+
+// CreatePod creates a pod in the given namespace. When wait is set, it then polls the pod until it is
+// running with all containers ready, as determined by CrewCheckingisPodHealthy, or until the timeout
+// elapses. A pod that reaches the Failed phase while waiting is reported immediately.
+//
+// Parameters:
+//
+//	ctx context.Context: Context for cancellation and timeout.
+//	clientset kubernetes.Interface: A Kubernetes clientset for interacting with the Kubernetes API.
+//	namespace string: The namespace in which to create the pod.
+//	pod *corev1.Pod: The pod to create.
+//	wait bool: Whether to wait for the pod to become ready.
+//	timeout time.Duration: The maximum time to wait when wait is set.
+//	results chan<- string: A channel to send operation results for logging.
+//	logger *zap.Logger: A logger for structured logging.
+//
+// Returns an error if the pod cannot be created or does not become ready in time.
+func CreatePod(ctx context.Context, clientset kubernetes.Interface, namespace string, pod *corev1.Pod, wait bool, timeout time.Duration, results chan<- string, logger *zap.Logger) error {
+	applyDefaultObjectMeta(pod)
+	created, err := clientset.CoreV1().Pods(namespace).Create(ctx, pod, v1.CreateOptions{})
+	if err != nil {
+		return reportCreatePodFailure(results, pod.Name, fmt.Errorf(language.ErrorCreatingPod, err))
+	}
+	publishCreatedObject(ctx, created)
+
+	if wait {
+		if err := waitForPodReady(ctx, clientset, namespace, pod.Name, timeout); err != nil {
+			return reportCreatePodFailure(results, pod.Name, err)
+		}
+	}
+
+	successMsg := fmt.Sprintf(language.PodCreatedSuccessfully, pod.Name, namespace)
+	if wait {
+		successMsg = fmt.Sprintf(language.PodCreatedAndReady, pod.Name, namespace)
+	}
+	results <- successMsg
+	navigator.LogInfoWithEmoji(constant.SuccessEmoji, successMsg)
+	return nil
+}
+
+// waitForPodReady polls the pod until CrewCheckingisPodHealthy reports it as healthy, the pod fails,
+// or the timeout elapses.
+//
+// This unexported function is used internally by CreatePod.
+func waitForPodReady(ctx context.Context, clientset kubernetes.Interface, namespace, podName string, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	for {
+		pod, err := clientset.CoreV1().Pods(namespace).Get(ctx, podName, v1.GetOptions{})
+		if err != nil && ctx.Err() == nil {
+			return err
+		}
+		if err == nil {
+			if CrewCheckingisPodHealthy(pod) {
+				return nil
+			}
+			if pod.Status.Phase == corev1.PodFailed {
+				return markNonRetriable(fmt.Errorf(language.ErrorPodFailedWhileWaiting, podName, pod.Status.Reason))
+			}
+		}
+		if !waitForNextAttempt(ctx, podPollInterval) {
+			return fmt.Errorf(language.ErrorPodNotReadyInTime, podName, timeout)
+		}
+	}
+}
+
+// reportCreatePodFailure sends an error message to the results channel, logs the failure,
+// and returns the error so callers can propagate it directly.
+//
+// This unexported function is used internally by CreatePod to report failures.
+func reportCreatePodFailure(results chan<- string, podName string, err error) error {
+	errorMessage := fmt.Sprintf(language.ErrorFailedToCreatePod, podName, err)
+	results <- errorMessage
+	navigator.LogErrorWithEmojiRateLimited(constant.ErrorEmoji, errorMessage, zap.Error(err))
+	return err
+}
+
+// extractCreatePodParameters extracts and validates the pod definition from a map of parameters.
+// 'podName' and 'image' are required; 'command', 'labels', 'env', 'restartPolicy', 'wait', and
+// 'timeout' are optional. The restart policy must be 'Always', 'OnFailure', or 'Never'.
+//
+// This function is used by task runners that create pods.
+func extractCreatePodParameters(parameters map[string]interface{}) (podSpecParameters, error) {
+	podName, err := getParamAsString(parameters, language.PodName)
+	if err != nil || podName == "" {
+		return podSpecParameters{}, newParameterError(language.PodName, err, language.ErrorParameterMissing, language.PodName)
+	}
+	image, err := getParamAsString(parameters, imagE)
+	if err != nil || image == "" {
+		return podSpecParameters{}, newParameterError(imagE, err, language.ErrorParameterMissing, imagE)
+	}
+
+	container := corev1.Container{Name: podName, Image: image}
+
+	if _, exists := parameters[commanD]; exists {
+		commandList, err := getParamAsSlice(parameters, commanD)
+		if err != nil {
+			return podSpecParameters{}, err
+		}
+		for _, item := range commandList {
+			arg, ok := item.(string)
+			if !ok {
+				return podSpecParameters{}, newParameterError(commanD, nil, language.ErrorParameterMustBeList, commanD)
+			}
+			container.Command = append(container.Command, arg)
+		}
+	}
+
+	if _, exists := parameters[enV]; exists {
+		env, err := getParamAsStringMap(parameters, enV)
+		if err != nil {
+			return podSpecParameters{}, err
+		}
+		// Sort the names so the container spec is stable across runs.
+		names := make([]string, 0, len(env))
+		for name := range env {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			container.Env = append(container.Env, corev1.EnvVar{Name: name, Value: env[name]})
+		}
+	}
+
+	pod := &corev1.Pod{
+		ObjectMeta: v1.ObjectMeta{Name: podName},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{container},
+		},
+	}
+
+	if _, exists := parameters[labeLs]; exists {
+		pod.Labels, err = getParamAsStringMap(parameters, labeLs)
+		if err != nil {
+			return podSpecParameters{}, err
+		}
+	}
+
+	restartPolicy, err := getOptionalParamAsString(parameters, restartPolicY, "")
+	if err != nil {
+		return podSpecParameters{}, err
+	}
+	if restartPolicy != "" {
+		policy := corev1.RestartPolicy(restartPolicy)
+		if policy != corev1.RestartPolicyAlways && policy != corev1.RestartPolicyOnFailure && policy != corev1.RestartPolicyNever {
+			return podSpecParameters{}, newParameterError(restartPolicY, nil, language.ErrorInvalidRestartPolicy, restartPolicy)
+		}
+		pod.Spec.RestartPolicy = policy
+	}
+
+	wait, err := getOptionalParamAsBool(parameters, waiT, false)
+	if err != nil {
+		return podSpecParameters{}, err
+	}
+	timeoutStr, err := getOptionalParamAsString(parameters, timeouT, defaultWatchTimeout)
+	if err != nil {
+		return podSpecParameters{}, err
+	}
+	timeout, err := time.ParseDuration(timeoutStr)
+	if err != nil || timeout <= 0 {
+		return podSpecParameters{}, newParameterError(timeouT, err, language.ErrorParameterInvalid, timeouT)
+	}
+
+	return podSpecParameters{pod: pod, wait: wait, timeout: timeout}, nil
+}
@@ -2,6 +2,7 @@ package worker
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/H0llyW00dzZ/K8sBlackPearl/language"
@@ -16,80 +17,231 @@ import (
 // performTaskWithRetries to attempt each task with built-in retry logic. If a task fails
 // after the maximum number of retries, it logs the error and sends a failure message through
 // the results channel. Tasks are claimed to prevent duplicate executions, and they can be
-// released if necessary for subsequent retries.
+// released if necessary for subsequent retries. A task referencing the outputs of tasks that have not
+// completed yet is deferred until the worker has gone through the other tasks.
 //
 // Parameters:
 //
 //	ctx context.Context: Context for cancellation and timeout of the worker process.
-//	clientset *kubernetes.Clientset: Kubernetes API client for cluster interactions.
+//	clientset kubernetes.Interface: Kubernetes API client for cluster interactions.
 //	tasks []configuration.Task: List of Task structs, each representing an executable task.
 //	results chan<- string: Channel to return execution results to the caller.
 //	logger *zap.Logger: Logger for structured logging within the worker.
-//	taskStatus *TaskStatusMap: Map to track and control the status of tasks.
+//	claims ClaimStore: Store through which tasks are claimed, such as a TaskStatusMap.
 //	workerIndex int: Identifier for the worker instance for logging.
-func CrewWorker(ctx context.Context, clientset *kubernetes.Clientset, tasks []configuration.Task, results chan<- string, logger *zap.Logger, taskStatus *TaskStatusMap, workerIndex int) {
-	for _, task := range tasks {
-		// Use task.ShipsNamespace for each task's namespace
-		processTask(ctx, clientset, task.ShipsNamespace, task, results, logger, taskStatus, workerIndex)
+func CrewWorker(ctx context.Context, clientset kubernetes.Interface, tasks []configuration.Task, results chan<- string, logger *zap.Logger, claims ClaimStore, workerIndex int) {
+	pending := tasks
+	for len(pending) > 0 {
+		var deferred []configuration.Task
+		for _, task := range pending {
+			// Tasks that have not started yet are left alone once the run is cancelled.
+			if ctx.Err() != nil {
+				return
+			}
+			// A task referencing outputs of tasks that have not completed yet is deferred, so the
+			// worker can run those tasks first.
+			if !taskOutputProducersDone(ctx, task.Parameters) {
+				deferred = append(deferred, task)
+				continue
+			}
+			// Use task.ShipsNamespace for each task's namespace
+			processTask(ctx, clientset, task.ShipsNamespace, task, results, logger, claims, workerIndex)
+		}
+		if len(deferred) == len(pending) {
+			// No deferred task can become ready through this worker, so each waits for the tasks it
+			// references as they are run by other workers.
+			for _, task := range deferred {
+				if ctx.Err() != nil {
+					return
+				}
+				processTask(ctx, clientset, task.ShipsNamespace, task, results, logger, claims, workerIndex)
+			}
+			return
+		}
+		pending = deferred
 	}
 }
 
 // processTask processes an individual task within a Kubernetes namespace. It first attempts to
 // claim the task to prevent duplicate processing. If the claim is successful, it then attempts
 // to perform the task with retries. Depending on the outcome, it either handles a failed task
-// or reports a successful completion.
+// or reports a successful completion. The terminal outcome is also recorded by the audit sink, if set.
+// When the claim store is a ClaimRenewer, the claim is renewed for as long as the task runs.
+// A claimed task whose idempotency key is already recorded by the completion store is skipped, and
+// reported as skipped once. A claimed task first waits for the tasks whose outputs it references to
+// complete, and then for a free slot when SetMaxConcurrentTasks limits concurrent execution.
+// A task that fails because the run deadline expired is reported as interrupted, not as failed, and
+// the error of a task interrupted by any cancellation carries its cause.
 //
 // Parameters:
 //
 //	ctx context.Context: Context for cancellation and timeout of the task processing.
-//	clientset *kubernetes.Clientset: Kubernetes API client for cluster interactions.
+//	clientset kubernetes.Interface: Kubernetes API client for cluster interactions.
 //	shipsNamespace string: Namespace in Kubernetes where the task is executed.
 //	task configuration.Task: The task to be processed.
 //	results chan<- string: Channel to return execution results to the caller.
 //	logger *zap.Logger: Logger for structured logging within the worker.
-//	taskStatus *TaskStatusMap: Map to track and control the status of tasks.
+//	claims ClaimStore: Store through which tasks are claimed, such as a TaskStatusMap.
 //	workerIndex int: Identifier for the worker instance for logging.
-func processTask(ctx context.Context, clientset *kubernetes.Clientset, shipsNamespace string, task configuration.Task, results chan<- string, logger *zap.Logger, taskStatus *TaskStatusMap, workerIndex int) {
-	if !taskStatus.Claim(task.Name) {
+func processTask(ctx context.Context, clientset kubernetes.Interface, shipsNamespace string, task configuration.Task, results chan<- string, logger *zap.Logger, claims ClaimStore, workerIndex int) {
+	if !claims.Claim(ctx, task.Name) {
+		return
+	}
+	stopRenewing := keepClaimed(ctx, claims, task.Name)
+	defer stopRenewing()
+
+	// The completion check follows the claim, so only the worker holding the claim reports the skip.
+	// The claim is kept, as for a completed task, so the other workers never report it again.
+	if isTaskAlreadyCompleted(ctx, task) {
+		skipMessage := fmt.Sprintf(language.TaskWorker_Name, workerIndex, fmt.Sprintf(language.TaskSkippedAlreadyCompleted, task.Name, task.IdempotencyKey))
+		navigator.LogInfoWithEmoji(language.PirateEmoji, skipMessage, zap.String(language.Task_Name, task.Name))
+		recordCycleOutcome(ctx, language.OutcomeSkipped)
+		recordTaskOutputCompletion(ctx, task.Name, nil)
+		results <- formatTaskResult(task, language.OutcomeSkipped, skipMessage, nil)
 		return
 	}
 
-	err := performTaskWithRetries(ctx, clientset, shipsNamespace, task, results, workerIndex, taskStatus)
+	// Wait for the tasks whose outputs are referenced before taking a slot, so that the wait never
+	// holds a slot those tasks need, and then for a slot when the number of concurrently executing
+	// tasks is limited.
+	var releaseSlot func()
+	acquired := awaitTaskOutputProducers(ctx, task.Parameters) == nil
+	if acquired {
+		releaseSlot, acquired = acquireTaskSlot(ctx)
+	}
+	if !acquired {
+		// The claim is released even though the run is cancelled, so the task can be picked up again.
+		claims.Release(context.WithoutCancel(ctx), task.Name)
+		navigator.LogInfoWithEmoji(language.WarningEmoji, language.ContextCancelled,
+			append(cancellationFields(ctx), zap.String(language.Task_Name, task.Name))...)
+		return
+	}
+	attempts, history, err := func() (int, []AttemptRecord, error) {
+		// The slot is freed as soon as the task returns, even if it panics, and before the outcome is reported.
+		defer releaseSlot()
+		return performTaskWithRetries(ctx, clientset, shipsNamespace, task, workerIndex, logger)
+	}()
+	if err != nil && ctx.Err() != nil {
+		if cause := context.Cause(ctx); !errors.Is(err, cause) {
+			err = fmt.Errorf(language.ErrorTaskInterrupted, cause, err)
+		}
+	}
+	recordAudit(task, shipsNamespace, workerIndex, attempts, err)
+	recordCycleOutcome(ctx, taskOutcome(task, err))
+	recordTaskOutputCompletion(ctx, task.Name, err)
 	if err != nil {
-		handleFailedTask(task, taskStatus, shipsNamespace, err, results, workerIndex)
+		stopRenewing()
+		handleFailedTask(context.WithoutCancel(ctx), task, claims, shipsNamespace, err, results, workerIndex, attempts, history)
 	} else {
-		handleSuccessfulTask(task, results, workerIndex)
+		markTaskCompleted(ctx, task)
+		handleSuccessfulTask(task, results, workerIndex, attempts, history)
 	}
 }
 
 // handleFailedTask handles the scenario when a task fails to complete after retries. It releases
 // the claim on the task, logs the final error, and sends an error message through the results channel.
+// A task marked ContinueOnError is logged and reported as a warning instead, so that its failure does
+// not count as a failed task. A task interrupted by the run deadline is logged as a warning and
+// reported with the deadline-exceeded outcome, whether or not it is marked ContinueOnError.
 //
 // Parameters:
 //
+//	ctx context.Context: Context bounding the release of the claim.
 //	task configuration.Task: The task that has failed.
-//	taskStatus *TaskStatusMap: Map to track and control the status of tasks.
+//	claims ClaimStore: Store through which tasks are claimed, such as a TaskStatusMap.
 //	shipsNamespace string: Namespace in Kubernetes associated with the task.
 //	err error: The error that occurred during task processing.
 //	results chan<- string: Channel to return execution results to the caller.
 //	workerIndex int: Identifier for the worker instance for logging.
-func handleFailedTask(task configuration.Task, taskStatus *TaskStatusMap, shipsNamespace string, err error, results chan<- string, workerIndex int) {
-	taskStatus.Release(task.Name)
-	logFinalError(shipsNamespace, task.Name, err, task.MaxRetries)
-	results <- err.Error()
+//	attempts int: The number of attempts made before the task was given up.
+//	history []AttemptRecord: The failed attempts of the task.
+func handleFailedTask(ctx context.Context, task configuration.Task, claims ClaimStore, shipsNamespace string, err error, results chan<- string, workerIndex int, attempts int, history []AttemptRecord) {
+	claims.Release(ctx, task.Name)
+	failureMessage := err.Error()
+	if task.FailureMessage != "" {
+		failureMessage = renderTaskMessage(task.FailureMessage, task, shipsNamespace, workerIndex, attempts, err, failureMessage)
+	}
+
+	if errors.Is(err, ErrRunDeadlineExceeded) {
+		navigator.LogInfoWithEmoji(language.WarningEmoji, failureMessage,
+			zap.String(language.Ships_Namespace, shipsNamespace),
+			zap.String(language.Task_Name, task.Name),
+			zap.Int(language.Attempt, attempts),
+			zap.Error(err),
+		)
+		results <- formatTaskResult(task, language.OutcomeDeadlineExceeded, failureMessage, history)
+		return
+	}
+
+	if task.ContinueOnError {
+		warningMessage := fmt.Sprintf(language.TaskFailedContinuing, failureMessage)
+		navigator.LogInfoWithEmoji(language.WarningEmoji, warningMessage,
+			zap.String(language.Ships_Namespace, shipsNamespace),
+			zap.String(language.Task_Name, task.Name),
+			zap.Int(language.Attempt, attempts),
+			zap.Error(err),
+		)
+		results <- formatTaskResult(task, language.OutcomeWarning, warningMessage, history)
+		return
+	}
+
+	logFinalError(shipsNamespace, task.Name, err, attempts)
+	results <- formatTaskResult(task, language.OutcomeFailure, failureMessage, history)
 }
 
 // handleSuccessfulTask reports a task's successful completion by sending a success message
-// through the results channel.
+// through the results channel. If the task defines a SuccessMessage template, the rendered
+// template is sent instead of the default message.
 //
 // Parameters:
 //
 //	task configuration.Task: The task that has been successfully completed.
 //	results chan<- string: Channel to return execution results to the caller.
 //	workerIndex int: Identifier for the worker instance for logging.
-func handleSuccessfulTask(task configuration.Task, results chan<- string, workerIndex int) {
+//	attempts int: The number of attempts it took to complete the task.
+//	history []AttemptRecord: The failed attempts the task overcame.
+func handleSuccessfulTask(task configuration.Task, results chan<- string, workerIndex int, attempts int, history []AttemptRecord) {
 	successMessage := fmt.Sprintf(language.TaskWorker_Name, workerIndex, fmt.Sprintf(language.TaskCompleteS, task.Name))
-	results <- successMessage
+	if task.SuccessMessage != "" {
+		successMessage = renderTaskMessage(task.SuccessMessage, task, task.ShipsNamespace, workerIndex, attempts, nil, successMessage)
+	}
+	results <- formatTaskResult(task, language.OutcomeSuccess, successMessage, history)
+}
+
+// renderTaskMessage renders a task's custom message template. If rendering fails, the failure is
+// logged and the default message is returned, so a bad template never hides a task's outcome.
+//
+// Parameters:
+//
+//	text string: The template text to render.
+//	task configuration.Task: The task whose outcome is being reported.
+//	shipsNamespace string: Namespace in Kubernetes associated with the task.
+//	workerIndex int: Identifier for the worker instance.
+//	attempts int: The number of attempts made.
+//	err error: The final error, or nil for successful tasks.
+//	defaultMessage string: The message to use when rendering fails.
+//
+// Returns:
+//
+//	string: The rendered message, or defaultMessage on failure.
+func renderTaskMessage(text string, task configuration.Task, shipsNamespace string, workerIndex int, attempts int, err error, defaultMessage string) string {
+	data := configuration.MessageData{
+		Name:        task.Name,
+		Type:        task.Type,
+		Namespace:   shipsNamespace,
+		WorkerIndex: workerIndex,
+		Attempts:    attempts,
+		Parameters:  task.Parameters,
+	}
+	if err != nil {
+		data.Error = err.Error()
+	}
+	rendered, renderErr := configuration.RenderMessage(text, data)
+	if renderErr != nil {
+		navigator.LogErrorWithEmojiRateLimited(language.WarningEmoji, renderErr.Error(), zap.String(language.Task_Name, task.Name))
+		return defaultMessage
+	}
+	return rendered
 }
 
 // resolveConflict attempts to resolve a conflict error by retrieving the latest version of a pod involved in the task.
@@ -100,17 +252,17 @@ func handleSuccessfulTask(task configuration.Task, results chan<- string, worker
 // Parameters:
 //
 //	ctx context.Context: The context governing cancellation.
-//	clientset *kubernetes.Clientset: The Kubernetes client set used for interacting with the Kubernetes API.
+//	clientset kubernetes.Interface: The Kubernetes client set used for interacting with the Kubernetes API.
 //	shipsNamespace string: The Kubernetes namespace where the pod is located.
 //	task *configuration.Task: The task containing the parameters that need to be updated with the latest pod information.
 //
 // Returns:
 //
 //	error: An error if retrieving the latest version of the pod fails or if the pod name is not found in the task parameters.
-func resolveConflict(ctx context.Context, clientset *kubernetes.Clientset, shipsNamespace string, task *configuration.Task) error {
+func resolveConflict(ctx context.Context, clientset kubernetes.Interface, shipsNamespace string, task *configuration.Task) error {
 	podName, err := getParamAsString(task.Parameters, language.PodName)
 	if err != nil {
-		return fmt.Errorf(language.ErrorParameterMustBestring, language.PodName, err)
+		return newParameterError(language.PodName, err, language.ErrorParameterMustBestring, language.PodName, err)
 	}
 	updatedPod, err := getLatestVersionOfPod(ctx, clientset, shipsNamespace, podName)
 	if err != nil {
@@ -131,8 +283,8 @@ func CrewProcessPods(ctx context.Context, pods []corev1.Pod, results chan<- stri
 	for _, pod := range pods {
 		select {
 		case <-ctx.Done():
-			cancelMsg := fmt.Sprintf(language.WorkerCancelled, ctx.Err())
-			navigator.LogInfoWithEmoji(language.PirateEmoji, cancelMsg)
+			cancelMsg := fmt.Sprintf(language.WorkerCancelled, contextError(ctx))
+			navigator.LogInfoWithEmoji(language.PirateEmoji, cancelMsg, cancellationFields(ctx)...)
 			results <- cancelMsg
 			return
 		default:
@@ -0,0 +1,75 @@
+package worker
+
+import (
+	"sync"
+
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// DefaultObjectMeta holds the labels and annotations added to every object created by the task runners,
+// for example a "managed-by" label or team tags used for cost allocation.
+//
+// Fields:
+//
+//	Labels map[string]string: The labels added to created objects.
+//	Annotations map[string]string: The annotations added to created objects.
+type DefaultObjectMeta struct {
+	Labels      map[string]string
+	Annotations map[string]string
+}
+
+// defaultObjectMeta is the package-level DefaultObjectMeta; it is empty by default.
+var (
+	defaultObjectMeta   DefaultObjectMeta
+	defaultObjectMetaMu sync.RWMutex
+)
+
+// SetDefaultObjectMeta sets the labels and annotations that the create runners merge onto the objects
+// they build, in a thread-safe manner. Labels and annotations specified by the task win over the
+// defaults on conflict. The maps are copied, so later changes by the caller have no effect.
+func SetDefaultObjectMeta(meta DefaultObjectMeta) {
+	defaultObjectMetaMu.Lock()
+	defer defaultObjectMetaMu.Unlock()
+	defaultObjectMeta = DefaultObjectMeta{
+		Labels:      copyStringMap(meta.Labels),
+		Annotations: copyStringMap(meta.Annotations),
+	}
+}
+
+// applyDefaultObjectMeta merges the default labels and annotations onto an object before it is
+// created, keeping any value the object already sets for the same key.
+//
+// This unexported function is used internally by the create operations.
+func applyDefaultObjectMeta(obj v1.Object) {
+	defaultObjectMetaMu.RLock()
+	defaults := defaultObjectMeta
+	defaultObjectMetaMu.RUnlock()
+
+	if len(defaults.Labels) > 0 {
+		obj.SetLabels(mergeMissingKeys(obj.GetLabels(), defaults.Labels))
+	}
+	if len(defaults.Annotations) > 0 {
+		obj.SetAnnotations(mergeMissingKeys(obj.GetAnnotations(), defaults.Annotations))
+	}
+}
+
+// mergeMissingKeys returns a new map holding the entries of current plus the entries of defaults
+// whose keys are not present in current.
+//
+// This unexported function is used internally by applyDefaultObjectMeta.
+func mergeMissingKeys(current, defaults map[string]string) map[string]string {
+	merged := copyStringMap(defaults)
+	for key, value := range current {
+		merged[key] = value
+	}
+	return merged
+}
+
+// copyStringMap returns a copy of m; a nil map yields an empty map.
+func copyStringMap(m map[string]string) map[string]string {
+	out := make(map[string]string, len(m))
+	for key, value := range m {
+		out[key] = value
+	}
+	return out
+}
@@ -0,0 +1,53 @@
+package worker
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/H0llyW00dzZ/K8sBlackPearl/worker/configuration"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestDefaultObjectMetaIsMergedOntoCreatedObjects(t *testing.T) {
+	labels := map[string]string{"managed-by": "k8sblackpearl", "team": "navy"}
+	SetDefaultObjectMeta(DefaultObjectMeta{Labels: labels, Annotations: map[string]string{"cost-center": "fleet"}})
+	t.Cleanup(func() { SetDefaultObjectMeta(DefaultObjectMeta{}) })
+	// The defaults were copied, so changing the caller's map has no effect.
+	labels["managed-by"] = "someone-else"
+
+	clientset := fake.NewSimpleClientset()
+	runs := []struct {
+		runner     TaskRunner
+		parameters map[string]interface{}
+	}{
+		{&CrewCreatePod{}, map[string]interface{}{"podName": "deckhand", "image": "pearl:1", "labels": map[string]interface{}{"team": "pirates"}}},
+		{&CrewCreateConfigMap{}, map[string]interface{}{"configMapName": "settings", "data": map[string]interface{}{"mode": "calm"}}},
+	}
+	for _, run := range runs {
+		task := configuration.Task{Name: "create", Parameters: run.parameters}
+		if err := run.runner.Run(context.Background(), clientset, "default", task, run.parameters, 0); err != nil {
+			t.Fatalf("%T: Run: %v", run.runner, err)
+		}
+	}
+
+	pod, err := clientset.CoreV1().Pods("default").Get(context.Background(), "deckhand", v1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := map[string]string{"managed-by": "k8sblackpearl", "team": "pirates"}; !reflect.DeepEqual(pod.Labels, want) {
+		t.Fatalf("got pod labels %v, want %v with the task's team winning", pod.Labels, want)
+	}
+	if pod.Annotations["cost-center"] != "fleet" {
+		t.Fatalf("got pod annotations %v, want the default cost-center", pod.Annotations)
+	}
+
+	configMap, err := clientset.CoreV1().ConfigMaps("default").Get(context.Background(), "settings", v1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := map[string]string{"managed-by": "k8sblackpearl", "team": "navy"}; !reflect.DeepEqual(configMap.Labels, want) {
+		t.Fatalf("got ConfigMap labels %v, want the defaults %v", configMap.Labels, want)
+	}
+}
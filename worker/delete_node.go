@@ -0,0 +1,135 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/H0llyW00dzZ/K8sBlackPearl/language"
+	"github.com/H0llyW00dzZ/K8sBlackPearl/navigator"
+	"github.com/H0llyW00dzZ/go-urlshortner/logmonitor/constant"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/client-go/kubernetes"
+)
+
+// DeleteNode removes a Node object from the cluster, typically after it has been drained.
+// When requireCordoned is set, the node must be marked unschedulable. When requireEmpty is set,
+// the node must not host any running pods other than DaemonSet-managed or mirror pods.
+// If a safety check fails, the node is left untouched and a descriptive error is returned.
+//
+// Parameters:
+//
+//	ctx context.Context: Context for cancellation and timeout.
+//	clientset kubernetes.Interface: A Kubernetes clientset for interacting with the Kubernetes API.
+//	nodeName string: The name of the node to delete.
+//	requireCordoned bool: Whether the node must be cordoned before deletion.
+//	requireEmpty bool: Whether the node must be free of non-DaemonSet pods before deletion.
+//	results chan<- string: A channel to send operation results for logging.
+//	logger *zap.Logger: A logger for structured logging.
+//
+// Returns an error if a safety check fails or the node cannot be deleted.
+func DeleteNode(ctx context.Context, clientset kubernetes.Interface, nodeName string, requireCordoned, requireEmpty bool, results chan<- string, logger *zap.Logger) error {
+	node, err := clientset.CoreV1().Nodes().Get(ctx, nodeName, v1.GetOptions{})
+	if err != nil {
+		return reportNodeDeleteFailure(results, nodeName, err)
+	}
+
+	if requireCordoned && !node.Spec.Unschedulable {
+		return reportNodeDeleteFailure(results, nodeName, fmt.Errorf(language.ErrorNodeNotCordoned, nodeName))
+	}
+
+	if requireEmpty {
+		remaining, err := listEvictablePodsOnNode(ctx, clientset, nodeName)
+		if err != nil {
+			return reportNodeDeleteFailure(results, nodeName, err)
+		}
+		if len(remaining) > 0 {
+			return reportNodeDeleteFailure(results, nodeName, fmt.Errorf(language.ErrorNodeNotEmpty, nodeName, len(remaining), strings.Join(remaining, ", ")))
+		}
+	}
+
+	if err := clientset.CoreV1().Nodes().Delete(ctx, nodeName, v1.DeleteOptions{}); err != nil {
+		return reportNodeDeleteFailure(results, nodeName, err)
+	}
+
+	successMsg := fmt.Sprintf(language.NodeDeletedSuccessfully, nodeName)
+	results <- successMsg
+	navigator.LogInfoWithEmoji(constant.SuccessEmoji, successMsg, zap.String(language.NodeName, nodeName))
+	return nil
+}
+
+// listEvictablePodsOnNode lists the pods scheduled on a node that would block its removal,
+// returning them as "namespace/name" references. DaemonSet-managed pods, mirror pods, and pods
+// that have already terminated are ignored.
+//
+// This unexported function is used internally by DeleteNode.
+func listEvictablePodsOnNode(ctx context.Context, clientset kubernetes.Interface, nodeName string) ([]string, error) {
+	podList, err := clientset.CoreV1().Pods(v1.NamespaceAll).List(ctx, v1.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector(specNodeName, nodeName).String(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf(language.ErrorListingPods, err)
+	}
+
+	var remaining []string
+	for _, pod := range podList.Items {
+		if pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+			continue
+		}
+		if isDaemonSetPod(&pod) || isMirrorPod(&pod) {
+			continue
+		}
+		remaining = append(remaining, pod.Namespace+"/"+pod.Name)
+	}
+	return remaining, nil
+}
+
+// isDaemonSetPod reports whether the pod is controlled by a DaemonSet.
+func isDaemonSetPod(pod *corev1.Pod) bool {
+	for _, owner := range pod.OwnerReferences {
+		if owner.Controller != nil && *owner.Controller && owner.Kind == kindDaemonSet {
+			return true
+		}
+	}
+	return false
+}
+
+// isMirrorPod reports whether the pod is a static pod mirrored by the kubelet.
+func isMirrorPod(pod *corev1.Pod) bool {
+	_, found := pod.Annotations[corev1.MirrorPodAnnotationKey]
+	return found
+}
+
+// reportNodeDeleteFailure sends an error message to the results channel, logs the failure,
+// and returns the error so callers can propagate it directly.
+//
+// This unexported function is used internally by DeleteNode to report failures.
+func reportNodeDeleteFailure(results chan<- string, nodeName string, err error) error {
+	errorMessage := fmt.Sprintf(language.ErrorFailedToDeleteNode, nodeName, err)
+	results <- errorMessage
+	navigator.LogErrorWithEmojiRateLimited(constant.ErrorEmoji, errorMessage, zap.String(language.NodeName, nodeName), zap.Error(err))
+	return err
+}
+
+// extractDeleteNodeParameters extracts and validates the 'nodeName', 'requireCordoned', and
+// 'requireEmpty' parameters from a map of parameters.
+//
+// This function is used by task runners that delete nodes.
+func extractDeleteNodeParameters(parameters map[string]interface{}) (nodeName string, requireCordoned, requireEmpty bool, err error) {
+	nodeName, err = getParamAsString(parameters, nodeNamE)
+	if err != nil || nodeName == "" {
+		return "", false, false, newParameterError(nodeNamE, nil, language.ErrorParameterNodeName)
+	}
+	requireCordoned, err = getOptionalParamAsBool(parameters, requireCordoneD, false)
+	if err != nil {
+		return "", false, false, err
+	}
+	requireEmpty, err = getOptionalParamAsBool(parameters, requireEmptY, false)
+	if err != nil {
+		return "", false, false, err
+	}
+	return nodeName, requireCordoned, requireEmpty, nil
+}
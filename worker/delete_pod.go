@@ -0,0 +1,87 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/H0llyW00dzZ/K8sBlackPearl/language"
+	"github.com/H0llyW00dzZ/K8sBlackPearl/navigator"
+	"github.com/H0llyW00dzZ/go-urlshortner/logmonitor/constant"
+	"go.uber.org/zap"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// DeletePodByName deletes a single pod identified by its exact name. When gracePeriodSeconds is not
+// nil, it overrides the pod's termination grace period. A pod that does not exist is reported as a
+// non-retriable error, unless ignoreNotFound is set, in which case it counts as success.
+//
+// Parameters:
+//
+//	ctx context.Context: Context for cancellation and timeout.
+//	clientset kubernetes.Interface: A Kubernetes clientset for interacting with the Kubernetes API.
+//	namespace string: The namespace of the pod.
+//	podName string: The exact name of the pod to delete.
+//	gracePeriodSeconds *int64: An optional grace period override.
+//	ignoreNotFound bool: Whether a missing pod should be treated as already deleted.
+//	results chan<- string: A channel to send operation results for logging.
+//	logger *zap.Logger: A logger for structured logging.
+//
+// Returns an error if the pod cannot be deleted.
+func DeletePodByName(ctx context.Context, clientset kubernetes.Interface, namespace, podName string, gracePeriodSeconds *int64, ignoreNotFound bool, results chan<- string, logger *zap.Logger) error {
+	err := clientset.CoreV1().Pods(namespace).Delete(ctx, podName, v1.DeleteOptions{GracePeriodSeconds: gracePeriodSeconds})
+	switch {
+	case err == nil:
+		successMsg := fmt.Sprintf(language.PodDeletedSuccessfully, podName, namespace)
+		results <- successMsg
+		navigator.LogInfoWithEmoji(constant.SuccessEmoji, successMsg)
+		return nil
+	case apierrors.IsNotFound(err) && ignoreNotFound:
+		notFoundMsg := fmt.Sprintf(language.PodAlreadyDeleted, podName, namespace)
+		results <- notFoundMsg
+		navigator.LogInfoWithEmoji(constant.SuccessEmoji, notFoundMsg)
+		return nil
+	case apierrors.IsNotFound(err):
+		return reportDeletePodFailure(results, podName, markNonRetriable(fmt.Errorf(language.ErrorNamedPodNotFound, podName, namespace)))
+	default:
+		return reportDeletePodFailure(results, podName, err)
+	}
+}
+
+// reportDeletePodFailure sends an error message to the results channel, logs the failure,
+// and returns the error so callers can propagate it directly.
+//
+// This unexported function is used internally by DeletePodByName to report failures.
+func reportDeletePodFailure(results chan<- string, podName string, err error) error {
+	errorMessage := fmt.Sprintf(language.ErrorFailedToDeletePod, podName, err)
+	results <- errorMessage
+	navigator.LogErrorWithEmojiRateLimited(constant.ErrorEmoji, errorMessage, zap.Error(err))
+	return err
+}
+
+// extractDeletePodParameters extracts and validates the 'podName', 'gracePeriodSeconds', and
+// 'ignoreNotFound' parameters from a map of parameters.
+//
+// This function is used by task runners that delete a single pod.
+func extractDeletePodParameters(parameters map[string]interface{}) (podName string, gracePeriodSeconds *int64, ignoreNotFound bool, err error) {
+	podName, err = getParamAsString(parameters, language.PodName)
+	if err != nil || podName == "" {
+		return "", nil, false, newParameterError(language.PodName, err, language.ErrorParameterMissing, language.PodName)
+	}
+	if _, exists := parameters[gracePeriodSecondS]; exists {
+		seconds, err := getParamAsInt64(parameters, gracePeriodSecondS)
+		if err != nil {
+			return "", nil, false, err
+		}
+		if seconds < 0 {
+			return "", nil, false, newParameterError(gracePeriodSecondS, nil, language.ErrorParameterInvalid, gracePeriodSecondS)
+		}
+		gracePeriodSeconds = &seconds
+	}
+	ignoreNotFound, err = getOptionalParamAsBool(parameters, ignoreNotFounD, false)
+	if err != nil {
+		return "", nil, false, err
+	}
+	return podName, gracePeriodSeconds, ignoreNotFound, nil
+}
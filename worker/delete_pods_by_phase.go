@@ -0,0 +1,146 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/H0llyW00dzZ/K8sBlackPearl/language"
+	"github.com/H0llyW00dzZ/K8sBlackPearl/navigator"
+	"github.com/H0llyW00dzZ/go-urlshortner/logmonitor/constant"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/client-go/kubernetes"
+)
+
+// podPhaseCleanupCriteria selects the finished pods to delete.
+type podPhaseCleanupCriteria struct {
+	phase         corev1.PodPhase
+	labelSelector string
+	olderThan     time.Duration
+}
+
+// DeletePodsByPhase deletes the pods of a namespace that are in the given terminal phase, Succeeded
+// or Failed, optionally filtered by a label selector. With a non-zero olderThan, only pods that finished
+// at least that long ago are deleted. The context is checked before every deletion, and a single
+// summary naming the deleted pods is reported through the results channel.
+//
+// Parameters:
+//
+//	ctx context.Context: Context for cancellation and timeout.
+//	clientset kubernetes.Interface: A Kubernetes clientset for interacting with the Kubernetes API.
+//	namespace string: The namespace whose pods are cleaned up.
+//	criteria podPhaseCleanupCriteria: The phase, label selector, and minimum age of the pods to delete.
+//	results chan<- string: A channel to send operation results for logging.
+//	logger *zap.Logger: A logger for structured logging.
+//
+// Returns an error if the pods cannot be listed, if any deletion fails, or if the context is cancelled.
+func DeletePodsByPhase(ctx context.Context, clientset kubernetes.Interface, namespace string, criteria podPhaseCleanupCriteria, results chan<- string, logger *zap.Logger) error {
+	listOptions := v1.ListOptions{
+		LabelSelector: criteria.labelSelector,
+		FieldSelector: fields.OneTermEqualSelector(statusPhase, string(criteria.phase)).String(),
+		Limit:         defaultPageSize,
+	}
+	pods, err := listAllPods(ctx, clientset, namespace, listOptions, 0)
+	if err != nil {
+		return reportDeletePodsByPhaseFailure(results, criteria.phase, nil, err)
+	}
+
+	cutoff := time.Now().Add(-criteria.olderThan)
+	var deleted, failed []string
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		if pod.Status.Phase != criteria.phase || podFinishedAt(pod).After(cutoff) {
+			continue
+		}
+		if err := ctx.Err(); err != nil {
+			return reportDeletePodsByPhaseFailure(results, criteria.phase, deleted, err)
+		}
+		err := clientset.CoreV1().Pods(namespace).Delete(ctx, pod.Name, v1.DeleteOptions{})
+		if err != nil && !apierrors.IsNotFound(err) {
+			navigator.LogErrorWithEmojiRateLimited(constant.ErrorEmoji, fmt.Sprintf(language.ErrorFailedToDeletePod, pod.Name, err), zap.Error(err))
+			failed = append(failed, pod.Name)
+			continue
+		}
+		deleted = append(deleted, pod.Name)
+	}
+
+	if len(failed) > 0 {
+		return reportDeletePodsByPhaseFailure(results, criteria.phase, deleted, fmt.Errorf(language.ErrorDeletingPods, len(failed), strings.Join(failed, ", ")))
+	}
+
+	successMsg := fmt.Sprintf(language.PodsDeletedByPhase, len(deleted), criteria.phase, namespace, strings.Join(deleted, ", "))
+	results <- successMsg
+	navigator.LogInfoWithEmoji(constant.SuccessEmoji, successMsg)
+	return nil
+}
+
+// podFinishedAt returns when a pod in a terminal phase finished: the latest termination time of its
+// containers, falling back to the transition time of its Ready condition and then to its creation time.
+//
+// This unexported function is used internally by DeletePodsByPhase.
+func podFinishedAt(pod *corev1.Pod) time.Time {
+	var finishedAt time.Time
+	for _, status := range pod.Status.ContainerStatuses {
+		if terminated := status.State.Terminated; terminated != nil && terminated.FinishedAt.After(finishedAt) {
+			finishedAt = terminated.FinishedAt.Time
+		}
+	}
+	if !finishedAt.IsZero() {
+		return finishedAt
+	}
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == corev1.PodReady && !condition.LastTransitionTime.IsZero() {
+			return condition.LastTransitionTime.Time
+		}
+	}
+	return pod.CreationTimestamp.Time
+}
+
+// reportDeletePodsByPhaseFailure sends an error message naming the pods deleted so far to the results
+// channel, logs the failure, and returns the error so callers can propagate it directly.
+//
+// This unexported function is used internally by DeletePodsByPhase to report failures.
+func reportDeletePodsByPhaseFailure(results chan<- string, phase corev1.PodPhase, deleted []string, err error) error {
+	errorMessage := fmt.Sprintf(language.ErrorFailedToDeletePodsByPhase, phase, len(deleted), strings.Join(deleted, ", "), err)
+	results <- errorMessage
+	navigator.LogErrorWithEmojiRateLimited(constant.ErrorEmoji, errorMessage, zap.Error(err))
+	return err
+}
+
+// extractDeletePodsByPhaseParameters extracts and validates the 'phase' parameter, which must be
+// 'Succeeded' or 'Failed', and the optional 'labelSelector' and 'olderThan' parameters. 'olderThan'
+// is a duration such as "1h" and defaults to zero.
+//
+// This function is used by task runners that delete finished pods.
+func extractDeletePodsByPhaseParameters(parameters map[string]interface{}) (podPhaseCleanupCriteria, error) {
+	phase, err := getParamAsString(parameters, phasE)
+	if err != nil {
+		return podPhaseCleanupCriteria{}, newParameterError(phasE, err, language.ErrorParameterMissing, phasE)
+	}
+	switch corev1.PodPhase(phase) {
+	case corev1.PodSucceeded, corev1.PodFailed:
+	default:
+		return podPhaseCleanupCriteria{}, newParameterError(phasE, nil, language.ErrorInvalidTerminalPhase, phase)
+	}
+
+	selector, err := getOptionalParamAsString(parameters, labelSelector, "")
+	if err != nil {
+		return podPhaseCleanupCriteria{}, err
+	}
+
+	olderThanStr, err := getOptionalParamAsString(parameters, olderThaN, "0s")
+	if err != nil {
+		return podPhaseCleanupCriteria{}, err
+	}
+	olderThan, err := time.ParseDuration(olderThanStr)
+	if err != nil || olderThan < 0 {
+		return podPhaseCleanupCriteria{}, newParameterError(olderThaN, err, language.ErrorParameterInvalid, olderThaN)
+	}
+
+	return podPhaseCleanupCriteria{phase: corev1.PodPhase(phase), labelSelector: selector, olderThan: olderThan}, nil
+}
@@ -0,0 +1,128 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/H0llyW00dzZ/K8sBlackPearl/language"
+	"github.com/H0llyW00dzZ/K8sBlackPearl/navigator"
+	"go.uber.org/zap"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// deploymentRevision is a single entry of a deployment's rollout history, backed by one ReplicaSet.
+type deploymentRevision struct {
+	revision   int64
+	replicaSet string
+	images     []string
+	created    time.Time
+	current    bool
+}
+
+// listDeploymentRevisions enumerates the ReplicaSets controlled by a deployment and returns one revision
+// per ReplicaSet, read from the deployment.kubernetes.io/revision annotation, sorted from newest to
+// oldest. ReplicaSets without a valid revision annotation are skipped.
+//
+// Parameters:
+//
+//	ctx context.Context: Context for cancellation and timeout.
+//	clientset kubernetes.Interface: A Kubernetes clientset for interacting with the Kubernetes API.
+//	namespace string: The namespace of the deployment.
+//	deploymentName string: The name of the deployment.
+//
+// Returns:
+//
+//	[]deploymentRevision: The revisions of the deployment, newest first.
+//	error: An error if the deployment or its ReplicaSets cannot be read.
+func listDeploymentRevisions(ctx context.Context, clientset kubernetes.Interface, namespace, deploymentName string) ([]deploymentRevision, error) {
+	deployment, err := clientset.AppsV1().Deployments(namespace).Get(ctx, deploymentName, v1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return nil, markNonRetriable(fmt.Errorf(language.ErrorGettingDeployment, deploymentName, err))
+	}
+	if err != nil {
+		return nil, fmt.Errorf(language.ErrorGettingDeployment, deploymentName, err)
+	}
+
+	selector, err := v1.LabelSelectorAsSelector(deployment.Spec.Selector)
+	if err != nil {
+		return nil, markNonRetriable(err)
+	}
+	replicaSets, err := clientset.AppsV1().ReplicaSets(namespace).List(ctx, v1.ListOptions{LabelSelector: selector.String()})
+	if err != nil {
+		return nil, fmt.Errorf(language.ErrorListingReplicaSets, err)
+	}
+
+	currentRevision := deployment.Annotations[deploymentRevisionAnnotation]
+	var revisions []deploymentRevision
+	for i := range replicaSets.Items {
+		replicaSet := &replicaSets.Items[i]
+		if !v1.IsControlledBy(replicaSet, deployment) {
+			continue
+		}
+		annotation := replicaSet.Annotations[deploymentRevisionAnnotation]
+		revision, err := strconv.ParseInt(annotation, 10, 64)
+		if err != nil {
+			continue
+		}
+
+		var images []string
+		for _, container := range replicaSet.Spec.Template.Spec.Containers {
+			images = append(images, container.Image)
+		}
+		revisions = append(revisions, deploymentRevision{
+			revision:   revision,
+			replicaSet: replicaSet.Name,
+			images:     images,
+			created:    replicaSet.CreationTimestamp.Time,
+			current:    annotation == currentRevision,
+		})
+	}
+
+	sort.Slice(revisions, func(i, j int) bool {
+		return revisions[i].revision > revisions[j].revision
+	})
+	return revisions, nil
+}
+
+// reportDeploymentRevisions sends one line per revision through the results channel and logs the same
+// information with structured fields. It stops early if the context is cancelled.
+//
+// Parameters:
+//
+//	ctx context.Context: Context for cancellation.
+//	baseFields []zap.Field: A slice of zap.Field structs providing contextual logging information.
+//	revisions []deploymentRevision: The revisions to report.
+//	results chan<- string: A channel with room for one message per revision.
+//
+// Returns an error if the context is cancelled before all revisions are reported.
+func reportDeploymentRevisions(ctx context.Context, baseFields []zap.Field, revisions []deploymentRevision, results chan<- string) error {
+	for _, revision := range revisions {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		format := language.DeploymentRevisionSummary
+		if revision.current {
+			format = language.DeploymentCurrentRevisionSummary
+		}
+		created := revision.created.UTC().Format(time.RFC3339)
+		message := fmt.Sprintf(format, revision.revision, revision.replicaSet, strings.Join(revision.images, ", "), created)
+		results <- message
+
+		revisionFields := append([]zap.Field(nil), baseFields...)
+		revisionFields = append(revisionFields,
+			zap.Int64(language.Revision, revision.revision),
+			zap.String(language.ReplicaSetName, revision.replicaSet),
+			zap.Strings(language.ContainerImages, revision.images),
+			zap.Time(language.CreatedAt, revision.created),
+			zap.Bool(language.CurrentRevision, revision.current),
+		)
+		navigator.LogInfoWithEmoji(language.PirateEmoji, message, revisionFields...)
+	}
+	return nil
+}
@@ -0,0 +1,133 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/H0llyW00dzZ/K8sBlackPearl/language"
+	"github.com/H0llyW00dzZ/K8sBlackPearl/navigator"
+	"github.com/H0llyW00dzZ/go-urlshortner/logmonitor/constant"
+	"go.uber.org/zap"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/util/retry"
+)
+
+// UpdateDeploymentStrategy replaces the rollout strategy of a deployment. The deployment is read,
+// its spec.strategy is set, and the update is retried on conflicts with a fresh read.
+//
+// Parameters:
+//
+//	ctx context.Context: Context for cancellation and timeout.
+//	clientset kubernetes.Interface: A Kubernetes clientset for interacting with the Kubernetes API.
+//	namespace string: The namespace of the deployment.
+//	deploymentName string: The name of the deployment to update.
+//	strategy appsv1.DeploymentStrategy: The strategy to apply.
+//	results chan<- string: A channel to send operation results for logging.
+//	logger *zap.Logger: A logger for structured logging.
+//
+// Returns an error if the deployment cannot be read or updated.
+func UpdateDeploymentStrategy(ctx context.Context, clientset kubernetes.Interface, namespace, deploymentName string, strategy appsv1.DeploymentStrategy, results chan<- string, logger *zap.Logger) error {
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		deployment, err := clientset.AppsV1().Deployments(namespace).Get(ctx, deploymentName, v1.GetOptions{})
+		if err != nil {
+			return fmt.Errorf(language.FailedToGetDeployment, deploymentName, err)
+		}
+		deployment.Spec.Strategy = strategy
+		_, err = clientset.AppsV1().Deployments(namespace).Update(ctx, deployment, v1.UpdateOptions{})
+		return err
+	})
+	if err != nil {
+		errorMessage := fmt.Sprintf(language.ErrorFailedToUpdateStrategy, deploymentName, err)
+		results <- errorMessage
+		navigator.LogErrorWithEmojiRateLimited(constant.ErrorEmoji, errorMessage, zap.Error(err))
+		return err
+	}
+
+	successMsg := fmt.Sprintf(language.DeploymentStrategyUpdated, deploymentName, strategy.Type)
+	results <- successMsg
+	navigator.LogInfoWithEmoji(constant.SuccessEmoji, successMsg)
+	return nil
+}
+
+// extractDeploymentStrategyParameters extracts and validates the 'deploymentName', 'strategyType',
+// 'maxSurge', and 'maxUnavailable' parameters. The strategy type must be 'RollingUpdate' or 'Recreate';
+// 'maxSurge' and 'maxUnavailable' are only accepted for rolling updates and may be integers or
+// percentage strings such as "25%".
+//
+// This function is used by task runners that change deployment strategies.
+func extractDeploymentStrategyParameters(parameters map[string]interface{}) (string, appsv1.DeploymentStrategy, error) {
+	deploymentName, err := extractDeploymentNameParameter(parameters)
+	if err != nil {
+		return "", appsv1.DeploymentStrategy{}, err
+	}
+
+	strategyType, err := getParamAsString(parameters, strategyTypE)
+	if err != nil {
+		return "", appsv1.DeploymentStrategy{}, err
+	}
+
+	_, hasSurge := parameters[maxSurgE]
+	_, hasUnavailable := parameters[maxUnavailablE]
+
+	switch appsv1.DeploymentStrategyType(strategyType) {
+	case appsv1.RecreateDeploymentStrategyType:
+		if hasSurge || hasUnavailable {
+			return "", appsv1.DeploymentStrategy{}, newParameterError(strategyTypE, nil, language.ErrorRollingParamsWithRecreate)
+		}
+		return deploymentName, appsv1.DeploymentStrategy{Type: appsv1.RecreateDeploymentStrategyType}, nil
+	case appsv1.RollingUpdateDeploymentStrategyType:
+		strategy := appsv1.DeploymentStrategy{
+			Type:          appsv1.RollingUpdateDeploymentStrategyType,
+			RollingUpdate: &appsv1.RollingUpdateDeployment{},
+		}
+		if hasSurge {
+			surge, err := parseIntOrPercent(parameters, maxSurgE)
+			if err != nil {
+				return "", appsv1.DeploymentStrategy{}, err
+			}
+			strategy.RollingUpdate.MaxSurge = &surge
+		}
+		if hasUnavailable {
+			unavailable, err := parseIntOrPercent(parameters, maxUnavailablE)
+			if err != nil {
+				return "", appsv1.DeploymentStrategy{}, err
+			}
+			strategy.RollingUpdate.MaxUnavailable = &unavailable
+		}
+		return deploymentName, strategy, nil
+	default:
+		return "", appsv1.DeploymentStrategy{}, newParameterError(strategyTypE, nil, language.ErrorInvalidStrategyType, strategyType)
+	}
+}
+
+// parseIntOrPercent reads a parameter that may be a non-negative integer or a percentage string
+// between "0%" and "100%", as accepted by 'maxSurge' and 'maxUnavailable'.
+//
+// This unexported function is used internally by extractDeploymentStrategyParameters.
+func parseIntOrPercent(parameters map[string]interface{}, key string) (intstr.IntOrString, error) {
+	switch value := parameters[key].(type) {
+	case int:
+		if value >= 0 {
+			return intstr.FromInt32(int32(value)), nil
+		}
+	case float64:
+		if value >= 0 && value == float64(int32(value)) {
+			return intstr.FromInt32(int32(value)), nil
+		}
+	case string:
+		if number, found := strings.CutSuffix(value, "%"); found {
+			percent, err := strconv.Atoi(number)
+			if err == nil && percent >= 0 && percent <= 100 {
+				return intstr.FromString(value), nil
+			}
+		} else if number, err := strconv.Atoi(value); err == nil && number >= 0 {
+			return intstr.FromInt32(int32(number)), nil
+		}
+	}
+	return intstr.IntOrString{}, newParameterError(key, nil, language.ErrorInvalidIntOrPercent, key)
+}
@@ -0,0 +1,58 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/H0llyW00dzZ/K8sBlackPearl/worker/configuration"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestCrewUpdateDeploymentStrategyTypes(t *testing.T) {
+	clientset := fake.NewSimpleClientset(&appsv1.Deployment{
+		ObjectMeta: v1.ObjectMeta{Name: "api", Namespace: "default"},
+	})
+	run := func(parameters map[string]interface{}) appsv1.DeploymentStrategy {
+		t.Helper()
+		parameters["deploymentName"] = "api"
+		task := configuration.Task{Name: "strategy", Type: "CrewUpdateDeploymentStrategy", Parameters: parameters}
+		if err := (&CrewUpdateDeploymentStrategy{}).Run(context.Background(), clientset, "default", task, parameters, 0); err != nil {
+			t.Fatalf("Run with %v: %v", parameters, err)
+		}
+		deployment, err := clientset.AppsV1().Deployments("default").Get(context.Background(), "api", v1.GetOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return deployment.Spec.Strategy
+	}
+
+	rolling := run(map[string]interface{}{"strategyType": "RollingUpdate", "maxSurge": "25%", "maxUnavailable": 1})
+	if rolling.Type != appsv1.RollingUpdateDeploymentStrategyType || rolling.RollingUpdate == nil ||
+		*rolling.RollingUpdate.MaxSurge != intstr.FromString("25%") || *rolling.RollingUpdate.MaxUnavailable != intstr.FromInt32(1) {
+		t.Fatalf("got strategy %+v, want a rolling update with maxSurge 25%% and maxUnavailable 1", rolling)
+	}
+
+	recreate := run(map[string]interface{}{"strategyType": "Recreate"})
+	if recreate.Type != appsv1.RecreateDeploymentStrategyType || recreate.RollingUpdate != nil {
+		t.Fatalf("got strategy %+v, want Recreate without rolling update settings", recreate)
+	}
+}
+
+func TestExtractDeploymentStrategyParametersRejectsInvalidValues(t *testing.T) {
+	for name, parameters := range map[string]map[string]interface{}{
+		"unknown type":          {"strategyType": "BlueGreen"},
+		"percentage over 100":   {"strategyType": "RollingUpdate", "maxSurge": "150%"},
+		"malformed percentage":  {"strategyType": "RollingUpdate", "maxUnavailable": "a%"},
+		"negative integer":      {"strategyType": "RollingUpdate", "maxSurge": -1},
+		"rolling with recreate": {"strategyType": "Recreate", "maxSurge": 1},
+	} {
+		parameters["deploymentName"] = "api"
+		if _, _, err := extractDeploymentStrategyParameters(parameters); !errors.Is(err, ErrInvalidParameter) {
+			t.Fatalf("%s: got %v, want a parameter error", name, err)
+		}
+	}
+}
@@ -49,6 +49,9 @@
 //   - NewKubernetesClient: Creates a new Kubernetes clientset configured for in-cluster
 //     communication with the Kubernetes API server.
 //
+//   - NewKubernetesClientWithHealthCheck: Creates a Kubernetes clientset like NewKubernetesClient
+//     and verifies at startup that the API server answers within the given timeout.
+//
 //   - CrewWorker: Orchestrates a worker process to perform tasks such as health checks,
 //     labeling of pods, scaling deployments, updating deployment images, creating PVCs,
 //     updating network policies, and other configurable tasks within a specified namespace.
@@ -85,6 +88,161 @@
 //     parameters, handling retries on conflicts and reporting the outcome through a results channel.
 //     It logs the update process with structured logging, including emojis for visual cues.
 //
+//   - CrewCreateLimitRange: Creates a LimitRange with default, defaultRequest, max, and min
+//     constraints for containers or pods, optionally overwriting an existing LimitRange.
+//
+//   - CrewApplyManifest: Creates or updates arbitrary resources from a multi-document YAML
+//     manifest using the dynamic client, reporting the outcome of every object.
+//
+//   - CrewGetPodMetrics and CrewGetNodeMetrics: Report the current CPU and memory usage of
+//     pods or nodes from the metrics.k8s.io API, failing without retries when metrics-server
+//     is not installed.
+//
+//   - CrewDeleteNode: Removes a decommissioned Node object, optionally refusing when the node
+//     is still schedulable or still hosts non-DaemonSet pods.
+//
+//   - CrewCreateStorageClass: Creates a cluster-scoped StorageClass with the given provisioner,
+//     parameters, reclaim policy, and binding mode, optionally replacing an existing one.
+//
+//   - CrewScaleToZero: Scales a deployment to zero replicas, recording its previous size in the
+//     'k8sblackpearl.io/previousReplicas' annotation.
+//
+//   - CrewRestoreReplicas: Scales a deployment back to the size recorded by CrewScaleToZero and
+//     removes the annotation.
+//
+//   - CrewWatchPodsUntilCondition: Waits for pods to become 'ready' or 'deleted' using the watch API,
+//     re-establishing expired watches from the last seen resource version.
+//
+//   - CrewCreatePod: Creates a pod from 'podName', 'image', and optional 'command', 'labels', 'env',
+//     and 'restartPolicy', optionally waiting until it is ready.
+//
+//   - CrewDeletePodByName: Deletes a single pod by exact name, with an optional grace period and
+//     'ignoreNotFound' to treat a missing pod as success.
+//
+//   - CrewAnnotateDeployment: Sets an annotation on a deployment, or on its pod template with
+//     'target: template' to trigger a rollout, skipping when the value is already set.
+//
+//   - CrewListSecretsMetadata: Lists Secrets with their name, type, key names, and age, never their values.
+//
+//   - CrewUpdateDeploymentStrategy: Sets a deployment's strategy to 'RollingUpdate' (with optional
+//     'maxSurge' and 'maxUnavailable') or 'Recreate'.
+//
+//   - CrewGetIngresses: Lists Ingresses with their hosts, backend services, TLS secrets, and load
+//     balancer addresses.
+//
+//   - CrewPauseDeployment: Pauses a deployment's rollout so several edits can be batched.
+//
+//   - CrewResumeDeployment: Resumes a paused deployment's rollout.
+//
+//   - CrewGetPodsByNode: Reports the pods of a namespace grouped by the node they run on,
+//     with unscheduled pods as a separate group.
+//
+//   - CrewCreateEndpoints: Creates or overwrites the legacy core/v1 Endpoints object of a
+//     selector-less Service so that it routes to external addresses.
+//
+//   - CrewGetConfigMap: Reports the keys of a ConfigMap, and their values when 'showValues' is set.
+//
+//   - CrewGetSecretKeys: Reports the key names of a Secret without ever exposing its values.
+//
+//   - CrewUpdateIngressBackend: Repoints the backend of an Ingress rule path, selected by host and path,
+//     to another service and reports the previous and new backends.
+//
+//   - CrewCheckServiceEndpoints: Reports the ready and not-ready backend addresses of a Service and,
+//     with 'requireReady', fails when none is ready.
+//
+//   - CrewRunHealthProbeAgainstPod: Probes a running pod's HTTP endpoint through the API server proxy
+//     and compares the response status with the expected one.
+//
+//   - CrewCloneDeployment: Copies a deployment into another namespace without its server-populated
+//     metadata and status, optionally renaming it and rewriting image registries.
+//
+//   - CrewValidateManifest: Submits each document of a manifest as a server-side dry run and reports
+//     whether the API server would accept it, without persisting anything.
+//
+//   - CrewSetImagePullSecrets: Merges image pull secrets into a ServiceAccount or into a deployment's
+//     pod template, skipping secrets that are already referenced.
+//
+//   - CrewCreateVPA: Creates a VerticalPodAutoscaler for a deployment through the dynamic client,
+//     with an update mode and optional min/max allowed resources.
+//
+//   - CrewCleanupJobs: Deletes finished Jobs matching an optional label selector, minimum age, and
+//     Complete or Failed status, with Background propagation.
+//
+//   - CrewWaitForJobCompletion: Waits for an existing Job to reach its completions, failing with the
+//     Job's failure reason or on timeout.
+//
+//   - CrewGetDeploymentRevisions: Lists a deployment's revisions from its ReplicaSets, newest first,
+//     with their images and creation times.
+//
+//   - CrewGetNamespaceInventory: Counts the key resources of a namespace, with pods and PVCs broken
+//     down by phase, tolerating resource types it cannot list.
+//
+//   - CrewScaleMany: Scales every deployment matching a label selector to the same number of replicas,
+//     continuing past individual failures and naming them in the returned error.
+//
+//   - CrewCheckPDBViolations: Reports which PodDisruptionBudgets governing the pods of a namespace or
+//     node allow no disruptions and would block a drain, optionally failing the task.
+//
+//   - CrewGetPodLogsSince: reads the logs of a pod container since 'sinceSeconds' or 'sinceTime',
+//     optionally from the 'previous' container instance, capped at 'limitBytes'.
+//
+//   - CrewUpdateSecretData: merges the 'stringData' keys into the Secret named by 'secretName', or replaces
+//     its data when 'replace' is true. Only key names are logged.
+//
+//   - CrewSetDeploymentNodeSelector: merges 'nodeSelector' into the pod template of a deployment, or replaces
+//     it when 'replace' is true; replacing with an empty selector clears it.
+//
+//   - CrewCheckImageTags: compares the images of deployments matching 'labelSelector' with the 'expected'
+//     map of container names to images and reports drift, failing only when 'failOnMismatch' is true.
+//
+//   - CrewDeletePodsByPhase: deletes the pods in the 'Succeeded' or 'Failed' 'phase', optionally restricted by
+//     'labelSelector' and to pods that finished at least 'olderThan' ago.
+//
+//   - CrewGetResourceQuotaUsage: reports used versus hard and the remaining headroom of every resource limited by
+//     the namespace's ResourceQuotas, failing on an exhausted resource only when 'failIfExhausted' is true.
+//
+//   - CrewCreateConfigMap: Creates a ConfigMap from 'data' strings and base64-encoded 'binaryData'
+//     values, replacing the data of an existing one when 'overwrite' is set.
+//
+//   - CrewWaitForPVCBound: Waits until a PVC is Bound, reporting its volume, or until the optional
+//     'timeout' elapses, reporting its current phase.
+//
+//   - CrewRestartPods: Deletes the pods matching 'labelSelector' so their controllers recreate them,
+//     one at a time with a readiness wait between deletions when 'rolling' is set.
+//
+//   - CrewGetPodOwnerChain: Traces a pod through its ownerReferences up to its Deployment, StatefulSet,
+//     DaemonSet, Job, or CronJob and reports the ownership chain.
+//
+//   - CrewCreateHeadlessService: Creates a headless Service (clusterIP None) for StatefulSet discovery from
+//     a required 'selector' and 'ports', optionally publishing not-ready addresses.
+//
+//   - CrewCheckDeploymentRolloutStuck: Reports whether a deployment rollout is stuck, either past its progress
+//     deadline or without progress for longer than 'threshold', failing when 'failIfStuck' is set.
+//
+//   - CrewUpdateHPA: Retunes an existing HorizontalPodAutoscaler, changing only the given 'minReplicas',
+//     'maxReplicas', and 'targetCPUUtilizationPercentage'.
+//
+//   - CrewCreateEndpointSlice: Creates or overwrites a discovery/v1 EndpointSlice for a Service from
+//     'addressType', 'endpoints', and 'ports', validating every address against the address type.
+//
+//   - CrewGetPodResourceRequests: Sums the CPU and memory requests and limits of the pods matching the optional
+//     'labelSelector' for bin-packing analysis, reporting every pod as well when 'perPod' is set.
+//
+//   - CrewCheckPodSecurity: Audits the securityContext of the pods matching the optional 'labelSelector' for
+//     running as root, privileged mode, privilege escalation, and capabilities that are not dropped, reporting
+//     every violating container and failing when 'failIfViolation' is set.
+//
+//   - CrewLabelResourcesAcrossKinds: Sets 'labelKey' to 'labelValue' on the deployments, statefulsets, daemonsets,
+//     services, configmaps, secrets, or pods listed in 'kinds' that match the optional 'labelSelector', skipping
+//     objects that already carry the label and reporting the outcome of every kind.
+//
+//   - CrewGetOrphanedResources: Reports the configmaps, secrets, and persistent volume claims that no pod,
+//     workload template, service account, or ingress of the namespace references, as heuristic cleanup candidates.
+//
+//   - CrewScaleBasedOnPodCount: Scales 'targetDeployment' to the count of the pods matching 'sourceSelector'
+//     multiplied by the optional 'ratio', rounded as the optional 'formula' selects ("ceil", "floor", or "round")
+//     and clamped to the optional 'minReplicas' and 'maxReplicas'.
+//
 // Usage:
 //
 // Initialize the Kubernetes client using NewKubernetesClient, then leverage the client
@@ -143,6 +301,124 @@
 //   - Network Policy update functionality has been introduced, allowing for the management
 //     of network traffic policies within the cluster, with structured logging and retry mechanisms.
 //
+//   - Task parameters written as {"secretRef": {"name": "...", "key": "..."}} are resolved
+//     from the named Secret in the task's namespace right before the runner executes, keeping
+//     sensitive values out of configuration files. Secrets are read once per run and cached.
+//
+//   - Pod listings support a 'paginate' parameter that follows the API server's Continue token
+//     to read every page, using 'limit' as the page size and an optional 'maxItems' as the overall cap.
+//     Bulk labeling always pages through the namespace.
+//
+//   - Task outcomes can be recorded through an AuditSink set with SetAuditSink. The bundled
+//     JSONLFileAuditSink appends one redacted JSON line per completed task without blocking workers.
+//
+//   - Typed errors: parameter validation failures are reported as *ParameterError (matching
+//     ErrInvalidParameter) and failed tasks as *TaskExecutionError, so callers can use errors.Is and errors.As.
+//
+//   - Custom outcome messages: tasks may set 'successMessage' and 'failureMessage' text/template strings,
+//     rendered with configuration.MessageData (for example "{{.Name}} done for {{.Parameters.ticket}}").
+//
+//   - Panic safety: a panicking TaskRunner is recovered, logged with its stack trace, and reported as a
+//     failed task, while the rest of the crew keeps working.
+//
+//   - Client configuration: NewKubernetesClientWithOptions accepts KubeClientOptions to force in-cluster or
+//     kubeconfig mode and to select a kubeconfig path and context.
+//
+//   - Request metadata: attach RequestMetadata to the context with WithRequestMetadata and the runners
+//     include its request, tenant, trace, and extra values in their structured log fields.
+//
+//   - Per-call timeout: every Kubernetes API call is bounded (30 seconds by default, configurable with
+//     KubeClientOptions.CallTimeout), so a hung API server fails one retriable call instead of stalling a task.
+//
+//   - Idempotency keys: tasks may declare an 'idempotencyKey'; once such a task succeeds it is recorded in
+//     the CompletionStore set with SetCompletionStore (in memory by default, or a ConfigMap with
+//     NewConfigMapCompletionStore), and tasks with the same key are skipped, even after a restart.
+//
+//   - Concurrency limit: SetMaxConcurrentTasks caps how many tasks execute at the same time, independently of
+//     the number of workers scanning the task list.
+//
+//   - Testing seam: CaptainTellWorkers, TaskRunner, and every helper accept kubernetes.Interface, and
+//     the workertest subpackage runs TaskRunners against a seeded fake clientset and captures the
+//     messages they report.
+//
+//   - Startup summary: CaptainTellWorkers logs configuration.SummarizeTasks, the task counts by type and
+//     namespace, the tasks missing retry settings, and likely misconfigurations, before the workers start.
+//
+//   - Per-namespace pools: CaptainTellWorkersPerNamespace runs a bounded pool of workers for each namespace,
+//     with an optional global cap on concurrent tasks, so one namespace cannot starve the others.
+//
+//   - API call latency logging: EnableAPILatencyLogging(true) logs the duration of every Kubernetes API call
+//     with its operation, resource, and the task that issued it, to help diagnose slow clusters.
+//
+//   - Retry jitter: SetRetryJitter randomizes retry delays by a bounded fraction so that workers failing
+//     on the same transient error do not retry in lockstep.
+//
+//   - Runner specs: RegisterTaskRunnerWithSpec attaches a RunnerSpec (description, parameters, and required
+//     RBAC rules) to a task type, and GetRunnerSpec returns it, so tooling can work from the registration.
+//
+//   - Plain-text logging: navigator.SetEmojiEnabled(false) drops the emoji prefix from every log message
+//     for log ingestion systems that cannot handle multibyte characters.
+//
+//   - Server-side apply: CrewApplyManifest accepts 'serverSideApply', 'fieldManager', and 'force'. Forcing takes
+//     ownership of fields managed by other controllers and overrides their values, so use it only for fields
+//     the task is meant to own.
+//
+//   - Default object metadata: SetDefaultObjectMeta adds common labels and annotations, such as a managed-by
+//     label or team tags, to every object the create runners build; values set by the task win on conflict.
+//
+//   - JSON results: SetResultFormat(ResultFormatJSON) writes every task outcome to the results channel as a
+//     JSON-encoded TaskResult (task name, type, outcome, message, and timestamp) instead of a text message.
+//
+//   - Best-effort tasks: a task with continueOnError set reports a failure after its retries as a warning result and warning audit outcome instead of a failure.
+//
+//   - Load-time task type validation: InitializeTasks calls ValidateTaskTypes, which rejects tasks whose type has no
+//     registered TaskRunner with one error listing them and the valid types.
+//
+//   - Pod informer cache: StartPodInformer starts a shared pod informer for a namespace and, once synced, serves
+//     the read-only pod listings of runners such as CrewGetPodsTaskRunner, CrewCheckHealthPods, and CrewGetPodsByNode
+//     from the local cache instead of live List calls.
+//
+//   - Retry history: JSON task results carry retryHistory, the failed attempts (number, error, and delay) that
+//     preceded the outcome, capped at the most recent 20. RetryPolicy.History exposes the same records.
+//
+//   - Task output references: parameters may reference ${tasks.<name>.output.<key>}, resolved from the run's
+//     TaskOutputStore before the runner executes. Runners publish outputs with PublishTaskOutput; create runners publish
+//     the 'name' and 'uid' of the objects they create. A task waits for the tasks it references to complete before it
+//     takes a task slot, and workers defer such tasks to run the others first, so the order of the tasks does not matter.
+//     An output of a task that is not part of the run, that failed, or that did not publish it fails the task without
+//     retries, naming the dependency.
+//
+//   - Worker-scoped retry logs: failed attempts are logged through the worker's logger, carrying its index,
+//     at Warn level while the task will be retried and at Error level for the final attempt.
+//
+//   - Retry predicate: SetRetryPredicate adds user-defined criteria for retrying an error; it is consulted after
+//     the built-in checks and can only make errors retriable, never the reverse.
+//
+//   - Parameter key aliases: task parameters may use snake_case keys (for example 'deployment_name'), which
+//     are normalized to the camelCase keys the runners read before every task runs.
+//
+//   - Task tags: the optional 'tags' map of a task is attached, as 'tag.<key>' fields, to the runner's log
+//     entries and to its API latency records; keep tag values low-cardinality, since they act as metric labels.
+//
+//   - Health summaries: CrewCheckHealthPods logs how many pods were checked and how many were unhealthy. A
+//     cancelled scan reports a partial summary and succeeds, unless 'failIfUnhealthy' is set, in which case
+//     any unhealthy pod fails the task.
+//
+//   - Run deadline: SetMaxRunDuration bounds the wall-clock time of a crew run. When it expires, in-flight
+//     tasks are cancelled and reported with the "deadline_exceeded" outcome, wrapping ErrRunDeadlineExceeded,
+//     rather than as failures, and the results channel is closed without waiting for shutdown to be called.
+//
+//   - Continuous mode: RunContinuous re-runs the task list every interval until the context is cancelled,
+//     with fresh claims each cycle, skipping a tick while the previous cycle is still running and reporting a
+//     summary of every cycle's outcomes.
+//
+//   - Distributed claims: claiming is abstracted behind the ClaimStore interface. TaskStatusMap remains the
+//     per-run in-memory default, and SetClaimStore with a LeaseClaimStore lets several controller replicas
+//     coordinate through one coordination/v1 Lease per task, renewed while the task runs.
+//
+//   - Quiet startup: SetQuietStartup(true) suppresses the animated startup banners of the client constructors
+//     and logs a single structured line reporting the in-cluster or out-of-cluster mode instead.
+//
 // # TODO
 //
 //   - Extend the functionality of the CrewWorker function to support a wider range
@@ -0,0 +1,153 @@
+package worker
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"strings"
+	"sync"
+
+	"github.com/H0llyW00dzZ/K8sBlackPearl/language"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/client-go/discovery/cached/memory"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/restmapper"
+)
+
+// dynamicClientAndMapper is the dynamic client and RESTMapper registered for a clientset.
+type dynamicClientAndMapper struct {
+	client dynamic.Interface
+	mapper meta.RESTMapper
+}
+
+// dynamicClients holds the dynamic client and RESTMapper registered for each clientset, so that the
+// runners working with arbitrary resources reuse them, and the discovery cache behind the mapper,
+// across tasks.
+var (
+	dynamicClients   = make(map[kubernetes.Interface]dynamicClientAndMapper)
+	dynamicClientsMu sync.RWMutex
+)
+
+// SetDynamicClient registers the dynamic client, and the RESTMapper resolving kinds into resources,
+// that the runners working with arbitrary resources use alongside the clientset, in a thread-safe
+// manner. The clients created by NewKubernetesClient, NewKubernetesClientWithHealthCheck, and
+// NewKubernetesClientWithOptions are registered automatically; register clientsets built any other
+// way, such as fake clientsets in tests. A nil mapper selects a RESTMapper backed by the cached
+// discovery of the clientset, which is refreshed when a kind is not found.
+//
+// Parameters:
+//
+//	clientset kubernetes.Interface: The clientset passed to the runners.
+//	dynamicClient dynamic.Interface: A dynamic client for the same cluster.
+//	mapper meta.RESTMapper: A RESTMapper for the same cluster, or nil.
+func SetDynamicClient(clientset kubernetes.Interface, dynamicClient dynamic.Interface, mapper meta.RESTMapper) {
+	if mapper == nil {
+		mapper = restmapper.NewDeferredDiscoveryRESTMapper(memory.NewMemCacheClient(clientset.Discovery()))
+	}
+	dynamicClientsMu.Lock()
+	defer dynamicClientsMu.Unlock()
+	dynamicClients[clientset] = dynamicClientAndMapper{client: dynamicClient, mapper: mapper}
+}
+
+// registerDynamicClientForConfig builds a dynamic client from the configuration of a newly created
+// clientset and registers it with SetDynamicClient.
+//
+// This unexported function is used internally by the Kubernetes client constructors.
+func registerDynamicClientForConfig(clientset kubernetes.Interface, config *rest.Config) error {
+	dynamicClient, err := dynamic.NewForConfig(config)
+	if err != nil {
+		return err
+	}
+	SetDynamicClient(clientset, dynamicClient, nil)
+	return nil
+}
+
+// dynamicClientFor returns the dynamic client and RESTMapper registered for the clientset. The
+// dynamic client is used to work with arbitrary resources, while the RESTMapper resolves their kinds
+// into API resources and scopes.
+//
+// Parameters:
+//
+//	clientset kubernetes.Interface: The clientset passed to the runner.
+//
+// Returns:
+//
+//	dynamic.Interface: A dynamic client for unstructured resource operations.
+//	meta.RESTMapper: A RESTMapper backed by a cached discovery client.
+//	error: A non-retriable error if no dynamic client is registered for the clientset.
+func dynamicClientFor(clientset kubernetes.Interface) (dynamic.Interface, meta.RESTMapper, error) {
+	dynamicClientsMu.RLock()
+	registered, ok := dynamicClients[clientset]
+	dynamicClientsMu.RUnlock()
+	if !ok {
+		return nil, nil, markNonRetriable(errors.New(language.ErrorDynamicClientMissing))
+	}
+	return registered.client, registered.mapper, nil
+}
+
+// decodeManifest splits a manifest containing one or more YAML or JSON documents into
+// unstructured objects. Empty documents (for example a trailing '---') are skipped.
+//
+// Parameters:
+//
+//	manifest string: The manifest content to decode.
+//
+// Returns:
+//
+//	[]*unstructured.Unstructured: The decoded objects in document order.
+//	error: An error if any document cannot be decoded.
+func decodeManifest(manifest string) ([]*unstructured.Unstructured, error) {
+	decoder := yaml.NewYAMLOrJSONDecoder(bytes.NewReader([]byte(strings.TrimSpace(manifest))), 4096)
+	var objects []*unstructured.Unstructured
+	for {
+		var document map[string]interface{}
+		if err := decoder.Decode(&document); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, err
+		}
+		if len(document) == 0 {
+			continue
+		}
+		objects = append(objects, &unstructured.Unstructured{Object: document})
+	}
+	return objects, nil
+}
+
+// resourceInterfaceFor resolves the dynamic resource interface for an object using the RESTMapper.
+// When the resource is namespaced and the object does not declare a namespace, the provided
+// default namespace is set on the object.
+//
+// Parameters:
+//
+//	dynamicClient dynamic.Interface: The dynamic client used to build the resource interface.
+//	mapper meta.RESTMapper: The RESTMapper used to resolve the object's kind.
+//	obj *unstructured.Unstructured: The object to resolve.
+//	defaultNamespace string: The namespace applied to namespaced objects that do not declare one.
+//
+// Returns:
+//
+//	dynamic.ResourceInterface: The interface for operating on the object.
+//	*meta.RESTMapping: The resolved mapping, including the resource scope.
+//	error: An error if the object's kind cannot be resolved.
+func resourceInterfaceFor(dynamicClient dynamic.Interface, mapper meta.RESTMapper, obj *unstructured.Unstructured, defaultNamespace string) (dynamic.ResourceInterface, *meta.RESTMapping, error) {
+	gvk := obj.GroupVersionKind()
+	mapping, err := mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if mapping.Scope.Name() == meta.RESTScopeNameNamespace {
+		if obj.GetNamespace() == "" {
+			obj.SetNamespace(defaultNamespace)
+		}
+		return dynamicClient.Resource(mapping.Resource).Namespace(obj.GetNamespace()), mapping, nil
+	}
+
+	return dynamicClient.Resource(mapping.Resource), mapping, nil
+}
@@ -0,0 +1,81 @@
+package worker
+
+import (
+	"context"
+	"testing"
+
+	"github.com/H0llyW00dzZ/K8sBlackPearl/worker/configuration"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/rest"
+)
+
+// forgetDynamicClient removes the dynamic client registered for the clientset once the test ends.
+func forgetDynamicClient(t *testing.T, clientset *fake.Clientset) {
+	t.Cleanup(func() {
+		dynamicClientsMu.Lock()
+		delete(dynamicClients, clientset)
+		dynamicClientsMu.Unlock()
+	})
+}
+
+func TestDynamicClientForUnregisteredClientsetFailsWithoutRetries(t *testing.T) {
+	task := configuration.Task{Name: "pod-metrics", Type: "CrewGetPodMetrics", ShipsNamespace: "default"}
+	err := (&CrewGetPodMetrics{}).Run(context.Background(), fake.NewSimpleClientset(), "default", task, map[string]interface{}{}, 0)
+	if err == nil || !isNonRetriable(err) {
+		t.Fatalf("got error %v, want a non-retriable error", err)
+	}
+}
+
+func TestRegisterDynamicClientForConfigCachesTheMapper(t *testing.T) {
+	clientset := fake.NewSimpleClientset()
+	forgetDynamicClient(t, clientset)
+	if err := registerDynamicClientForConfig(clientset, &rest.Config{Host: "https://cluster.example"}); err != nil {
+		t.Fatalf("registerDynamicClientForConfig: %v", err)
+	}
+
+	first, firstMapper, err := dynamicClientFor(clientset)
+	if err != nil || first == nil || firstMapper == nil {
+		t.Fatalf("got client %v, mapper %v, error %v", first, firstMapper, err)
+	}
+	second, secondMapper, _ := dynamicClientFor(clientset)
+	if second != first || secondMapper != firstMapper {
+		t.Fatal("each lookup built a new dynamic client or RESTMapper")
+	}
+}
+
+func TestCrewApplyManifestUsesTheRegisteredDynamicClient(t *testing.T) {
+	clientset := fake.NewSimpleClientset()
+	forgetDynamicClient(t, clientset)
+	scheme := runtime.NewScheme()
+	if err := corev1.AddToScheme(scheme); err != nil {
+		t.Fatalf("AddToScheme: %v", err)
+	}
+	dynamicClient := dynamicfake.NewSimpleDynamicClient(scheme)
+	mapper := meta.NewDefaultRESTMapper([]schema.GroupVersion{corev1.SchemeGroupVersion})
+	mapper.Add(corev1.SchemeGroupVersion.WithKind("ConfigMap"), meta.RESTScopeNamespace)
+	SetDynamicClient(clientset, dynamicClient, mapper)
+
+	task := configuration.Task{Name: "apply", Type: "CrewApplyManifest", ShipsNamespace: "default"}
+	parameters := map[string]interface{}{
+		"manifest": "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: settings\ndata:\n  mode: calm\n",
+	}
+	if err := (&CrewApplyManifest{}).Run(context.Background(), clientset, "default", task, parameters, 0); err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+
+	configMaps := corev1.SchemeGroupVersion.WithResource("configmaps")
+	created, err := dynamicClient.Resource(configMaps).Namespace("default").Get(context.Background(), "settings", v1.GetOptions{})
+	if err != nil {
+		t.Fatalf("the manifest object was not created through the dynamic client: %v", err)
+	}
+	if mode, _, _ := unstructured.NestedString(created.Object, "data", "mode"); mode != "calm" {
+		t.Fatalf("got data.mode %q, want %q", mode, "calm")
+	}
+}
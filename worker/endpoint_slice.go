@@ -0,0 +1,302 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/H0llyW00dzZ/K8sBlackPearl/language"
+	"github.com/H0llyW00dzZ/K8sBlackPearl/navigator"
+	"github.com/H0llyW00dzZ/go-urlshortner/logmonitor/constant"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/util/retry"
+)
+
+// CreateEndpointSlice creates a discovery/v1 EndpointSlice for a Service. The slice is labeled with
+// kubernetes.io/service-name, which associates it with the Service, and with
+// endpointslice.kubernetes.io/managed-by, so the EndpointSlice controller leaves it alone. If it
+// already exists and overwrite is enabled, its endpoints and ports are replaced, retrying on
+// conflicts. The address type of an existing slice is immutable, so it cannot be changed this way.
+//
+// Parameters:
+//
+//	ctx context.Context: Context for cancellation and timeout.
+//	clientset kubernetes.Interface: A Kubernetes clientset for interacting with the Kubernetes API.
+//	namespace string: The namespace of the Service.
+//	slice *discoveryv1.EndpointSlice: The EndpointSlice to create.
+//	overwrite bool: Whether the endpoints and ports of an existing EndpointSlice should be replaced.
+//	results chan<- string: A channel to send operation results for logging.
+//	logger *zap.Logger: A logger for structured logging.
+//
+// Returns an error if the EndpointSlice cannot be created or overwritten.
+func CreateEndpointSlice(ctx context.Context, clientset kubernetes.Interface, namespace string, slice *discoveryv1.EndpointSlice, overwrite bool, results chan<- string, logger *zap.Logger) error {
+	name := slice.Name
+	applyDefaultObjectMeta(slice)
+	created, err := clientset.DiscoveryV1().EndpointSlices(namespace).Create(ctx, slice, v1.CreateOptions{})
+	if err == nil {
+		publishCreatedObject(ctx, created)
+		successMsg := fmt.Sprintf(language.EndpointSliceSuccessfullyCreated, name, namespace, len(slice.Endpoints), slice.AddressType)
+		results <- successMsg
+		navigator.LogInfoWithEmoji(constant.SuccessEmoji, successMsg)
+		return nil
+	}
+
+	if !apierrors.IsAlreadyExists(err) {
+		return reportEndpointSliceFailure(results, name, fmt.Errorf(language.ErrorCreatingEndpointSlice, err))
+	}
+	if !overwrite {
+		return reportEndpointSliceFailure(results, name, markNonRetriable(fmt.Errorf(language.ErrorEndpointSliceAlreadyExists, name)))
+	}
+
+	err = retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		current, getErr := clientset.DiscoveryV1().EndpointSlices(namespace).Get(ctx, name, v1.GetOptions{})
+		if getErr != nil {
+			return getErr
+		}
+		if current.AddressType != slice.AddressType {
+			return markNonRetriable(fmt.Errorf(language.ErrorEndpointSliceAddressType, name, current.AddressType, slice.AddressType))
+		}
+		current.Endpoints = slice.Endpoints
+		current.Ports = slice.Ports
+		_, updateErr := clientset.DiscoveryV1().EndpointSlices(namespace).Update(ctx, current, v1.UpdateOptions{})
+		return updateErr
+	})
+	if err != nil {
+		return reportEndpointSliceFailure(results, name, err)
+	}
+
+	successMsg := fmt.Sprintf(language.EndpointSliceSuccessfullyUpdated, name, namespace, len(slice.Endpoints), slice.AddressType)
+	results <- successMsg
+	navigator.LogInfoWithEmoji(constant.SuccessEmoji, successMsg)
+	return nil
+}
+
+// reportEndpointSliceFailure sends an error message to the results channel, logs the failure,
+// and returns the error so callers can propagate it directly.
+//
+// This unexported function is used internally by CreateEndpointSlice to report failures.
+func reportEndpointSliceFailure(results chan<- string, name string, err error) error {
+	errorMessage := fmt.Sprintf(language.ErrorFailedToCreateEndpointSlice, name, err)
+	results <- errorMessage
+	navigator.LogErrorWithEmojiRateLimited(constant.ErrorEmoji, errorMessage, zap.Error(err))
+	return err
+}
+
+// extractEndpointSliceParameters extracts and validates the 'serviceName', 'addressType', 'endpoints',
+// 'ports', and optional 'endpointSliceName' and 'overwrite' parameters. The slice is named after the
+// Service unless 'endpointSliceName' is given. The address type is one of 'IPv4', 'IPv6', or 'FQDN'.
+// Each endpoint entry requires 'addresses', all of which must match the address type, and may set a
+// 'hostname' (a DNS label) and 'conditions' with the 'ready', 'serving', and 'terminating' flags.
+// Each port entry requires a 'port' between 1 and 65535 and may set a 'protocol' of 'TCP', 'UDP', or
+// 'SCTP'. A 'name' is required on every port entry when several ports are given.
+//
+// This function is used by task runners that manage EndpointSlices.
+func extractEndpointSliceParameters(parameters map[string]interface{}) (*discoveryv1.EndpointSlice, bool, error) {
+	serviceName, err := getParamAsString(parameters, serviceNamE)
+	if err != nil || serviceName == "" {
+		return nil, false, newParameterError(serviceNamE, err, language.ErrorParameterMissing, serviceNamE)
+	}
+
+	sliceName, err := getOptionalParamAsString(parameters, endpointSliceNamE, serviceName)
+	if err != nil {
+		return nil, false, err
+	}
+	if problems := validation.IsDNS1123Subdomain(sliceName); len(problems) > 0 {
+		return nil, false, newParameterError(endpointSliceNamE, nil, language.ErrorParameterInvalid, endpointSliceNamE)
+	}
+
+	addressType, err := getParamAsString(parameters, addressTypE)
+	if err != nil {
+		return nil, false, newParameterError(addressTypE, err, language.ErrorParameterMissing, addressTypE)
+	}
+	switch discoveryv1.AddressType(addressType) {
+	case discoveryv1.AddressTypeIPv4, discoveryv1.AddressTypeIPv6, discoveryv1.AddressTypeFQDN:
+	default:
+		return nil, false, newParameterError(addressTypE, nil, language.ErrorInvalidAddressType, addressType)
+	}
+
+	rawEndpoints, err := getParamAsSlice(parameters, endpointS)
+	if err != nil {
+		return nil, false, err
+	}
+	if len(rawEndpoints) == 0 {
+		return nil, false, newParameterError(endpointS, nil, language.ErrorParameterMissing, endpointS)
+	}
+	endpoints := make([]discoveryv1.Endpoint, 0, len(rawEndpoints))
+	for i, rawEndpoint := range rawEndpoints {
+		endpoint, err := parseSliceEndpoint(rawEndpoint, discoveryv1.AddressType(addressType))
+		if err != nil {
+			return nil, false, newParameterError(endpointS, err, language.ErrorParameterSliceEndpoint, i, err)
+		}
+		endpoints = append(endpoints, endpoint)
+	}
+
+	rawPorts, err := getParamAsSlice(parameters, porTs)
+	if err != nil {
+		return nil, false, err
+	}
+	if len(rawPorts) == 0 {
+		return nil, false, newParameterError(porTs, nil, language.ErrorParameterMissing, porTs)
+	}
+	ports := make([]discoveryv1.EndpointPort, 0, len(rawPorts))
+	for i, rawPort := range rawPorts {
+		port, err := parseSlicePort(rawPort, len(rawPorts) > 1)
+		if err != nil {
+			return nil, false, newParameterError(porTs, err, language.ErrorParameterServicePort, i, err)
+		}
+		ports = append(ports, port)
+	}
+
+	overwrite, err := getOptionalParamAsBool(parameters, overwritE, false)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return &discoveryv1.EndpointSlice{
+		ObjectMeta: v1.ObjectMeta{
+			Name: sliceName,
+			Labels: map[string]string{
+				discoveryv1.LabelServiceName: serviceName,
+				discoveryv1.LabelManagedBy:   defaultFieldManager,
+			},
+		},
+		AddressType: discoveryv1.AddressType(addressType),
+		Endpoints:   endpoints,
+		Ports:       ports,
+	}, overwrite, nil
+}
+
+// parseSliceEndpoint converts a single decoded 'endpoints' entry into an EndpointSlice endpoint,
+// validating each address against the address type. Conditions that are not given are left unset,
+// which consumers treat as ready and serving.
+//
+// This unexported function is used internally by extractEndpointSliceParameters.
+func parseSliceEndpoint(rawEndpoint interface{}, addressType discoveryv1.AddressType) (discoveryv1.Endpoint, error) {
+	entry, ok := toStringInterfaceMap(rawEndpoint)
+	if !ok {
+		return discoveryv1.Endpoint{}, newParameterError(endpointS, nil, language.ErrorParameterInvalid, endpointS)
+	}
+
+	rawAddresses, err := getParamAsSlice(entry, addresseS)
+	if err != nil {
+		return discoveryv1.Endpoint{}, err
+	}
+	if len(rawAddresses) == 0 {
+		return discoveryv1.Endpoint{}, newParameterError(addresseS, nil, language.ErrorParameterMissing, addresseS)
+	}
+	addresses := make([]string, 0, len(rawAddresses))
+	for _, rawAddress := range rawAddresses {
+		address, ok := rawAddress.(string)
+		if !ok || !validSliceAddress(address, addressType) {
+			return discoveryv1.Endpoint{}, fmt.Errorf(language.ErrorInvalidSliceAddress, rawAddress, addressType)
+		}
+		addresses = append(addresses, address)
+	}
+	endpoint := discoveryv1.Endpoint{Addresses: addresses}
+
+	hostname, err := getOptionalParamAsString(entry, hostnamE, "")
+	if err != nil {
+		return discoveryv1.Endpoint{}, err
+	}
+	if hostname != "" {
+		if problems := validation.IsDNS1123Label(hostname); len(problems) > 0 {
+			return discoveryv1.Endpoint{}, fmt.Errorf(language.ErrorInvalidHostname, hostname, problems[0])
+		}
+		endpoint.Hostname = &hostname
+	}
+
+	if rawConditions, exists := entry[conditionS]; exists {
+		conditions, ok := toStringInterfaceMap(rawConditions)
+		if !ok {
+			return discoveryv1.Endpoint{}, newParameterError(conditionS, nil, language.ErrorParameterInvalid, conditionS)
+		}
+		for key, target := range map[string]**bool{
+			language.EndpointReady: &endpoint.Conditions.Ready,
+			servinG:                &endpoint.Conditions.Serving,
+			terminatinG:            &endpoint.Conditions.Terminating,
+		} {
+			if _, exists := conditions[key]; !exists {
+				continue
+			}
+			value, err := getOptionalParamAsBool(conditions, key, false)
+			if err != nil {
+				return discoveryv1.Endpoint{}, err
+			}
+			*target = &value
+		}
+	}
+
+	return endpoint, nil
+}
+
+// validSliceAddress reports whether the address is valid for the address type: a dotted IPv4
+// address, an IPv6 address, or a fully qualified domain name.
+//
+// This unexported function is used internally by parseSliceEndpoint.
+func validSliceAddress(address string, addressType discoveryv1.AddressType) bool {
+	switch addressType {
+	case discoveryv1.AddressTypeIPv4:
+		ip := net.ParseIP(address)
+		return ip != nil && ip.To4() != nil && !strings.Contains(address, ":")
+	case discoveryv1.AddressTypeIPv6:
+		ip := net.ParseIP(address)
+		return ip != nil && strings.Contains(address, ":")
+	case discoveryv1.AddressTypeFQDN:
+		return len(validation.IsFullyQualifiedDomainName(field.NewPath(addresseS), address)) == 0
+	}
+	return false
+}
+
+// parseSlicePort converts a single decoded 'ports' entry into an EndpointSlice port. The name is
+// required when the slice exposes several ports.
+//
+// This unexported function is used internally by extractEndpointSliceParameters.
+func parseSlicePort(rawPort interface{}, nameRequired bool) (discoveryv1.EndpointPort, error) {
+	entry, ok := toStringInterfaceMap(rawPort)
+	if !ok {
+		return discoveryv1.EndpointPort{}, newParameterError(porTs, nil, language.ErrorParameterInvalid, porTs)
+	}
+
+	port, err := getParamAsInt(entry, porT)
+	if err != nil {
+		return discoveryv1.EndpointPort{}, err
+	}
+	if port < 1 || port > 65535 {
+		return discoveryv1.EndpointPort{}, fmt.Errorf(language.ErrorInvalidPort, port)
+	}
+
+	name, err := getOptionalParamAsString(entry, portNamE, "")
+	if err != nil {
+		return discoveryv1.EndpointPort{}, err
+	}
+	if name == "" && nameRequired {
+		return discoveryv1.EndpointPort{}, fmt.Errorf(language.ErrorServicePortNameRequired, port)
+	}
+	if name != "" {
+		if problems := validation.IsDNS1123Label(name); len(problems) > 0 {
+			return discoveryv1.EndpointPort{}, fmt.Errorf(language.ErrorInvalidPortName, name, problems[0])
+		}
+	}
+
+	protocol, err := getOptionalParamAsString(entry, protocoL, string(corev1.ProtocolTCP))
+	if err != nil {
+		return discoveryv1.EndpointPort{}, err
+	}
+	switch corev1.Protocol(protocol) {
+	case corev1.ProtocolTCP, corev1.ProtocolUDP, corev1.ProtocolSCTP:
+	default:
+		return discoveryv1.EndpointPort{}, fmt.Errorf(language.ErrorInvalidProtocol, protocol)
+	}
+
+	portNumber := int32(port)
+	protocolValue := corev1.Protocol(protocol)
+	return discoveryv1.EndpointPort{Name: &name, Port: &portNumber, Protocol: &protocolValue}, nil
+}
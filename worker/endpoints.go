@@ -0,0 +1,179 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	"github.com/H0llyW00dzZ/K8sBlackPearl/language"
+	"github.com/H0llyW00dzZ/K8sBlackPearl/navigator"
+	"github.com/H0llyW00dzZ/go-urlshortner/logmonitor/constant"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/util/retry"
+)
+
+// CreateEndpoints creates a corev1.Endpoints object that points a selector-less Service at external
+// backends. The Endpoints object must have the same name as the Service. If it already exists and
+// overwrite is enabled, its subsets are replaced, retrying on conflicts.
+//
+// This targets the legacy core/v1 Endpoints API. Clusters that consume EndpointSlices mirror
+// custom Endpoints automatically through the EndpointSlice mirroring controller.
+//
+// Parameters:
+//
+//	ctx context.Context: Context for cancellation and timeout.
+//	clientset kubernetes.Interface: A Kubernetes clientset for interacting with the Kubernetes API.
+//	namespace string: The namespace of the Service.
+//	endpoints *corev1.Endpoints: The Endpoints object to create.
+//	overwrite bool: Whether the subsets of an existing Endpoints object should be replaced.
+//	results chan<- string: A channel to send operation results for logging.
+//	logger *zap.Logger: A logger for structured logging.
+//
+// Returns an error if the Endpoints object cannot be created or overwritten.
+func CreateEndpoints(ctx context.Context, clientset kubernetes.Interface, namespace string, endpoints *corev1.Endpoints, overwrite bool, results chan<- string, logger *zap.Logger) error {
+	name := endpoints.Name
+	applyDefaultObjectMeta(endpoints)
+	created, err := clientset.CoreV1().Endpoints(namespace).Create(ctx, endpoints, v1.CreateOptions{})
+	if err == nil {
+		publishCreatedObject(ctx, created)
+		successMsg := fmt.Sprintf(language.EndpointsSuccessfullyCreated, name, namespace)
+		results <- successMsg
+		navigator.LogInfoWithEmoji(constant.SuccessEmoji, successMsg)
+		return nil
+	}
+
+	if !apierrors.IsAlreadyExists(err) {
+		return reportEndpointsFailure(results, name, fmt.Errorf(language.ErrorCreatingEndpoints, err))
+	}
+	if !overwrite {
+		return reportEndpointsFailure(results, name, fmt.Errorf(language.ErrorEndpointsAlreadyExists, name))
+	}
+
+	err = retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		current, getErr := clientset.CoreV1().Endpoints(namespace).Get(ctx, name, v1.GetOptions{})
+		if getErr != nil {
+			return getErr
+		}
+		current.Subsets = endpoints.Subsets
+		_, updateErr := clientset.CoreV1().Endpoints(namespace).Update(ctx, current, v1.UpdateOptions{})
+		return updateErr
+	})
+	if err != nil {
+		return reportEndpointsFailure(results, name, err)
+	}
+
+	successMsg := fmt.Sprintf(language.EndpointsSuccessfullyUpdated, name, namespace)
+	results <- successMsg
+	navigator.LogInfoWithEmoji(constant.SuccessEmoji, successMsg)
+	return nil
+}
+
+// reportEndpointsFailure sends an error message to the results channel, logs the failure,
+// and returns the error so callers can propagate it directly.
+//
+// This unexported function is used internally by CreateEndpoints to report failures.
+func reportEndpointsFailure(results chan<- string, name string, err error) error {
+	errorMessage := fmt.Sprintf(language.ErrorFailedToCreateEndpoints, name, err)
+	results <- errorMessage
+	navigator.LogErrorWithEmojiRateLimited(constant.ErrorEmoji, errorMessage, zap.Error(err))
+	return err
+}
+
+// extractEndpointsParameters extracts and validates the 'serviceName', 'addresses', and 'overwrite'
+// parameters. Each address entry requires an 'ip' and a 'port' between 1 and 65535, and may set a
+// 'hostname' (a DNS label) and a 'protocol' of 'TCP', 'UDP', or 'SCTP'. Addresses sharing the same
+// port and protocol are grouped into a single subset.
+//
+// This function is used by task runners that manage Endpoints.
+func extractEndpointsParameters(parameters map[string]interface{}) (*corev1.Endpoints, bool, error) {
+	serviceName, err := getParamAsString(parameters, serviceNamE)
+	if err != nil || serviceName == "" {
+		return nil, false, newParameterError(serviceNamE, err, language.ErrorParameterMissing, serviceNamE)
+	}
+
+	rawAddresses, err := getParamAsSlice(parameters, addresseS)
+	if err != nil {
+		return nil, false, err
+	}
+	if len(rawAddresses) == 0 {
+		return nil, false, newParameterError(addresseS, nil, language.ErrorParameterMissing, addresseS)
+	}
+
+	endpoints := &corev1.Endpoints{ObjectMeta: v1.ObjectMeta{Name: serviceName}}
+	subsetIndex := make(map[corev1.EndpointPort]int)
+	for i, rawAddress := range rawAddresses {
+		address, port, err := parseEndpointAddress(rawAddress)
+		if err != nil {
+			return nil, false, newParameterError(addresseS, err, language.ErrorParameterEndpointAddress, i, err)
+		}
+		index, exists := subsetIndex[port]
+		if !exists {
+			index = len(endpoints.Subsets)
+			subsetIndex[port] = index
+			endpoints.Subsets = append(endpoints.Subsets, corev1.EndpointSubset{Ports: []corev1.EndpointPort{port}})
+		}
+		endpoints.Subsets[index].Addresses = append(endpoints.Subsets[index].Addresses, address)
+	}
+
+	overwrite, err := getOptionalParamAsBool(parameters, overwritE, false)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return endpoints, overwrite, nil
+}
+
+// parseEndpointAddress converts a single decoded 'addresses' entry into an endpoint address and port.
+//
+// This unexported function is used internally by extractEndpointsParameters.
+func parseEndpointAddress(rawAddress interface{}) (corev1.EndpointAddress, corev1.EndpointPort, error) {
+	entry, ok := toStringInterfaceMap(rawAddress)
+	if !ok {
+		return corev1.EndpointAddress{}, corev1.EndpointPort{}, newParameterError(addresseS, nil, language.ErrorParameterInvalid, addresseS)
+	}
+
+	ip, err := getParamAsString(entry, iP)
+	if err != nil {
+		return corev1.EndpointAddress{}, corev1.EndpointPort{}, err
+	}
+	if net.ParseIP(ip) == nil {
+		return corev1.EndpointAddress{}, corev1.EndpointPort{}, fmt.Errorf(language.ErrorInvalidIPAddress, ip)
+	}
+
+	port, err := getParamAsInt(entry, porT)
+	if err != nil {
+		return corev1.EndpointAddress{}, corev1.EndpointPort{}, err
+	}
+	if port < 1 || port > 65535 {
+		return corev1.EndpointAddress{}, corev1.EndpointPort{}, fmt.Errorf(language.ErrorInvalidPort, port)
+	}
+
+	hostname, err := getOptionalParamAsString(entry, hostnamE, "")
+	if err != nil {
+		return corev1.EndpointAddress{}, corev1.EndpointPort{}, err
+	}
+	if hostname != "" {
+		if problems := validation.IsDNS1123Label(hostname); len(problems) > 0 {
+			return corev1.EndpointAddress{}, corev1.EndpointPort{}, fmt.Errorf(language.ErrorInvalidHostname, hostname, problems[0])
+		}
+	}
+
+	protocol, err := getOptionalParamAsString(entry, protocoL, string(corev1.ProtocolTCP))
+	if err != nil {
+		return corev1.EndpointAddress{}, corev1.EndpointPort{}, err
+	}
+	switch corev1.Protocol(protocol) {
+	case corev1.ProtocolTCP, corev1.ProtocolUDP, corev1.ProtocolSCTP:
+	default:
+		return corev1.EndpointAddress{}, corev1.EndpointPort{}, fmt.Errorf(language.ErrorInvalidProtocol, protocol)
+	}
+
+	address := corev1.EndpointAddress{IP: ip, Hostname: hostname}
+	endpointPort := corev1.EndpointPort{Port: int32(port), Protocol: corev1.Protocol(protocol)}
+	return address, endpointPort, nil
+}
@@ -2,9 +2,10 @@ package worker
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"runtime/debug"
 	"strings"
-	"time"
 
 	"github.com/H0llyW00dzZ/K8sBlackPearl/language"
 	"github.com/H0llyW00dzZ/K8sBlackPearl/navigator"
@@ -15,29 +16,47 @@ import (
 	"k8s.io/client-go/kubernetes"
 )
 
-// performTaskWithRetries tries to execute a task, with retries on failure.
+// performTaskWithRetries tries to execute a task, with retries on failure, using RetryPolicy.
 // It honors the cancellation signal from the context and ceases retry attempts
 // if the context is cancelled. If the task remains incomplete after all retries,
-// it returns an error detailing the failure.
+// it returns an error detailing the failure. A conflict is resolved by refreshing
+// the task's parameters before the next attempt.
+//
+// Conflicts and other failures draw from a single budget: every execution of the task,
+// whatever the failure that preceded it, counts as one attempt, so MaxRetries bounds the
+// total number of executions and the reported count reflects every one of them.
+// The failed attempts are collected the same way, so that a task that eventually succeeds
+// can report the failures it overcame. Reporting the outcome is left to the caller,
+// processTask, so that every task's success or failure is reported exactly once.
 //
 // Parameters:
 //
 //	ctx context.Context: Context for task cancellation and timeouts.
-//	clientset *kubernetes.Clientset: Kubernetes API client for executing tasks.
+//	clientset kubernetes.Interface: Kubernetes API client for executing tasks.
 //	shipsNamespace string: Kubernetes namespace for task execution.
 //	task configuration.Task: Task to be executed.
-//	results chan<- string: Channel for reporting task execution results.
 //	workerIndex int: Index of the worker for contextual logging.
-//	taskStatus *TaskStatusMap: Map to track and control the status of tasks.
+//	logger *zap.Logger: The worker's logger for failed attempts, or nil to use the global logger.
 //
 // Returns:
 //
-//	error: Error if the task fails after all retry attempts.
-func performTaskWithRetries(ctx context.Context, clientset *kubernetes.Clientset, shipsNamespace string, task configuration.Task, results chan<- string, workerIndex int, taskStatus *TaskStatusMap) error {
+//	int: The total number of attempts made to execute the task.
+//	[]AttemptRecord: The failed attempts, oldest first, capped at maxAttemptHistory.
+//	error: A *TaskExecutionError wrapping the last attempt's error if the task fails after all retry attempts.
+func performTaskWithRetries(ctx context.Context, clientset kubernetes.Interface, shipsNamespace string, task configuration.Task, workerIndex int, logger *zap.Logger) (int, []AttemptRecord, error) {
+	var lastTaskErr error
 	// Define the operation to be retried.
 	operation := func() (string, error) {
 		// Attempt to perform the task.
 		err := performTask(ctx, clientset, shipsNamespace, task, workerIndex)
+		lastTaskErr = err // Keep the raw error so the final error can wrap it.
+		// A conflict may be resolved by refreshing the task's parameters, in which case
+		// the next attempt runs with the updated parameters.
+		if apierrors.IsConflict(err) && !handleConflictError(ctx, clientset, shipsNamespace, &task) {
+			navigator.LogInfoWithEmoji(language.WarningEmoji, fmt.Sprintf(language.ConflictUnresolved, task.Name),
+				zap.String(language.Task_Name, task.Name),
+			)
+		}
 		return task.Name, err // Return the task name along with the error.
 	}
 
@@ -45,39 +64,20 @@ func performTaskWithRetries(ctx context.Context, clientset *kubernetes.Clientset
 	retryPolicy := RetryPolicy{
 		MaxRetries: task.MaxRetries,
 		RetryDelay: task.RetryDelayDuration,
+		Logger:     logger,
 	}
 
 	// Use the RetryPolicy's Execute method to perform the operation with retries.
 	err := retryPolicy.Execute(ctx, operation, func(message string, fields ...zap.Field) {
-		// This is a placeholder for the actual logging function.
-		// Replace this with the actual function to log retries.
-		// For example: navigator.LogInfoWithEmoji or navigator.LogErrorWithEmoji
+		// Used only when no worker logger is given; otherwise the policy logs through it.
 		// Combine emojis with a space for readability.
 		emojiField := fmt.Sprintf("%s %s", constant.ErrorEmoji, language.PirateEmoji)
 		navigator.LogErrorWithEmoji(emojiField, message, fields...)
 	})
-
-	if err != nil {
-		// Additional error handling logic
-		if apierrors.IsConflict(err) {
-			// Handle conflict-specific errors
-			conflictResolved := handleConflictError(ctx, clientset, shipsNamespace, &task)
-			if conflictResolved {
-				// Conflict resolved, retry the operation
-				return performTaskWithRetries(ctx, clientset, shipsNamespace, task, results, workerIndex, taskStatus)
-			}
-		} else {
-			// Handle generic errors that are not conflicts
-			handleGenericError(ctx, err, task.MaxRetries, &task, workerIndex, task.MaxRetries, task.RetryDelayDuration)
-		}
-
-		handleFailedTask(task, taskStatus, shipsNamespace, err, results, workerIndex)
-		return fmt.Errorf(language.ErrorFailedToCompleteTask, task.Name, task.MaxRetries)
+	if err == nil {
+		return retryPolicy.Attempts(), retryPolicy.History(), nil
 	}
-
-	// If the operation was successful, handle the success.
-	handleSuccessfulTask(task, results, workerIndex)
-	return nil
+	return retryPolicy.Attempts(), retryPolicy.History(), &TaskExecutionError{TaskName: task.Name, Attempts: retryPolicy.Attempts(), Cause: lastTaskErr}
 }
 
 // logRetryAttempt logs a warning message indicating a task retry attempt with the current count.
@@ -88,15 +88,16 @@ func performTaskWithRetries(ctx context.Context, clientset *kubernetes.Clientset
 //
 //	taskName string: The name of the task being attempted.
 //	attempt int: The current retry attempt number.
-//	err error: The error encountered during the task execution that prompted the retry.
 //	maxRetries int: The maximum number of retry attempts.
+//	final bool: Whether no further attempt will be made.
+//	err error: The error encountered during the task execution that prompted the retry.
 //	logFunc func(string, ...zap.Field): The log function to use.
-func logRetryAttempt(taskName string, attempt int, maxRetries int, err error, logFunc func(string, ...zap.Field)) {
+func logRetryAttempt(taskName string, attempt int, maxRetries int, final bool, err error, logFunc func(string, ...zap.Field)) {
 	// Initialize a slice with the error emoji.
 	emojis := []string{language.RetryEmoji}
 
 	// If it's the final attempt, add the warning emoji to the slice.
-	if attempt == maxRetries {
+	if final {
 		emojis = append(emojis, constant.ErrorEmoji, language.WarningEmoji)
 	}
 
@@ -118,73 +119,113 @@ func logRetryAttempt(taskName string, attempt int, maxRetries int, err error, lo
 }
 
 // logFinalError logs an error message signaling the final failure of a task after all retries.
-// It includes the task name and the error returned from the last attempt. The maxRetries variable is used
-// to indicate the total number of allowed retries.
+// It includes the task name and the error returned from the last attempt. The attempts variable is used
+// to indicate the number of attempts that were actually made.
 //
 // Parameters:
 //
 //	shipsnamespace string: The namespace where the task was attempted.
 //	taskName string: The name of the task that failed.
 //	err error: The final error encountered that resulted in the task failure.
-//	maxRetries int: The maximum number of retry attempts.
-func logFinalError(shipsnamespace string, taskName string, err error, maxRetries int) {
-	finalErrorMessage := fmt.Sprintf(language.ErrorFailedToCompleteTask, taskName, maxRetries)
+//	attempts int: The number of attempts made before giving up.
+func logFinalError(shipsnamespace string, taskName string, err error, attempts int) {
+	finalErrorMessage := fmt.Sprintf(language.ErrorFailedToCompleteTask, taskName, attempts)
 	navigator.LogErrorWithEmojiRateLimited(
 		constant.ErrorEmoji,
 		finalErrorMessage,
 		zap.String(language.Ships_Namespace, shipsnamespace),
 		zap.String(language.Task_Name, taskName),
+		zap.Int(language.Attempt, attempts),
 		zap.Error(err),
 	)
 }
 
 // handleConflictError is called when a conflict error is detected during task execution. It attempts to resolve
-// the conflict by calling resolveConflict. If resolving the conflict fails, it returns false to indicate that the
-// task should not be retried. Otherwise, it returns true, suggesting that the task may be retried.
+// the conflict by calling resolveConflict, so that the next attempt runs with refreshed parameters. If resolving
+// the conflict fails, it returns false and the next attempt runs with the parameters unchanged. Either way, the
+// failed attempt counts against the task's retry budget.
 //
 // Parameters:
 //
 //	ctx context.Context: The context governing cancellation.
-//	clientset *kubernetes.Clientset: The Kubernetes client set used for task operations.
+//	clientset kubernetes.Interface: The Kubernetes client set used for task operations.
 //	shipsnamespace string: The Kubernetes namespace where the task was attempted.
 //	task *configuration.Task: The task being attempted.
 //
 // Returns:
 //
-//	bool: A boolean indicating whether the task should be retried after conflict resolution.
-func handleConflictError(ctx context.Context, clientset *kubernetes.Clientset, shipsnamespace string, task *configuration.Task) bool {
+//	bool: A boolean indicating whether the conflict was resolved.
+func handleConflictError(ctx context.Context, clientset kubernetes.Interface, shipsnamespace string, task *configuration.Task) bool {
 	if resolveErr := resolveConflict(ctx, clientset, shipsnamespace, task); resolveErr != nil {
 		return false
 	}
 	return true
 }
 
-// handleGenericError handles non-conflict errors encountered during task execution. It logs the retry attempt
-// and enforces a delay before the next attempt based on retryDelay. If the context is canceled during this delay,
-// it returns false to indicate that the task should not be retried. Otherwise, it returns true to suggest that
-// the task may be retried.
+// nonRetriableError marks an error as permanent, signaling the retry logic that further
+// attempts cannot succeed (for example, when a required API is not installed in the cluster).
+type nonRetriableError struct {
+	err error
+}
+
+// Error returns the message of the wrapped error.
+func (e *nonRetriableError) Error() string {
+	return e.err.Error()
+}
+
+// Unwrap returns the wrapped error so errors.Is and errors.As keep working.
+func (e *nonRetriableError) Unwrap() error {
+	return e.err
+}
+
+// markNonRetriable wraps an error so that RetryPolicy.Execute stops retrying when it is returned.
 //
 // Parameters:
 //
-//	ctx context.Context: The context governing cancellation.
-//	err error: The error encountered during task execution.
-//	attempt int: The current retry attempt number.
-//	task *configuration.Task: The task being attempted.
-//	workerIndex int: The index of the worker processing the task.
-//	maxRetries int: The maximum number of retry attempts allowed.
-//	retryDelay time.Duration: The duration to wait before making the next retry attempt.
+//	err error: The error to mark as non-retriable.
 //
 // Returns:
 //
-//	bool: A boolean indicating whether the task should be retried or not.
-func handleGenericError(ctx context.Context, err error, attempt int, task *configuration.Task, workerIndex int, maxRetries int, retryDelay time.Duration) bool {
-	// Pass Context to logRetryAttempt
-	logRetryAttempt(task.Name, attempt, maxRetries, err, navigator.Logger.Info)
-
-	// Wait for the next attempt, respecting the context cancellation.
-	if !waitForNextAttempt(ctx, retryDelay) {
-		return false // Context was cancelled during wait, do not continue.
+//	error: The wrapped error, or nil if err is nil.
+func markNonRetriable(err error) error {
+	if err == nil {
+		return nil
 	}
+	return &nonRetriableError{err: err}
+}
 
-	return true
+// isNonRetriable reports whether an error, or any error it wraps, was marked as non-retriable.
+//
+// Parameters:
+//
+//	err error: The error to inspect.
+//
+// Returns:
+//
+//	bool: True if the error should not be retried.
+func isNonRetriable(err error) bool {
+	var target *nonRetriableError
+	return errors.As(err, &target)
+}
+
+// recoveredPanicError logs a recovered panic together with its stack trace and converts it into a
+// non-retriable error, since repeating a task that panicked is unlikely to help.
+//
+// Parameters:
+//
+//	taskName string: The name of the task that panicked, or empty if the panic happened outside a task.
+//	workerIndex int: The index of the worker that recovered the panic.
+//	recovered interface{}: The value returned by recover.
+//
+// Returns:
+//
+//	error: A non-retriable error describing the panic.
+func recoveredPanicError(taskName string, workerIndex int, recovered interface{}) error {
+	err := markNonRetriable(fmt.Errorf(language.ErrorTaskPanicked, taskName, recovered))
+	navigator.LogErrorWithEmoji(constant.ErrorEmoji, err.Error(),
+		zap.String(language.Task_Name, taskName),
+		zap.Int(language.Worker_Name, workerIndex),
+		zap.ByteString(language.StackTrace, debug.Stack()),
+	)
+	return err
 }
@@ -0,0 +1,124 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/H0llyW00dzZ/K8sBlackPearl/worker/configuration"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestPerformTaskWithRetriesSharesOneBudgetAcrossConflicts(t *testing.T) {
+	executions := 0
+	registerTestRunner(t, "TestMixedFailures", func(_ context.Context, _ kubernetes.Interface, _ string, _ configuration.Task, _ map[string]interface{}, _ int) error {
+		executions++
+		if executions%2 == 1 {
+			return apierrors.NewConflict(schema.GroupResource{Resource: "pods"}, "web", errors.New("modified"))
+		}
+		return errors.New("transient failure")
+	})
+	task := configuration.Task{
+		Name:           "mixed",
+		Type:           "TestMixedFailures",
+		ShipsNamespace: "default",
+		MaxRetries:     5,
+		RetryDelay:     "1ms",
+		Parameters:     map[string]interface{}{},
+	}
+
+	attempts, _, err := performTaskWithRetries(context.Background(), fake.NewSimpleClientset(), "default", task, 0, nil)
+	if err == nil {
+		t.Fatal("the task succeeded although every attempt failed")
+	}
+	if attempts != task.MaxRetries || executions != task.MaxRetries {
+		t.Fatalf("got %d attempts and %d executions, want %d of each", attempts, executions, task.MaxRetries)
+	}
+}
+
+func TestDeploymentRunnersDoNotNestRetries(t *testing.T) {
+	tasks := []configuration.Task{
+		{Name: "scale-api", Type: "CrewScaleDeployments",
+			Parameters: map[string]interface{}{"deploymentName": "api", "replicas": 3}},
+		{Name: "update-api", Type: "CrewUpdateImageDeployments",
+			Parameters: map[string]interface{}{"deploymentName": "api", "containerName": "app", "newImage": "pearl:2"}},
+	}
+	for _, task := range tasks {
+		clientset, updates := newConflictingDeploymentClientset()
+		task.ShipsNamespace = "default"
+		task.MaxRetries = 3
+		task.RetryDelayDuration = time.Millisecond
+
+		attempts, _, err := performTaskWithRetries(context.Background(), clientset, "default", task, 0, nil)
+		if !apierrors.IsConflict(err) {
+			t.Fatalf("%s: got error %v, want the conflict of the final attempt", task.Type, err)
+		}
+		if attempts != task.MaxRetries || len(updates) != task.MaxRetries {
+			t.Fatalf("%s: got %d attempts and %d updates, want %d of each", task.Type, attempts, len(updates), task.MaxRetries)
+		}
+	}
+}
+
+func TestPerformTaskWithRetriesBehavior(t *testing.T) {
+	var executions int
+	var failures []error
+	registerTestRunner(t, "TestScripted", func(_ context.Context, _ kubernetes.Interface, _ string, _ configuration.Task, _ map[string]interface{}, _ int) error {
+		executions++
+		if executions <= len(failures) {
+			return failures[executions-1]
+		}
+		return nil
+	})
+	task := configuration.Task{
+		Name:           "scripted",
+		Type:           "TestScripted",
+		ShipsNamespace: "default",
+		MaxRetries:     5,
+		RetryDelay:     "1ms",
+		Parameters:     map[string]interface{}{},
+	}
+
+	for name, tc := range map[string]struct {
+		failures     []error
+		wantAttempts int
+		wantHistory  int
+		wantErr      bool
+	}{
+		"succeeds first time":          {nil, 1, 0, false},
+		"recovers from failures":       {[]error{errors.New("reset"), errors.New("reset")}, 3, 2, false},
+		"stops on non-retriable error": {[]error{markNonRetriable(errors.New("bad input"))}, 1, 1, true},
+		"gives up after MaxRetries":    {[]error{errors.New("1"), errors.New("2"), errors.New("3"), errors.New("4"), errors.New("5")}, 5, 5, true},
+	} {
+		executions, failures = 0, tc.failures
+		attempts, history, err := performTaskWithRetries(context.Background(), fake.NewSimpleClientset(), "default", task, 0, nil)
+		if attempts != tc.wantAttempts || executions != tc.wantAttempts || len(history) != tc.wantHistory || (err != nil) != tc.wantErr {
+			t.Fatalf("%s: got %d attempts, %d executions, %d failed attempts, and error %v; want %d, %d, %d, and an error: %v",
+				name, attempts, executions, len(history), err, tc.wantAttempts, tc.wantAttempts, tc.wantHistory, tc.wantErr)
+		}
+	}
+}
+
+func TestPerformTaskWithRetriesStopsWaitingWhenCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	registerTestRunner(t, "TestFailThenCancel", func(_ context.Context, _ kubernetes.Interface, _ string, _ configuration.Task, _ map[string]interface{}, _ int) error {
+		cancel()
+		return errors.New("transient failure")
+	})
+	task := configuration.Task{
+		Name:           "cancelled",
+		Type:           "TestFailThenCancel",
+		ShipsNamespace: "default",
+		MaxRetries:     3,
+		Parameters:     map[string]interface{}{},
+	}
+
+	attempts, _, err := performTaskWithRetries(ctx, fake.NewSimpleClientset(), "default", task, 0, nil)
+	if err == nil || attempts != 1 {
+		t.Fatalf("got %d attempts and error %v, want the cancellation to end the retries after 1 attempt", attempts, err)
+	}
+}
@@ -0,0 +1,88 @@
+package worker
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/H0llyW00dzZ/K8sBlackPearl/language"
+)
+
+// ErrInvalidParameter is matched by every ParameterError, so callers can use
+// errors.Is(err, ErrInvalidParameter) without caring which parameter was wrong.
+var ErrInvalidParameter = errors.New(language.ErrorInvalidParameterSentinel)
+
+// ParameterError reports that a task parameter is missing or has an unexpected type or value.
+// Its message is rendered from the language constants, so it reads exactly like the formatted
+// errors returned before typed errors were introduced.
+//
+// Fields:
+//
+//	Key string: The parameter key that failed validation.
+//	Reason string: The human-readable description of the failure.
+//	Err error: The underlying cause, if any.
+type ParameterError struct {
+	Key    string
+	Reason string
+	Err    error
+}
+
+// Error returns the human-readable description of the parameter failure.
+func (e *ParameterError) Error() string {
+	return e.Reason
+}
+
+// Unwrap returns the underlying cause, allowing errors.Is and errors.As to inspect it.
+func (e *ParameterError) Unwrap() error {
+	return e.Err
+}
+
+// Is reports whether target is ErrInvalidParameter.
+func (e *ParameterError) Is(target error) bool {
+	return target == ErrInvalidParameter
+}
+
+// newParameterError builds a ParameterError for the given key, rendering its message from
+// one of the language constants.
+//
+// Parameters:
+//
+//	key string: The parameter key that failed validation.
+//	cause error: The underlying cause, or nil.
+//	format string: The language constant used to render the message.
+//	args ...interface{}: The arguments for the format string.
+//
+// Returns:
+//
+//	*ParameterError: The typed parameter error.
+func newParameterError(key string, cause error, format string, args ...interface{}) *ParameterError {
+	return &ParameterError{
+		Key:    key,
+		Reason: fmt.Sprintf(format, args...),
+		Err:    cause,
+	}
+}
+
+// TaskExecutionError reports that a task could not be completed after all of its attempts.
+// It wraps the error returned by the last attempt, so callers can check, for example,
+// whether the task failed because of a ParameterError or a Kubernetes API error.
+//
+// Fields:
+//
+//	TaskName string: The name of the task that failed.
+//	Attempts int: The number of attempts made.
+//	Cause error: The error returned by the last attempt.
+type TaskExecutionError struct {
+	TaskName string
+	Attempts int
+	Cause    error
+}
+
+// Error returns the same message as language.ErrorFailedToCompleteTask.
+func (e *TaskExecutionError) Error() string {
+	return fmt.Sprintf(language.ErrorFailedToCompleteTask, e.TaskName, e.Attempts)
+}
+
+// Unwrap returns the error of the last attempt.
+func (e *TaskExecutionError) Unwrap() error {
+	return e.Cause
+}
@@ -0,0 +1,55 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/H0llyW00dzZ/K8sBlackPearl/worker/configuration"
+	"go.uber.org/zap"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestParameterErrorUnwrapping(t *testing.T) {
+	_, err := getParamAsInt(map[string]interface{}{"replicas": "three"}, "replicas")
+
+	var paramErr *ParameterError
+	if !errors.As(err, &paramErr) || paramErr.Key != "replicas" {
+		t.Fatalf("got %v, want a ParameterError for key replicas", err)
+	}
+	if !errors.Is(err, ErrInvalidParameter) {
+		t.Fatal("a ParameterError does not match ErrInvalidParameter")
+	}
+	if err.Error() != "parameter 'replicas' must be an integer" {
+		t.Fatalf("got message %q, want the language constant unchanged", err.Error())
+	}
+
+	cause := errors.New("not a number")
+	if wrapped := newParameterError("replicas", cause, "bad %s", "replicas"); !errors.Is(wrapped, cause) {
+		t.Fatal("a ParameterError does not unwrap to its cause")
+	}
+}
+
+func TestTaskExecutionErrorWrapsTheLastAttempt(t *testing.T) {
+	task := configuration.Task{
+		Name:       "scale-api",
+		Type:       "CrewScaleDeployments",
+		MaxRetries: 1,
+		RetryDelay: "1ms",
+		Parameters: map[string]interface{}{"deploymentName": "api", "replicas": "three"},
+	}
+
+	_, _, err := performTaskWithRetries(context.Background(), fake.NewSimpleClientset(), "default", task, 0, zap.NewNop())
+
+	var execErr *TaskExecutionError
+	if !errors.As(err, &execErr) || execErr.TaskName != "scale-api" || execErr.Attempts != 1 {
+		t.Fatalf("got %v, want a TaskExecutionError for scale-api after 1 attempt", err)
+	}
+	if err.Error() != "Failed to complete task scale-api after 1 attempts" {
+		t.Fatalf("got message %q, want the language constant unchanged", err.Error())
+	}
+	var paramErr *ParameterError
+	if !errors.As(err, &paramErr) || paramErr.Key != "replicas" || !errors.Is(err, ErrInvalidParameter) {
+		t.Fatalf("the TaskExecutionError does not unwrap to the ParameterError of its last attempt: %v", execErr.Cause)
+	}
+}
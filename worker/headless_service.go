@@ -0,0 +1,177 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/H0llyW00dzZ/K8sBlackPearl/language"
+	"github.com/H0llyW00dzZ/K8sBlackPearl/navigator"
+	"github.com/H0llyW00dzZ/go-urlshortner/logmonitor/constant"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/client-go/kubernetes"
+)
+
+// CreateHeadlessService creates a headless Service, one whose spec.clusterIP is "None", so that DNS
+// resolves its name to the addresses of the selected pods, as StatefulSets require for stable network
+// identities. The cluster IP is forced to "None" whatever the given Service sets.
+//
+// Parameters:
+//
+//	ctx context.Context: Context for cancellation and timeout.
+//	clientset kubernetes.Interface: A Kubernetes clientset for interacting with the Kubernetes API.
+//	namespace string: The namespace in which to create the Service.
+//	service *corev1.Service: The Service to create.
+//	results chan<- string: A channel to send operation results for logging.
+//	logger *zap.Logger: A logger for structured logging.
+//
+// Returns an error if the Service already exists or cannot be created.
+func CreateHeadlessService(ctx context.Context, clientset kubernetes.Interface, namespace string, service *corev1.Service, results chan<- string, logger *zap.Logger) error {
+	name := service.Name
+	service.Spec.ClusterIP = corev1.ClusterIPNone
+	applyDefaultObjectMeta(service)
+	created, err := clientset.CoreV1().Services(namespace).Create(ctx, service, v1.CreateOptions{})
+	if apierrors.IsAlreadyExists(err) {
+		return reportHeadlessServiceFailure(results, name, markNonRetriable(fmt.Errorf(language.ErrorServiceAlreadyExists, name)))
+	}
+	if err != nil {
+		return reportHeadlessServiceFailure(results, name, fmt.Errorf(language.ErrorCreatingService, err))
+	}
+
+	publishCreatedObject(ctx, created)
+	successMsg := fmt.Sprintf(language.HeadlessServiceCreated, name, namespace, len(service.Spec.Ports))
+	results <- successMsg
+	navigator.LogInfoWithEmoji(constant.SuccessEmoji, successMsg)
+	return nil
+}
+
+// reportHeadlessServiceFailure sends an error message to the results channel, logs the failure,
+// and returns the error so callers can propagate it directly.
+//
+// This unexported function is used internally by CreateHeadlessService to report failures.
+func reportHeadlessServiceFailure(results chan<- string, name string, err error) error {
+	errorMessage := fmt.Sprintf(language.ErrorFailedToCreateService, name, err)
+	results <- errorMessage
+	navigator.LogErrorWithEmojiRateLimited(constant.ErrorEmoji, errorMessage, zap.Error(err))
+	return err
+}
+
+// extractHeadlessServiceParameters extracts and validates the 'serviceName', 'selector', 'ports', and
+// optional 'publishNotReadyAddresses' parameters. The selector must not be empty, since a headless
+// Service without one publishes no pod addresses. Each port entry requires a 'port' between 1 and
+// 65535 and may set a 'targetPort' (a number or a named container port) and a 'protocol' of 'TCP',
+// 'UDP', or 'SCTP'. A 'name' is required on every entry when several ports are given.
+//
+// This function is used by task runners that create headless Services.
+func extractHeadlessServiceParameters(parameters map[string]interface{}) (*corev1.Service, error) {
+	serviceName, err := getParamAsString(parameters, serviceNamE)
+	if err != nil || serviceName == "" {
+		return nil, newParameterError(serviceNamE, err, language.ErrorParameterMissing, serviceNamE)
+	}
+
+	selector, err := getParamAsStringMap(parameters, selectoR)
+	if err != nil {
+		return nil, err
+	}
+	if len(selector) == 0 {
+		return nil, newParameterError(selectoR, nil, language.ErrorParameterMissing, selectoR)
+	}
+
+	rawPorts, err := getParamAsSlice(parameters, porTs)
+	if err != nil {
+		return nil, err
+	}
+	if len(rawPorts) == 0 {
+		return nil, newParameterError(porTs, nil, language.ErrorParameterMissing, porTs)
+	}
+	ports := make([]corev1.ServicePort, 0, len(rawPorts))
+	for i, rawPort := range rawPorts {
+		port, err := parseServicePort(rawPort, len(rawPorts) > 1)
+		if err != nil {
+			return nil, newParameterError(porTs, err, language.ErrorParameterServicePort, i, err)
+		}
+		ports = append(ports, port)
+	}
+
+	publishNotReady, err := getOptionalParamAsBool(parameters, publishNotReadyAddresseS, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return &corev1.Service{
+		ObjectMeta: v1.ObjectMeta{Name: serviceName},
+		Spec: corev1.ServiceSpec{
+			ClusterIP:                corev1.ClusterIPNone,
+			Selector:                 selector,
+			Ports:                    ports,
+			PublishNotReadyAddresses: publishNotReady,
+		},
+	}, nil
+}
+
+// parseServicePort converts a single decoded 'ports' entry into a Service port. The name is
+// required when the Service exposes several ports.
+//
+// This unexported function is used internally by extractHeadlessServiceParameters.
+func parseServicePort(rawPort interface{}, nameRequired bool) (corev1.ServicePort, error) {
+	entry, ok := toStringInterfaceMap(rawPort)
+	if !ok {
+		return corev1.ServicePort{}, newParameterError(porTs, nil, language.ErrorParameterInvalid, porTs)
+	}
+
+	port, err := getParamAsInt(entry, porT)
+	if err != nil {
+		return corev1.ServicePort{}, err
+	}
+	if port < 1 || port > 65535 {
+		return corev1.ServicePort{}, fmt.Errorf(language.ErrorInvalidPort, port)
+	}
+
+	name, err := getOptionalParamAsString(entry, portNamE, "")
+	if err != nil {
+		return corev1.ServicePort{}, err
+	}
+	if name == "" && nameRequired {
+		return corev1.ServicePort{}, fmt.Errorf(language.ErrorServicePortNameRequired, port)
+	}
+	if name != "" {
+		if problems := validation.IsDNS1123Label(name); len(problems) > 0 {
+			return corev1.ServicePort{}, fmt.Errorf(language.ErrorInvalidPortName, name, problems[0])
+		}
+	}
+
+	protocol, err := getOptionalParamAsString(entry, protocoL, string(corev1.ProtocolTCP))
+	if err != nil {
+		return corev1.ServicePort{}, err
+	}
+	switch corev1.Protocol(protocol) {
+	case corev1.ProtocolTCP, corev1.ProtocolUDP, corev1.ProtocolSCTP:
+	default:
+		return corev1.ServicePort{}, fmt.Errorf(language.ErrorInvalidProtocol, protocol)
+	}
+
+	targetPort := intstr.FromInt32(int32(port))
+	switch value := entry[targetPorT].(type) {
+	case nil:
+	case string:
+		if problems := validation.IsValidPortName(value); len(problems) > 0 {
+			return corev1.ServicePort{}, fmt.Errorf(language.ErrorInvalidTargetPort, value, problems[0])
+		}
+		targetPort = intstr.FromString(value)
+	default:
+		number, err := getParamAsInt(entry, targetPorT)
+		if err != nil {
+			return corev1.ServicePort{}, err
+		}
+		if number < 1 || number > 65535 {
+			return corev1.ServicePort{}, fmt.Errorf(language.ErrorInvalidPort, number)
+		}
+		targetPort = intstr.FromInt32(int32(number))
+	}
+
+	return corev1.ServicePort{Name: name, Port: int32(port), TargetPort: targetPort, Protocol: corev1.Protocol(protocol)}, nil
+}
@@ -0,0 +1,70 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/H0llyW00dzZ/K8sBlackPearl/worker/configuration"
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestCrewCreateHeadlessServiceCreatesAHeadlessService(t *testing.T) {
+	clientset := fake.NewSimpleClientset()
+	parameters := map[string]interface{}{
+		"serviceName": "crew",
+		"selector":    map[string]interface{}{"app": "crew"},
+		"ports": []interface{}{
+			map[string]interface{}{"name": "peer", "port": 2380},
+			map[string]interface{}{"name": "client", "port": 2379, "targetPort": "client"},
+		},
+		"publishNotReadyAddresses": true,
+	}
+	task := configuration.Task{Name: "discovery", Type: "CrewCreateHeadlessService", Parameters: parameters}
+
+	if err := (&CrewCreateHeadlessService{}).Run(context.Background(), clientset, "default", task, parameters, 0); err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+
+	service, err := clientset.CoreV1().Services("default").Get(context.Background(), "crew", v1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if service.Spec.ClusterIP != corev1.ClusterIPNone {
+		t.Fatalf("got clusterIP %q, want a headless service", service.Spec.ClusterIP)
+	}
+	if !service.Spec.PublishNotReadyAddresses || service.Spec.Selector["app"] != "crew" || len(service.Spec.Ports) != 2 {
+		t.Fatalf("got spec %+v, want the selector, both ports, and not-ready addresses published", service.Spec)
+	}
+	if peer := service.Spec.Ports[0]; peer.TargetPort != intstr.FromInt32(2380) || peer.Protocol != corev1.ProtocolTCP {
+		t.Fatalf("got port %+v, want the target port and protocol defaulted", peer)
+	}
+	if client := service.Spec.Ports[1]; client.TargetPort != intstr.FromString("client") {
+		t.Fatalf("got port %+v, want the named target port", client)
+	}
+}
+
+func TestCrewCreateHeadlessServiceRejectsInvalidParameters(t *testing.T) {
+	port := map[string]interface{}{"port": 2380}
+	for name, parameters := range map[string]map[string]interface{}{
+		"missing selector":     {"serviceName": "crew", "ports": []interface{}{port}},
+		"empty selector":       {"serviceName": "crew", "selector": map[string]interface{}{}, "ports": []interface{}{port}},
+		"missing ports":        {"serviceName": "crew", "selector": map[string]interface{}{"app": "crew"}},
+		"port out of range":    {"serviceName": "crew", "selector": map[string]interface{}{"app": "crew"}, "ports": []interface{}{map[string]interface{}{"port": 70000}}},
+		"unnamed port of many": {"serviceName": "crew", "selector": map[string]interface{}{"app": "crew"}, "ports": []interface{}{port, map[string]interface{}{"name": "client", "port": 2379}}},
+	} {
+		clientset := fake.NewSimpleClientset()
+		task := configuration.Task{Name: "discovery", Type: "CrewCreateHeadlessService", Parameters: parameters}
+
+		err := (&CrewCreateHeadlessService{}).Run(context.Background(), clientset, "default", task, parameters, 0)
+		if !errors.Is(err, ErrInvalidParameter) {
+			t.Fatalf("%s: got error %v, want an invalid parameter error", name, err)
+		}
+		if actions := clientset.Actions(); len(actions) != 0 {
+			t.Fatalf("%s: got %d API calls, want the task rejected before any", name, len(actions))
+		}
+	}
+}
@@ -3,6 +3,7 @@ package worker
 import (
 	"context"
 	"fmt"
+	"sort"
 	"time"
 
 	"github.com/H0llyW00dzZ/K8sBlackPearl/language"
@@ -10,6 +11,7 @@ import (
 	"github.com/H0llyW00dzZ/K8sBlackPearl/worker/configuration"
 	"github.com/H0llyW00dzZ/go-urlshortner/logmonitor/constant"
 	"go.uber.org/zap"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 )
 
 // RetryPolicy encapsulates the configuration for how an operation should be retried
@@ -20,9 +22,65 @@ import (
 //
 //	MaxRetries int: The maximum number of retry attempts to make before giving up.
 //	RetryDelay time.Duration: The duration to wait between successive retry attempts.
+//	Logger *zap.Logger: An optional logger for the failed attempts, such as a per-worker logger.
 type RetryPolicy struct {
-	MaxRetries int           // The maximum number of times to retry the operation.
-	RetryDelay time.Duration // The delay between consecutive retry attempts.
+	MaxRetries int             // The maximum number of times to retry the operation.
+	RetryDelay time.Duration   // The delay between consecutive retry attempts.
+	Logger     *zap.Logger     // Logs failed attempts at Warn level and the final one at Error level when set.
+	attempts   int             // The number of attempts made by the last call to Execute.
+	history    []AttemptRecord // The failed attempts of the last call to Execute.
+}
+
+// AttemptRecord describes a single failed attempt of an operation run by RetryPolicy.Execute.
+//
+// Fields:
+//
+//	Attempt int: The 1-based number of the attempt.
+//	Err string: The error message returned by the attempt.
+//	Delay time.Duration: The time waited before the next attempt, or zero if there was none.
+type AttemptRecord struct {
+	Attempt int           `json:"attempt"`
+	Err     string        `json:"error"`
+	Delay   time.Duration `json:"delay"`
+}
+
+// Attempts returns the number of times the operation was executed during the most recent
+// call to Execute. It lets callers report the real number of tries rather than MaxRetries.
+func (r *RetryPolicy) Attempts() int {
+	return r.attempts
+}
+
+// History returns the failed attempts of the most recent call to Execute, oldest first.
+// At most maxAttemptHistory records are kept; older ones are dropped.
+func (r *RetryPolicy) History() []AttemptRecord {
+	return r.history
+}
+
+// retryLogFunc returns the function used to log a failed attempt: the Warn or, for the final attempt,
+// the Error method of Logger when it is set, and logFunc otherwise.
+//
+// This unexported method is used internally by Execute.
+func (r *RetryPolicy) retryLogFunc(final bool, logFunc func(string, ...zap.Field)) func(string, ...zap.Field) {
+	switch {
+	case r.Logger == nil:
+		return logFunc
+	case final:
+		return r.Logger.Error
+	default:
+		return r.Logger.Warn
+	}
+}
+
+// appendAttemptHistory appends records to history, dropping the oldest records so that
+// no more than maxAttemptHistory remain.
+//
+// This unexported function is used internally by RetryPolicy.Execute and performTaskWithRetries.
+func appendAttemptHistory(history []AttemptRecord, records ...AttemptRecord) []AttemptRecord {
+	history = append(history, records...)
+	if excess := len(history) - maxAttemptHistory; excess > 0 {
+		history = append([]AttemptRecord(nil), history[excess:]...)
+	}
+	return history
 }
 
 // Execute runs the given operation according to the retry policy defined by the RetryPolicy struct.
@@ -34,33 +92,50 @@ type RetryPolicy struct {
 // which usually represents a task name or identifier, and an error indicating the success or failure
 // of the operation. If the operation is successful (no error returned), Execute will return nil.
 // If the operation fails after the maximum number of retries, the last error is returned.
+// When the API server suggests a delay, for example through the Retry-After header of a
+// 429 TooManyRequests response, that delay is used instead of RetryDelay for the next attempt.
 //
 // The logFunc parameter is a function that adheres to the signature of the zap logging library's
 // logging methods (e.g., Info, Error) and is used to log retry attempts with structured logging fields.
+// When Logger is set, it is used instead, so the severity follows the attempt: Warn while the
+// operation will be retried and Error for the final attempt.
 //
 //	ctx context.Context: The context that controls the cancellation of the operation and retries.
 //	operation func() (string, error): The operation to be executed, which returns a result string and error.
 //	logFunc func(string, ...zap.Field): The logging function to log retry attempts.
 //
 // Returns an error if the operation does not succeed within the maximum number of retries or if
-// the context is cancelled, otherwise returns nil.
+// the context is cancelled, otherwise returns nil. Every failed attempt is recorded, with its error and
+// the delay that followed it, and can be read back through History.
 func (r *RetryPolicy) Execute(ctx context.Context, operation func() (string, error), logFunc func(string, ...zap.Field)) error {
 	var lastErr error
+	r.attempts = 0
+	r.history = nil
 	for attempt := 0; attempt < r.MaxRetries; attempt++ {
 		taskName, err := operation()
+		r.attempts++
 		if err == nil {
 			return nil // The operation was successful, return nil error.
 		}
 		lastErr = err
-		// Pass Context to logRetryAttempt.
-		logRetryAttempt(taskName, attempt, r.MaxRetries, err, logFunc)
-		if attempt < r.MaxRetries-1 {
-			if !waitForNextAttempt(ctx, r.RetryDelay) {
-				return ctx.Err() // Context was cancelled, return the context error.
-			}
+
+		// Retrying cannot help a non-retriable error, so it stops right away,
+		// unless the predicate set with SetRetryPredicate deems it retriable.
+		retrying := (!isNonRetriable(err) || retriableByPredicate(err)) && attempt < r.MaxRetries-1
+		logRetryAttempt(taskName, attempt, r.MaxRetries, !retrying, err, r.retryLogFunc(!retrying, logFunc))
+		var delay time.Duration
+		if retrying {
+			delay = retryDelayFor(err, r.RetryDelay)
+		}
+		r.history = appendAttemptHistory(r.history, AttemptRecord{Attempt: r.attempts, Err: err.Error(), Delay: delay})
+		if !retrying {
+			break
+		}
+		if !waitForNextAttempt(ctx, delay) {
+			return contextError(ctx) // Context was cancelled, return the context error and its cause.
 		}
 	}
-	return fmt.Errorf(language.ErrorFailedToCompleteAfterAttempts, r.MaxRetries, lastErr)
+	return fmt.Errorf(language.ErrorFailedToCompleteAfterAttempts, r.attempts, lastErr)
 }
 
 // getParamAsString retrieves a string value from a map based on a key.
@@ -73,11 +148,26 @@ func (r *RetryPolicy) Execute(ctx context.Context, operation func() (string, err
 func getParamAsString(params map[string]interface{}, key string) (string, error) {
 	value, ok := params[key].(string)
 	if !ok {
-		return "", fmt.Errorf(language.ErrorParameterMustBeString, key)
+		return "", newParameterError(key, nil, language.ErrorParameterMustBeString, key)
 	}
 	return value, nil
 }
 
+// getOptionalParamAsString retrieves a string value from a map based on a key.
+// If the key is not present, the provided default value is returned instead.
+//
+//	params map[string]interface{}: a map of parameters where the key may be associated with a string value.
+//	key string: the key for which to retrieve the string value.
+//	defaultValue string: the value to return when the key is absent.
+//
+// Returns the string value and nil on success, or an empty string and an error if the value is not a string.
+func getOptionalParamAsString(params map[string]interface{}, key string, defaultValue string) (string, error) {
+	if _, exists := params[key]; !exists {
+		return defaultValue, nil
+	}
+	return getParamAsString(params, key)
+}
+
 // getParamAsInt64 retrieves an integer value from a map based on a key.
 // It handles both int and float64 data types due to the way JSON and YAML unmarshal numbers.
 // It returns an error if the key is not present or the value is not a number.
@@ -93,7 +183,7 @@ func getParamAsInt64(params map[string]interface{}, key string) (int64, error) {
 	if value, ok := params[key].(float64); ok {
 		return int64(value), nil
 	}
-	return 0, fmt.Errorf(language.ErrorParameterMustBeInteger, key)
+	return 0, newParameterError(key, nil, language.ErrorParameterMustBeInteger, key)
 }
 
 // getParamAsInt attempts to retrieve an integer value from a map of parameters.
@@ -128,7 +218,7 @@ func getParamAsInt64(params map[string]interface{}, key string) (int64, error) {
 func getParamAsInt(params map[string]interface{}, key string) (int, error) {
 	value, ok := params[key]
 	if !ok {
-		return 0, fmt.Errorf(language.ErrorParameterNotFound, key)
+		return 0, newParameterError(key, nil, language.ErrorParameterNotFound, key)
 	}
 	switch v := value.(type) {
 	case float64:
@@ -136,7 +226,7 @@ func getParamAsInt(params map[string]interface{}, key string) (int, error) {
 	case int:
 		return v, nil
 	default:
-		return 0, fmt.Errorf(language.ErrorParameterMustBeInteger, key)
+		return 0, newParameterError(key, nil, language.ErrorParameterMustBeInteger, key)
 	}
 }
 
@@ -153,18 +243,44 @@ func logTaskStart(message string, fields []zap.Field) {
 
 // createLogFieldsForRunnerTask generates a slice of zap.Field items for structured logging.
 // It is used to create log fields that describe a runner task, including the task type and namespace.
+// The task's Tags and any RequestMetadata carried by the context are appended to the fields.
 //
+//	ctx context.Context: The context of the task, possibly carrying RequestMetadata.
 //	task configuration.Task: The task for which to create log fields.
 //	shipsNamespace string: The namespace associated with the task.
 //	taskType string: The type of the task being logged.
 //
 // Returns a slice of zap.Field items that can be used for structured logging.
-func createLogFieldsForRunnerTask(task configuration.Task, shipsNamespace string, taskType string) []zap.Field {
-	return navigator.CreateLogFields(
+func createLogFieldsForRunnerTask(ctx context.Context, task configuration.Task, shipsNamespace string, taskType string) []zap.Field {
+	fields := navigator.CreateLogFields(
 		taskType,
 		shipsNamespace,
 		navigator.WithAnyZapField(zap.String(language.Task_Name, task.Name)),
 	)
+	fields = append(fields, taskTagFields(task)...)
+	return append(fields, requestMetadataFields(ctx)...)
+}
+
+// taskTagFields converts the task's Tags into zap fields. Each key is prefixed with 'tag.', so that
+// tags cannot overwrite the built-in fields, and the keys are sorted so the field order is stable.
+// It returns nil when the task has no tags.
+//
+// This unexported function is used internally by createLogFieldsForRunnerTask and withAPICallTask.
+func taskTagFields(task configuration.Task) []zap.Field {
+	if len(task.Tags) == 0 {
+		return nil
+	}
+
+	keys := make([]string, 0, len(task.Tags))
+	for key := range task.Tags {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	fields := make([]zap.Field, 0, len(keys))
+	for _, key := range keys {
+		fields = append(fields, zap.String(language.TaskTagPrefix+key, task.Tags[key]))
+	}
+	return fields
 }
 
 // logErrorWithFields logs an error message with additional fields for context.
@@ -179,12 +295,15 @@ func logErrorWithFields(err error, fields []zap.Field) {
 }
 
 // logResultsFromChannel logs messages received from a channel.
-// It continues to log until the channel is closed.
+// It continues to log until the channel is closed. Every message is also passed to the
+// result observer carried by the context, if any.
 //
+//	ctx context.Context: The context of the run, which may carry a result observer.
 //	results chan string: A channel from which to read result strings to log.
 //	fields []zap.Field: A slice of zap.Field items that provide additional context for each log entry.
-func logResultsFromChannel(results chan string, fields []zap.Field) {
+func logResultsFromChannel(ctx context.Context, results chan string, fields []zap.Field) {
 	for result := range results {
+		observeResult(ctx, result)
 		navigator.LogInfoWithEmoji(language.PirateEmoji, result, fields...)
 	}
 }
@@ -198,6 +317,11 @@ func logResultsFromChannel(results chan string, fields []zap.Field) {
 // Returns true if the function waited for the duration specified by retryDelay without the context being cancelled.
 // Returns false if the context is cancelled before the duration elapses.
 func waitForNextAttempt(ctx context.Context, retryDelay time.Duration) bool {
+	// A select picks at random among ready cases, so with a zero delay a cancelled context could
+	// otherwise still let another attempt start.
+	if ctx.Err() != nil {
+		return false
+	}
 	select {
 	case <-ctx.Done():
 		// The context was cancelled, so don't wait and return false to indicate that the operation should not continue.
@@ -207,3 +331,109 @@ func waitForNextAttempt(ctx context.Context, retryDelay time.Duration) bool {
 		return true
 	}
 }
+
+// getOptionalParamAsBool retrieves a boolean value from a map based on a key.
+// If the key is not present, the provided default value is returned instead.
+//
+//	params map[string]interface{}: a map of parameters where the key may be associated with a boolean value.
+//	key string: the key for which to retrieve the boolean value.
+//	defaultValue bool: the value to return when the key is absent.
+//
+// Returns the boolean value and nil on success, or false and an error if the value is not a boolean.
+func getOptionalParamAsBool(params map[string]interface{}, key string, defaultValue bool) (bool, error) {
+	value, exists := params[key]
+	if !exists {
+		return defaultValue, nil
+	}
+	boolValue, ok := value.(bool)
+	if !ok {
+		return false, newParameterError(key, nil, language.ErrorParameterMustBeBool, key)
+	}
+	return boolValue, nil
+}
+
+// toStringInterfaceMap normalizes a decoded map into a map[string]interface{}.
+// YAML decoding produces map[interface{}]interface{} for nested objects while JSON
+// decoding produces map[string]interface{}, so both forms are accepted here.
+//
+//	value interface{}: the decoded value expected to be a map.
+//
+// Returns the normalized map and true on success, or nil and false if the value is not a map.
+func toStringInterfaceMap(value interface{}) (map[string]interface{}, bool) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		return v, true
+	case map[interface{}]interface{}:
+		normalized := make(map[string]interface{}, len(v))
+		for key, val := range v {
+			keyStr, ok := key.(string)
+			if !ok {
+				return nil, false
+			}
+			normalized[keyStr] = val
+		}
+		return normalized, true
+	default:
+		return nil, false
+	}
+}
+
+// getParamAsStringMap retrieves a map of string values from a map based on a key.
+// It accepts both JSON and YAML decoded maps and requires every value to be a string.
+//
+//	params map[string]interface{}: a map of parameters where the key is expected to be associated with a map value.
+//	key string: the key for which to retrieve the map value.
+//
+// Returns the map and nil on success, or nil and an error on failure.
+func getParamAsStringMap(params map[string]interface{}, key string) (map[string]string, error) {
+	value, exists := params[key]
+	if !exists {
+		return nil, newParameterError(key, nil, language.ErrorParameterNotFound, key)
+	}
+	rawMap, ok := toStringInterfaceMap(value)
+	if !ok {
+		return nil, newParameterError(key, nil, language.ErrorParameterMustBeStringMap, key)
+	}
+	stringMap := make(map[string]string, len(rawMap))
+	for mapKey, mapValue := range rawMap {
+		strValue, ok := mapValue.(string)
+		if !ok {
+			return nil, newParameterError(key, nil, language.ErrorParameterMustBeStringMap, key)
+		}
+		stringMap[mapKey] = strValue
+	}
+	return stringMap, nil
+}
+
+// getParamAsSlice retrieves a list value from a map based on a key.
+//
+//	params map[string]interface{}: a map of parameters where the key is expected to be associated with a list value.
+//	key string: the key for which to retrieve the list value.
+//
+// Returns the list and nil on success, or nil and an error on failure.
+func getParamAsSlice(params map[string]interface{}, key string) ([]interface{}, error) {
+	value, exists := params[key]
+	if !exists {
+		return nil, newParameterError(key, nil, language.ErrorParameterNotFound, key)
+	}
+	list, ok := value.([]interface{})
+	if !ok {
+		return nil, newParameterError(key, nil, language.ErrorParameterMustBeList, key)
+	}
+	return list, nil
+}
+
+// retryDelayFor returns the delay to wait before retrying after err. If the API server suggested
+// a delay, such as the Retry-After header of a 429 TooManyRequests response, that delay is honored;
+// otherwise the configured delay is used, randomized by the jitter set with SetRetryJitter.
+//
+//	err error: The error returned by the failed attempt.
+//	configured time.Duration: The delay configured for the retry loop.
+//
+// Returns the delay to wait before the next attempt.
+func retryDelayFor(err error, configured time.Duration) time.Duration {
+	if seconds, ok := apierrors.SuggestsClientDelay(err); ok && seconds > 0 {
+		return time.Duration(seconds) * time.Second
+	}
+	return jitterRetryDelay(configured)
+}
@@ -0,0 +1,103 @@
+package worker
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/H0llyW00dzZ/K8sBlackPearl/navigator"
+	"github.com/H0llyW00dzZ/K8sBlackPearl/worker/configuration"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+	"k8s.io/client-go/kubernetes"
+)
+
+// runnerFunc adapts a function to the TaskRunner interface.
+type runnerFunc func(ctx context.Context, clientset kubernetes.Interface, shipsNamespace string, task configuration.Task, parameters map[string]interface{}, workerIndex int) error
+
+// Run calls the function.
+func (f runnerFunc) Run(ctx context.Context, clientset kubernetes.Interface, shipsNamespace string, task configuration.Task, parameters map[string]interface{}, workerIndex int) error {
+	return f(ctx, clientset, shipsNamespace, task, parameters, workerIndex)
+}
+
+// registerTestRunner registers the function as the runner of the task type for the duration of the test.
+func registerTestRunner(t *testing.T, taskType string, run runnerFunc) {
+	t.Helper()
+	RegisterTaskRunner(taskType, func() TaskRunner { return run })
+	t.Cleanup(func() {
+		delete(taskRunnerRegistry, taskType)
+		delete(taskRunnerSpecs, taskType)
+	})
+}
+
+// runCrewToCompletion runs the tasks with startCrew and returns every result once all workers are done.
+func runCrewToCompletion(ctx context.Context, clientset kubernetes.Interface, tasks []configuration.Task, workerCount int) []string {
+	results := make(chan string)
+	var collected []string
+	var mu sync.Mutex
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for result := range results {
+			mu.Lock()
+			collected = append(collected, result)
+			mu.Unlock()
+		}
+	}()
+	startCrew(ctx, clientset, tasks, workerCount, 0, results, newRunClaimStore()).Wait()
+	close(results)
+	<-done
+	return collected
+}
+
+// resultCollector gathers the results reported by runners through WithResultObserver.
+type resultCollector struct {
+	mu      sync.Mutex
+	results []string
+}
+
+// context returns a context that reports the results of runners to the collector.
+func (c *resultCollector) context(ctx context.Context) context.Context {
+	return WithResultObserver(ctx, func(result string) {
+		c.mu.Lock()
+		c.results = append(c.results, result)
+		c.mu.Unlock()
+	})
+}
+
+// all returns the collected results.
+func (c *resultCollector) all() []string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]string(nil), c.results...)
+}
+
+// inFlightTracker records how many tasks run at once and the highest count seen.
+type inFlightTracker struct {
+	current atomic.Int32
+	peak    atomic.Int32
+}
+
+// enter marks a task as running and returns the function that marks it as done.
+func (t *inFlightTracker) enter() func() {
+	n := t.current.Add(1)
+	for {
+		peak := t.peak.Load()
+		if n <= peak || t.peak.CompareAndSwap(peak, n) {
+			break
+		}
+	}
+	return func() { t.current.Add(-1) }
+}
+
+// observeLogs routes the navigator logger to an observer for the duration of the test and returns
+// the entries it records.
+func observeLogs(t *testing.T) *observer.ObservedLogs {
+	t.Helper()
+	core, logs := observer.New(zapcore.DebugLevel)
+	navigator.SetLogger(zap.New(core))
+	t.Cleanup(func() { navigator.SetLogger(zap.NewNop()) })
+	return logs
+}
@@ -0,0 +1,70 @@
+package worker
+
+import (
+	"context"
+	"testing"
+
+	"go.uber.org/zap"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+// countUpdates returns how many update calls the clientset has received.
+func countUpdates(clientset *fake.Clientset) int {
+	updates := 0
+	for _, action := range clientset.Actions() {
+		if action.GetVerb() == "update" {
+			updates++
+		}
+	}
+	return updates
+}
+
+// newDeploymentClientset returns a clientset holding the deployment "api" with three replicas and a
+// single container "app" running pearl:1.
+func newDeploymentClientset() *fake.Clientset {
+	replicas := int32(3)
+	return fake.NewSimpleClientset(&appsv1.Deployment{
+		ObjectMeta: v1.ObjectMeta{Name: "api", Namespace: "default"},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: &replicas,
+			Template: corev1.PodTemplateSpec{Spec: corev1.PodSpec{
+				Containers: []corev1.Container{{Name: "app", Image: "pearl:1"}},
+			}},
+		},
+	})
+}
+
+func TestScaleDeploymentSkipsSatisfiedReplicas(t *testing.T) {
+	for replicas, wantUpdates := range map[int]int{3: 0, 5: 1} {
+		clientset := newDeploymentClientset()
+		results := make(chan string, 1)
+		if err := ScaleDeployment(context.Background(), clientset, "default", "api", replicas, results, zap.NewNop()); err != nil {
+			t.Fatalf("scaling to %d: %v", replicas, err)
+		}
+		if updates := countUpdates(clientset); updates != wantUpdates {
+			t.Fatalf("scaling to %d issued %d updates, want %d", replicas, updates, wantUpdates)
+		}
+		if result := <-results; wantUpdates == 0 && result != "Deployment 'api' already has 3 replicas, nothing to do" {
+			t.Fatalf("got result %q, want a no-op report", result)
+		}
+	}
+}
+
+func TestUpdateDeploymentImageSkipsSatisfiedImage(t *testing.T) {
+	for image, wantUpdates := range map[string]int{"pearl:1": 0, "pearl:2": 1} {
+		clientset := newDeploymentClientset()
+		results := make(chan string, 1)
+		if err := UpdateDeploymentImage(context.Background(), clientset, "default", "api", "app", image, results, zap.NewNop()); err != nil {
+			t.Fatalf("updating to %s: %v", image, err)
+		}
+		if updates := countUpdates(clientset); updates != wantUpdates {
+			t.Fatalf("updating to %s issued %d updates, want %d", image, updates, wantUpdates)
+		}
+		if result := <-results; wantUpdates == 0 && result != "Container 'app' of deployment 'api' already uses image 'pearl:1', nothing to do" {
+			t.Fatalf("got result %q, want a no-op report", result)
+		}
+	}
+}
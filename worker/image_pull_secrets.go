@@ -0,0 +1,142 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/H0llyW00dzZ/K8sBlackPearl/language"
+	"github.com/H0llyW00dzZ/K8sBlackPearl/navigator"
+	"github.com/H0llyW00dzZ/go-urlshortner/logmonitor/constant"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/util/retry"
+)
+
+// SetImagePullSecrets merges image pull secrets into a ServiceAccount's imagePullSecrets or into a
+// deployment's spec.template.spec.imagePullSecrets. Existing entries are kept, duplicates are skipped,
+// and the update is retried on conflicts. When every secret is already present nothing is written.
+//
+// Parameters:
+//
+//	ctx context.Context: Context for cancellation and timeout.
+//	clientset kubernetes.Interface: A Kubernetes clientset for interacting with the Kubernetes API.
+//	namespace string: The namespace of the target.
+//	targetKind string: Either "serviceAccount" or "deployment".
+//	targetName string: The name of the ServiceAccount or deployment.
+//	secretNames []string: The names of the image pull secrets to add.
+//	results chan<- string: A channel to send operation results for logging.
+//	logger *zap.Logger: A logger for structured logging.
+//
+// Returns an error if the target cannot be read or updated.
+func SetImagePullSecrets(ctx context.Context, clientset kubernetes.Interface, namespace, targetKind, targetName string, secretNames []string, results chan<- string, logger *zap.Logger) error {
+	var added []string
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		switch targetKind {
+		case pullSecretTargetServiceAccount:
+			serviceAccounts := clientset.CoreV1().ServiceAccounts(namespace)
+			serviceAccount, err := serviceAccounts.Get(ctx, targetName, v1.GetOptions{})
+			if err != nil {
+				return err
+			}
+			serviceAccount.ImagePullSecrets, added = mergeImagePullSecrets(serviceAccount.ImagePullSecrets, secretNames)
+			if len(added) == 0 {
+				return nil
+			}
+			_, err = serviceAccounts.Update(ctx, serviceAccount, v1.UpdateOptions{})
+			return err
+		default:
+			deployments := clientset.AppsV1().Deployments(namespace)
+			deployment, err := deployments.Get(ctx, targetName, v1.GetOptions{})
+			if err != nil {
+				return err
+			}
+			podSpec := &deployment.Spec.Template.Spec
+			podSpec.ImagePullSecrets, added = mergeImagePullSecrets(podSpec.ImagePullSecrets, secretNames)
+			if len(added) == 0 {
+				return nil
+			}
+			_, err = deployments.Update(ctx, deployment, v1.UpdateOptions{})
+			return err
+		}
+	})
+	if err != nil {
+		errorMessage := fmt.Sprintf(language.ErrorFailedToSetPullSecrets, targetKind, targetName, err)
+		results <- errorMessage
+		navigator.LogErrorWithEmojiRateLimited(constant.ErrorEmoji, errorMessage, zap.Error(err))
+		return err
+	}
+
+	message := fmt.Sprintf(language.PullSecretsAdded, strings.Join(added, ", "), targetKind, targetName)
+	if len(added) == 0 {
+		message = fmt.Sprintf(language.PullSecretsAlreadyPresent, targetKind, targetName)
+	}
+	results <- message
+	navigator.LogInfoWithEmoji(constant.SuccessEmoji, message)
+	return nil
+}
+
+// mergeImagePullSecrets appends the secrets that are not referenced yet, keeping the existing order.
+// It returns the merged list and the names that were added.
+//
+// This unexported function is used internally by SetImagePullSecrets.
+func mergeImagePullSecrets(existing []corev1.LocalObjectReference, secretNames []string) ([]corev1.LocalObjectReference, []string) {
+	present := make(map[string]bool, len(existing))
+	for _, ref := range existing {
+		present[ref.Name] = true
+	}
+	var added []string
+	for _, name := range secretNames {
+		if present[name] {
+			continue
+		}
+		present[name] = true
+		existing = append(existing, corev1.LocalObjectReference{Name: name})
+		added = append(added, name)
+	}
+	return existing, added
+}
+
+// extractImagePullSecretsParameters extracts and validates the target and the 'secretNames' parameter.
+// Exactly one of 'serviceAccountName' and 'deploymentName' must be given.
+//
+// This function is used by task runners that set image pull secrets.
+func extractImagePullSecretsParameters(parameters map[string]interface{}) (targetKind, targetName string, secretNames []string, err error) {
+	serviceAccountName, err := getOptionalParamAsString(parameters, serviceAccountNamE, "")
+	if err != nil {
+		return "", "", nil, err
+	}
+	deploymentName, err := getOptionalParamAsString(parameters, deploYmentName, "")
+	if err != nil {
+		return "", "", nil, err
+	}
+	switch {
+	case serviceAccountName != "" && deploymentName != "":
+		return "", "", nil, newParameterError(serviceAccountNamE, nil, language.ErrorPullSecretTargetAmbiguous)
+	case serviceAccountName != "":
+		targetKind, targetName = pullSecretTargetServiceAccount, serviceAccountName
+	case deploymentName != "":
+		targetKind, targetName = pullSecretTargetDeployment, deploymentName
+	default:
+		return "", "", nil, newParameterError(serviceAccountNamE, nil, language.ErrorPullSecretTargetMissing)
+	}
+
+	rawNames, err := getParamAsSlice(parameters, secretNameS)
+	if err != nil {
+		return "", "", nil, err
+	}
+	for _, rawName := range rawNames {
+		name, ok := rawName.(string)
+		if !ok || name == "" {
+			return "", "", nil, newParameterError(secretNameS, nil, language.ErrorParameterMustBeList, secretNameS)
+		}
+		secretNames = append(secretNames, name)
+	}
+	if len(secretNames) == 0 {
+		return "", "", nil, newParameterError(secretNameS, nil, language.ErrorParameterMissing, secretNameS)
+	}
+
+	return targetKind, targetName, secretNames, nil
+}
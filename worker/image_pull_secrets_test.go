@@ -0,0 +1,65 @@
+package worker
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/H0llyW00dzZ/K8sBlackPearl/worker/configuration"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestCrewSetImagePullSecretsTargets(t *testing.T) {
+	existing := []corev1.LocalObjectReference{{Name: "old-registry"}}
+	want := []corev1.LocalObjectReference{{Name: "old-registry"}, {Name: "private-registry"}}
+	clientset := fake.NewSimpleClientset(
+		&corev1.ServiceAccount{
+			ObjectMeta:       v1.ObjectMeta{Name: "builder", Namespace: "default"},
+			ImagePullSecrets: existing,
+		},
+		&appsv1.Deployment{
+			ObjectMeta: v1.ObjectMeta{Name: "api", Namespace: "default"},
+			Spec: appsv1.DeploymentSpec{Template: corev1.PodTemplateSpec{Spec: corev1.PodSpec{
+				ImagePullSecrets: existing,
+			}}},
+		},
+	)
+	secretNames := []interface{}{"private-registry", "old-registry", "private-registry"}
+
+	for _, target := range []string{"serviceAccountName", "deploymentName"} {
+		parameters := map[string]interface{}{"secretNames": secretNames}
+		if target == "serviceAccountName" {
+			parameters[target] = "builder"
+		} else {
+			parameters[target] = "api"
+		}
+		task := configuration.Task{Name: "pull-secrets", Type: "CrewSetImagePullSecrets", Parameters: parameters}
+		// The second run finds every secret present and writes nothing.
+		for run := 0; run < 2; run++ {
+			if err := (&CrewSetImagePullSecrets{}).Run(context.Background(), clientset, "default", task, parameters, 0); err != nil {
+				t.Fatalf("%s: Run: %v", target, err)
+			}
+		}
+	}
+
+	serviceAccount, err := clientset.CoreV1().ServiceAccounts("default").Get(context.Background(), "builder", v1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(serviceAccount.ImagePullSecrets, want) {
+		t.Fatalf("got ServiceAccount pull secrets %v, want %v", serviceAccount.ImagePullSecrets, want)
+	}
+	deployment, err := clientset.AppsV1().Deployments("default").Get(context.Background(), "api", v1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := deployment.Spec.Template.Spec.ImagePullSecrets; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got deployment pull secrets %v, want %v", got, want)
+	}
+	if updates := countUpdates(clientset); updates != 2 {
+		t.Fatalf("got %d updates, want one per target", updates)
+	}
+}
@@ -0,0 +1,143 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/H0llyW00dzZ/K8sBlackPearl/language"
+	"github.com/H0llyW00dzZ/K8sBlackPearl/navigator"
+	"github.com/H0llyW00dzZ/go-urlshortner/logmonitor/constant"
+	"go.uber.org/zap"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// imageCheck compares the image of one deployment container with the expected image.
+type imageCheck struct {
+	deploymentName string
+	containerName  string
+	expected       string
+	actual         string
+}
+
+// checkImageTags compares the container images of the deployments matching a label selector with
+// the expected images, keyed by container name. Containers without an expected image are ignored.
+// Nothing is modified.
+//
+// Parameters:
+//
+//	ctx context.Context: Context for cancellation and timeout.
+//	clientset kubernetes.Interface: A Kubernetes clientset for interacting with the Kubernetes API.
+//	namespace string: The namespace of the deployments.
+//	labelSelector string: An optional label selector to filter deployments.
+//	expected map[string]string: The expected image of each container name.
+//
+// Returns:
+//
+//	[]imageCheck: One comparison per checked container, sorted by deployment and container name.
+//	error: An error if the deployments cannot be listed.
+func checkImageTags(ctx context.Context, clientset kubernetes.Interface, namespace, labelSelector string, expected map[string]string) ([]imageCheck, error) {
+	deployments, err := clientset.AppsV1().Deployments(namespace).List(ctx, v1.ListOptions{LabelSelector: labelSelector})
+	if err != nil {
+		return nil, fmt.Errorf(language.ErrorListingDeployments, err)
+	}
+
+	var checks []imageCheck
+	for _, deployment := range deployments.Items {
+		for _, container := range deployment.Spec.Template.Spec.Containers {
+			expectedImage, found := expected[container.Name]
+			if !found {
+				continue
+			}
+			checks = append(checks, imageCheck{
+				deploymentName: deployment.Name,
+				containerName:  container.Name,
+				expected:       expectedImage,
+				actual:         container.Image,
+			})
+		}
+	}
+
+	sort.Slice(checks, func(i, j int) bool {
+		if checks[i].deploymentName != checks[j].deploymentName {
+			return checks[i].deploymentName < checks[j].deploymentName
+		}
+		return checks[i].containerName < checks[j].containerName
+	})
+	return checks, nil
+}
+
+// reportImageChecks sends one line per checked container through the results channel and logs the
+// same information with structured fields. It stops early if the context is cancelled.
+//
+// Parameters:
+//
+//	ctx context.Context: Context for cancellation.
+//	baseFields []zap.Field: A slice of zap.Field structs providing contextual logging information.
+//	checks []imageCheck: The comparisons to report.
+//	failOnMismatch bool: Whether a mismatching image should be returned as an error.
+//	results chan<- string: A channel with room for one message per comparison.
+//
+// Returns an error if the context is cancelled, or if failOnMismatch is set and any container runs
+// an image other than the expected one.
+func reportImageChecks(ctx context.Context, baseFields []zap.Field, checks []imageCheck, failOnMismatch bool, results chan<- string) error {
+	var mismatched []string
+	for _, check := range checks {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		checkFields := append([]zap.Field(nil), baseFields...)
+		checkFields = append(checkFields,
+			zap.String(language.DeploymentName, check.deploymentName),
+			zap.String(language.ContainerName, check.containerName),
+			zap.String(language.ExpectedImage, check.expected),
+			zap.String(language.ActualImage, check.actual),
+		)
+
+		if check.actual != check.expected {
+			message := fmt.Sprintf(language.ImageMismatch, check.deploymentName, check.containerName, check.actual, check.expected)
+			results <- message
+			navigator.LogInfoWithEmoji(language.WarningEmoji, message, checkFields...)
+			mismatched = append(mismatched, check.deploymentName+"/"+check.containerName)
+			continue
+		}
+
+		message := fmt.Sprintf(language.ImageMatches, check.deploymentName, check.containerName, check.actual)
+		results <- message
+		navigator.LogInfoWithEmoji(constant.SuccessEmoji, message, checkFields...)
+	}
+
+	if failOnMismatch && len(mismatched) > 0 {
+		return markNonRetriable(fmt.Errorf(language.ErrorImageMismatches, strings.Join(mismatched, ", ")))
+	}
+	return nil
+}
+
+// extractImageCheckParameters extracts and validates the optional 'labelSelector', the 'expected'
+// map of container names to images, and the optional 'failOnMismatch' parameter.
+//
+// This function is used by task runners that check deployment images.
+func extractImageCheckParameters(parameters map[string]interface{}) (string, map[string]string, bool, error) {
+	selector, err := getOptionalParamAsString(parameters, labelSelector, "")
+	if err != nil {
+		return "", nil, false, err
+	}
+
+	expected, err := getParamAsStringMap(parameters, expecteD)
+	if err != nil {
+		return "", nil, false, err
+	}
+	if len(expected) == 0 {
+		return "", nil, false, newParameterError(expecteD, nil, language.ErrorParameterMissing, expecteD)
+	}
+
+	failOnMismatch, err := getOptionalParamAsBool(parameters, failOnMismatcH, false)
+	if err != nil {
+		return "", nil, false, err
+	}
+
+	return selector, expected, failOnMismatch, nil
+}
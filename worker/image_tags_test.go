@@ -0,0 +1,73 @@
+package worker
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/H0llyW00dzZ/K8sBlackPearl/navigator"
+	"github.com/H0llyW00dzZ/K8sBlackPearl/worker/configuration"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func newImageDeployment(name string, labels map[string]string, images map[string]string) *appsv1.Deployment {
+	deployment := &appsv1.Deployment{ObjectMeta: v1.ObjectMeta{Name: name, Namespace: "default", Labels: labels}}
+	for container, image := range images {
+		deployment.Spec.Template.Spec.Containers = append(deployment.Spec.Template.Spec.Containers, corev1.Container{Name: container, Image: image})
+	}
+	return deployment
+}
+
+func TestCrewCheckImageTags(t *testing.T) {
+	navigator.SetEmojiEnabled(false)
+	t.Cleanup(func() { navigator.SetEmojiEnabled(true) })
+	web := map[string]string{"app": "web"}
+	clientset := fake.NewSimpleClientset(
+		newImageDeployment("web-new", web, map[string]string{"app": "pearl:2", "sidecar": "proxy:1"}),
+		newImageDeployment("web-old", web, map[string]string{"app": "pearl:1"}),
+		newImageDeployment("db", map[string]string{"app": "db"}, map[string]string{"app": "postgres:16"}),
+	)
+
+	for _, failOnMismatch := range []bool{false, true} {
+		task := configuration.Task{
+			Name: "drift",
+			Type: "CrewCheckImageTags",
+			Parameters: map[string]interface{}{
+				"labelSelector":  "app=web",
+				"expected":       map[string]interface{}{"app": "pearl:2"},
+				"failOnMismatch": failOnMismatch,
+			},
+		}
+		logs := observeLogs(t)
+
+		err := (&CrewCheckImageTags{}).Run(context.Background(), clientset, "default", task, task.Parameters, 0)
+		if failOnMismatch != (err != nil) {
+			t.Fatalf("failOnMismatch %v: got error %v", failOnMismatch, err)
+		}
+		if err != nil && (!strings.Contains(err.Error(), "web-old/app") || strings.Contains(err.Error(), "web-new") || !isNonRetriable(err)) {
+			t.Fatalf("got error %v, want a non-retriable error naming only web-old/app", err)
+		}
+
+		var results []string
+		for _, entry := range logs.FilterMessageSnippet("Deployment 'web-").All() {
+			results = append(results, entry.Message)
+		}
+		want := []string{
+			"Deployment 'web-new' container 'app' runs the expected image 'pearl:2'",
+			"Deployment 'web-old' container 'app' runs image 'pearl:1' instead of 'pearl:2'",
+		}
+		if !reflect.DeepEqual(results, want) {
+			t.Fatalf("failOnMismatch %v: got results %q, want %q", failOnMismatch, results, want)
+		}
+	}
+
+	for _, action := range clientset.Actions() {
+		if action.GetVerb() != "list" {
+			t.Fatalf("got a %s action, want the check to only read", action.GetVerb())
+		}
+	}
+}
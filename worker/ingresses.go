@@ -0,0 +1,139 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/H0llyW00dzZ/K8sBlackPearl/language"
+	"github.com/H0llyW00dzZ/K8sBlackPearl/navigator"
+	"go.uber.org/zap"
+	networkingv1 "k8s.io/api/networking/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// ingressSummary is the routing information reported for a single Ingress.
+type ingressSummary struct {
+	name       string
+	hosts      []string
+	backends   []string
+	tlsSecrets []string
+	addresses  []string
+}
+
+// listIngresses lists the Ingresses in a namespace, optionally filtered by a label selector,
+// and summarizes their hosts, backend services, TLS secrets, and load balancer addresses.
+//
+// Parameters:
+//
+//	ctx context.Context: Context for cancellation and timeout.
+//	clientset kubernetes.Interface: A Kubernetes clientset for interacting with the Kubernetes API.
+//	namespace string: The namespace whose Ingresses should be listed.
+//	labelSelector string: An optional label selector to filter Ingresses.
+//
+// Returns:
+//
+//	[]ingressSummary: The routing summary of each Ingress.
+//	error: An error if the Ingresses cannot be listed.
+func listIngresses(ctx context.Context, clientset kubernetes.Interface, namespace, labelSelector string) ([]ingressSummary, error) {
+	ingressList, err := clientset.NetworkingV1().Ingresses(namespace).List(ctx, v1.ListOptions{LabelSelector: labelSelector})
+	if err != nil {
+		return nil, fmt.Errorf(language.ErrorListingIngresses, err)
+	}
+
+	summaries := make([]ingressSummary, 0, len(ingressList.Items))
+	for i := range ingressList.Items {
+		summaries = append(summaries, summarizeIngress(&ingressList.Items[i]))
+	}
+	return summaries, nil
+}
+
+// summarizeIngress extracts the routing information of an Ingress.
+//
+// This unexported function is used internally by listIngresses.
+func summarizeIngress(ingress *networkingv1.Ingress) ingressSummary {
+	summary := ingressSummary{name: ingress.Name}
+
+	if backend := ingress.Spec.DefaultBackend; backend != nil {
+		summary.backends = append(summary.backends, describeIngressBackend(backend))
+	}
+	for _, rule := range ingress.Spec.Rules {
+		host := rule.Host
+		if host == "" {
+			host = "*"
+		}
+		summary.hosts = append(summary.hosts, host)
+		if rule.HTTP == nil {
+			continue
+		}
+		for _, path := range rule.HTTP.Paths {
+			summary.backends = append(summary.backends, host+path.Path+" -> "+describeIngressBackend(&path.Backend))
+		}
+	}
+	for _, tls := range ingress.Spec.TLS {
+		if tls.SecretName != "" {
+			summary.tlsSecrets = append(summary.tlsSecrets, tls.SecretName)
+		}
+	}
+	for _, lb := range ingress.Status.LoadBalancer.Ingress {
+		if lb.IP != "" {
+			summary.addresses = append(summary.addresses, lb.IP)
+		} else if lb.Hostname != "" {
+			summary.addresses = append(summary.addresses, lb.Hostname)
+		}
+	}
+	return summary
+}
+
+// describeIngressBackend formats an Ingress backend as "service:port" or as the referenced resource.
+//
+// This unexported function is used internally by summarizeIngress.
+func describeIngressBackend(backend *networkingv1.IngressBackend) string {
+	if service := backend.Service; service != nil {
+		if service.Port.Name != "" {
+			return service.Name + ":" + service.Port.Name
+		}
+		return fmt.Sprintf("%s:%d", service.Name, service.Port.Number)
+	}
+	if resource := backend.Resource; resource != nil {
+		return resource.Kind + "/" + resource.Name
+	}
+	return ""
+}
+
+// reportIngresses sends one line per Ingress through the results channel and logs the same
+// information with structured fields. It stops early if the context is cancelled.
+//
+// Parameters:
+//
+//	ctx context.Context: Context for cancellation.
+//	baseFields []zap.Field: A slice of zap.Field structs providing contextual logging information.
+//	summaries []ingressSummary: The Ingresses to report.
+//	results chan<- string: A channel with room for one message per Ingress.
+//
+// Returns an error if the context is cancelled before all Ingresses are reported.
+func reportIngresses(ctx context.Context, baseFields []zap.Field, summaries []ingressSummary, results chan<- string) error {
+	for _, ingress := range summaries {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		address := strings.Join(ingress.addresses, ", ")
+		if address == "" {
+			address = language.NotAssigned
+		}
+		message := fmt.Sprintf(language.IngressSummary, ingress.name, strings.Join(ingress.hosts, ", "), strings.Join(ingress.backends, ", "), strings.Join(ingress.tlsSecrets, ", "), address)
+		results <- message
+
+		ingressFields := append([]zap.Field(nil), baseFields...)
+		ingressFields = append(ingressFields,
+			zap.String(language.IngressName, ingress.name),
+			zap.Strings(language.IngressHosts, ingress.hosts),
+			zap.Strings(language.IngressBackends, ingress.backends),
+			zap.Strings(language.IngressTLSSecrets, ingress.tlsSecrets),
+			zap.Strings(language.IngressAddresses, ingress.addresses),
+		)
+		navigator.LogInfoWithEmoji(language.PirateEmoji, message, ingressFields...)
+	}
+	return nil
+}
@@ -35,4 +35,163 @@ func init() {
 	// Register the new TaskRunner for update network policy
 	RegisterTaskRunner("CrewUpdateNetworkPolicy", func() TaskRunner { return &CrewUpdateNetworkPolicy{} })
 
+	// Register the new TaskRunner for create limit range
+	RegisterTaskRunner("CrewCreateLimitRange", func() TaskRunner { return &CrewCreateLimitRange{} })
+
+	// Register the new TaskRunner for apply manifest
+	RegisterTaskRunner("CrewApplyManifest", func() TaskRunner { return &CrewApplyManifest{} })
+
+	// Register the new TaskRunner for get pod metrics
+	RegisterTaskRunner("CrewGetPodMetrics", func() TaskRunner { return &CrewGetPodMetrics{} })
+
+	// Register the new TaskRunner for get node metrics
+	RegisterTaskRunner("CrewGetNodeMetrics", func() TaskRunner { return &CrewGetNodeMetrics{} })
+
+	// Register the new TaskRunner for delete node
+	RegisterTaskRunner("CrewDeleteNode", func() TaskRunner { return &CrewDeleteNode{} })
+
+	// Register the new TaskRunner for create storage class
+	RegisterTaskRunner("CrewCreateStorageClass", func() TaskRunner { return &CrewCreateStorageClass{} })
+
+	// Register the new TaskRunner for scale to zero
+	RegisterTaskRunner("CrewScaleToZero", func() TaskRunner { return &CrewScaleToZero{} })
+
+	// Register the new TaskRunner for restore replicas
+	RegisterTaskRunner("CrewRestoreReplicas", func() TaskRunner { return &CrewRestoreReplicas{} })
+
+	// Register the new TaskRunner for watch pods until condition
+	RegisterTaskRunner("CrewWatchPodsUntilCondition", func() TaskRunner { return &CrewWatchPodsUntilCondition{} })
+
+	// Register the new TaskRunner for create pod
+	RegisterTaskRunner("CrewCreatePod", func() TaskRunner { return &CrewCreatePod{} })
+
+	// Register the new TaskRunner for delete pod by name
+	RegisterTaskRunner("CrewDeletePodByName", func() TaskRunner { return &CrewDeletePodByName{} })
+
+	// Register the new TaskRunner for annotate deployment
+	RegisterTaskRunner("CrewAnnotateDeployment", func() TaskRunner { return &CrewAnnotateDeployment{} })
+
+	// Register the new TaskRunner for list secrets metadata
+	RegisterTaskRunner("CrewListSecretsMetadata", func() TaskRunner { return &CrewListSecretsMetadata{} })
+
+	// Register the new TaskRunner for update deployment strategy
+	RegisterTaskRunner("CrewUpdateDeploymentStrategy", func() TaskRunner { return &CrewUpdateDeploymentStrategy{} })
+
+	// Register the new TaskRunner for get ingresses
+	RegisterTaskRunner("CrewGetIngresses", func() TaskRunner { return &CrewGetIngresses{} })
+
+	// Register the new TaskRunner for pause deployment
+	RegisterTaskRunner("CrewPauseDeployment", func() TaskRunner { return &CrewPauseDeployment{} })
+
+	// Register the new TaskRunner for resume deployment
+	RegisterTaskRunner("CrewResumeDeployment", func() TaskRunner { return &CrewResumeDeployment{} })
+
+	// Register the new TaskRunner for grouping pods by node
+	RegisterTaskRunner("CrewGetPodsByNode", func() TaskRunner { return &CrewGetPodsByNode{} })
+
+	// Register the new TaskRunner for creating endpoints
+	RegisterTaskRunner("CrewCreateEndpoints", func() TaskRunner { return &CrewCreateEndpoints{} })
+
+	// Register the new TaskRunner for getting configmap keys
+	RegisterTaskRunner("CrewGetConfigMap", func() TaskRunner { return &CrewGetConfigMap{} })
+
+	// Register the new TaskRunner for getting secret keys
+	RegisterTaskRunner("CrewGetSecretKeys", func() TaskRunner { return &CrewGetSecretKeys{} })
+
+	// Register the new TaskRunner for updating ingress backends
+	RegisterTaskRunner("CrewUpdateIngressBackend", func() TaskRunner { return &CrewUpdateIngressBackend{} })
+
+	// Register the new TaskRunner for checking service endpoints
+	RegisterTaskRunner("CrewCheckServiceEndpoints", func() TaskRunner { return &CrewCheckServiceEndpoints{} })
+
+	// Register the new TaskRunner for probing pod health endpoints
+	RegisterTaskRunner("CrewRunHealthProbeAgainstPod", func() TaskRunner { return &CrewRunHealthProbeAgainstPod{} })
+
+	// Register the new TaskRunner for cloning deployments
+	RegisterTaskRunner("CrewCloneDeployment", func() TaskRunner { return &CrewCloneDeployment{} })
+
+	// Register the new TaskRunner for validating manifests
+	RegisterTaskRunner("CrewValidateManifest", func() TaskRunner { return &CrewValidateManifest{} })
+
+	// Register the new TaskRunner for setting image pull secrets
+	RegisterTaskRunner("CrewSetImagePullSecrets", func() TaskRunner { return &CrewSetImagePullSecrets{} })
+
+	// Register the new TaskRunner for creating VerticalPodAutoscalers
+	RegisterTaskRunner("CrewCreateVPA", func() TaskRunner { return &CrewCreateVPA{} })
+
+	// Register the new TaskRunner for cleaning up finished jobs
+	RegisterTaskRunner("CrewCleanupJobs", func() TaskRunner { return &CrewCleanupJobs{} })
+
+	// Register the new TaskRunner for waiting on job completion
+	RegisterTaskRunner("CrewWaitForJobCompletion", func() TaskRunner { return &CrewWaitForJobCompletion{} })
+
+	// Register the new TaskRunner for listing deployment revisions
+	RegisterTaskRunner("CrewGetDeploymentRevisions", func() TaskRunner { return &CrewGetDeploymentRevisions{} })
+
+	// Register the new TaskRunner for the namespace inventory
+	RegisterTaskRunner("CrewGetNamespaceInventory", func() TaskRunner { return &CrewGetNamespaceInventory{} })
+
+	// Register the new TaskRunner for scaling deployments by label selector
+	RegisterTaskRunner("CrewScaleMany", func() TaskRunner { return &CrewScaleMany{} })
+
+	// Register the new TaskRunner for checking pod disruption budgets
+	RegisterTaskRunner("CrewCheckPDBViolations", func() TaskRunner { return &CrewCheckPDBViolations{} })
+
+	// Register the new TaskRunner for get pod logs since
+	RegisterTaskRunner("CrewGetPodLogsSince", func() TaskRunner { return &CrewGetPodLogsSince{} })
+
+	// Register the new TaskRunner for update secret data
+	RegisterTaskRunner("CrewUpdateSecretData", func() TaskRunner { return &CrewUpdateSecretData{} })
+
+	// Register the new TaskRunner for set deployment node selector
+	RegisterTaskRunner("CrewSetDeploymentNodeSelector", func() TaskRunner { return &CrewSetDeploymentNodeSelector{} })
+
+	// Register the new TaskRunner for check image tags
+	RegisterTaskRunner("CrewCheckImageTags", func() TaskRunner { return &CrewCheckImageTags{} })
+
+	// Register the new TaskRunner for delete pods by phase
+	RegisterTaskRunner("CrewDeletePodsByPhase", func() TaskRunner { return &CrewDeletePodsByPhase{} })
+
+	// Register the new TaskRunner for get resource quota usage
+	RegisterTaskRunner("CrewGetResourceQuotaUsage", func() TaskRunner { return &CrewGetResourceQuotaUsage{} })
+
+	// Register the new TaskRunner for create configmap
+	RegisterTaskRunner("CrewCreateConfigMap", func() TaskRunner { return &CrewCreateConfigMap{} })
+
+	// Register the new TaskRunner for wait for pvc bound
+	RegisterTaskRunner("CrewWaitForPVCBound", func() TaskRunner { return &CrewWaitForPVCBound{} })
+
+	// Register the new TaskRunner for restart pods
+	RegisterTaskRunner("CrewRestartPods", func() TaskRunner { return &CrewRestartPods{} })
+
+	// Register the new TaskRunner for get pod owner chain
+	RegisterTaskRunner("CrewGetPodOwnerChain", func() TaskRunner { return &CrewGetPodOwnerChain{} })
+
+	// Register the new TaskRunner for create headless service
+	RegisterTaskRunner("CrewCreateHeadlessService", func() TaskRunner { return &CrewCreateHeadlessService{} })
+
+	// Register the new TaskRunner for check deployment rollout stuck
+	RegisterTaskRunner("CrewCheckDeploymentRolloutStuck", func() TaskRunner { return &CrewCheckDeploymentRolloutStuck{} })
+
+	// Register the new TaskRunner for update hpa
+	RegisterTaskRunner("CrewUpdateHPA", func() TaskRunner { return &CrewUpdateHPA{} })
+
+	// Register the new TaskRunner for create endpointslice
+	RegisterTaskRunner("CrewCreateEndpointSlice", func() TaskRunner { return &CrewCreateEndpointSlice{} })
+
+	// Register the new TaskRunner for get pod resource requests
+	RegisterTaskRunner("CrewGetPodResourceRequests", func() TaskRunner { return &CrewGetPodResourceRequests{} })
+
+	// Register the new TaskRunner for check pod security
+	RegisterTaskRunner("CrewCheckPodSecurity", func() TaskRunner { return &CrewCheckPodSecurity{} })
+
+	// Register the new TaskRunner for labeling resources across kinds
+	RegisterTaskRunner("CrewLabelResourcesAcrossKinds", func() TaskRunner { return &CrewLabelResourcesAcrossKinds{} })
+
+	// Register the new TaskRunner for finding orphaned resources
+	RegisterTaskRunner("CrewGetOrphanedResources", func() TaskRunner { return &CrewGetOrphanedResources{} })
+
+	// Register the new TaskRunner for scaling based on a pod count
+	RegisterTaskRunner("CrewScaleBasedOnPodCount", func() TaskRunner { return &CrewScaleBasedOnPodCount{} })
+
 }
@@ -0,0 +1,121 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/H0llyW00dzZ/K8sBlackPearl/language"
+	"github.com/H0llyW00dzZ/K8sBlackPearl/navigator"
+	"github.com/H0llyW00dzZ/go-urlshortner/logmonitor/constant"
+	"go.uber.org/zap"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// WaitForJobCompletion polls a Job until its succeeded pods reach the requested completions, the Job
+// fails, or the timeout elapses. A progress message is sent through the results channel whenever the
+// Job's active, succeeded, or failed pod counts change. A failed Job is reported with the reason and
+// message of its Failed condition.
+//
+// Parameters:
+//
+//	ctx context.Context: Context for cancellation; the wait is additionally bounded by timeout.
+//	clientset kubernetes.Interface: A Kubernetes clientset for interacting with the Kubernetes API.
+//	namespace string: The namespace of the Job.
+//	jobName string: The name of the Job to wait for.
+//	timeout time.Duration: The maximum time to wait.
+//	results chan<- string: A channel that receives progress messages; it must be drained concurrently.
+//	logger *zap.Logger: A logger for structured logging.
+//
+// Returns an error if the Job fails, cannot be read, or does not complete before the timeout.
+func WaitForJobCompletion(ctx context.Context, clientset kubernetes.Interface, namespace, jobName string, timeout time.Duration, results chan<- string, logger *zap.Logger) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	var lastStatus *batchv1.JobStatus
+	for {
+		job, err := clientset.BatchV1().Jobs(namespace).Get(ctx, jobName, v1.GetOptions{})
+		switch {
+		case err != nil && ctx.Err() != nil:
+			// The wait ended while the request was in flight; report it below as a timeout.
+		case apierrors.IsNotFound(err):
+			return reportJobWaitFailure(results, jobName, markNonRetriable(fmt.Errorf(language.ErrorJobNotFound, jobName, namespace)))
+		case err != nil:
+			return reportJobWaitFailure(results, jobName, err)
+		default:
+			if failed := jobCondition(job, batchv1.JobFailed); failed != nil {
+				return reportJobWaitFailure(results, jobName, markNonRetriable(fmt.Errorf(language.ErrorJobFailed, jobName, failed.Reason, failed.Message)))
+			}
+
+			completions := int32(1)
+			if job.Spec.Completions != nil {
+				completions = *job.Spec.Completions
+			}
+			if job.Status.Succeeded >= completions || jobCondition(job, batchv1.JobComplete) != nil {
+				successMsg := fmt.Sprintf(language.JobCompleted, jobName, job.Status.Succeeded, completions)
+				results <- successMsg
+				navigator.LogInfoWithEmoji(constant.SuccessEmoji, successMsg)
+				return nil
+			}
+
+			if lastStatus == nil || lastStatus.Active != job.Status.Active || lastStatus.Succeeded != job.Status.Succeeded || lastStatus.Failed != job.Status.Failed {
+				results <- fmt.Sprintf(language.JobProgress, jobName, job.Status.Succeeded, completions, job.Status.Active, job.Status.Failed)
+				lastStatus = &job.Status
+			}
+		}
+
+		if !waitForNextAttempt(ctx, podPollInterval) {
+			return reportJobWaitFailure(results, jobName, fmt.Errorf(language.ErrorJobNotCompleteInTime, jobName, timeout))
+		}
+	}
+}
+
+// jobCondition returns the Job's condition of the given type when its status is True, or nil.
+//
+// This unexported function is used internally by WaitForJobCompletion.
+func jobCondition(job *batchv1.Job, conditionType batchv1.JobConditionType) *batchv1.JobCondition {
+	for i := range job.Status.Conditions {
+		condition := &job.Status.Conditions[i]
+		if condition.Type == conditionType && condition.Status == corev1.ConditionTrue {
+			return condition
+		}
+	}
+	return nil
+}
+
+// reportJobWaitFailure sends an error message to the results channel, logs the failure,
+// and returns the error so callers can propagate it directly.
+//
+// This unexported function is used internally by WaitForJobCompletion to report failures.
+func reportJobWaitFailure(results chan<- string, jobName string, err error) error {
+	errorMessage := fmt.Sprintf(language.ErrorFailedWaitingForJob, jobName, err)
+	results <- errorMessage
+	navigator.LogErrorWithEmojiRateLimited(constant.ErrorEmoji, errorMessage, zap.Error(err))
+	return err
+}
+
+// extractJobWaitParameters extracts and validates the 'jobName' and optional 'timeout' parameters.
+// The timeout defaults to defaultWatchTimeout.
+//
+// This function is used by task runners that wait for Jobs.
+func extractJobWaitParameters(parameters map[string]interface{}) (string, time.Duration, error) {
+	jobName, err := getParamAsString(parameters, jobNamE)
+	if err != nil || jobName == "" {
+		return "", 0, newParameterError(jobNamE, err, language.ErrorParameterMissing, jobNamE)
+	}
+
+	timeoutStr, err := getOptionalParamAsString(parameters, timeouT, defaultWatchTimeout)
+	if err != nil {
+		return "", 0, err
+	}
+	timeout, err := time.ParseDuration(timeoutStr)
+	if err != nil || timeout <= 0 {
+		return "", 0, newParameterError(timeouT, err, language.ErrorParameterInvalid, timeouT)
+	}
+
+	return jobName, timeout, nil
+}
@@ -0,0 +1,161 @@
+package worker
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/H0llyW00dzZ/ChatGPT-Next-Web-Session-Exporter/bannercli"
+	"github.com/H0llyW00dzZ/K8sBlackPearl/language"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+// KubeClientMode selects where the Kubernetes client configuration is loaded from.
+type KubeClientMode string
+
+const (
+	// KubeClientModeAuto tries the in-cluster configuration first and falls back to the kubeconfig file.
+	// This is the behavior of NewKubernetesClient.
+	KubeClientModeAuto KubeClientMode = "auto"
+	// KubeClientModeInCluster only uses the in-cluster service account configuration.
+	KubeClientModeInCluster KubeClientMode = "incluster"
+	// KubeClientModeKubeconfig only uses the kubeconfig file, even when running inside a pod.
+	KubeClientModeKubeconfig KubeClientMode = "kubeconfig"
+)
+
+// KubeClientOptions controls how NewKubernetesClientWithOptions builds its configuration.
+//
+// Fields:
+//
+//	Mode KubeClientMode: Where to load the configuration from; an empty Mode means KubeClientModeAuto.
+//	KubeconfigPath string: The kubeconfig file to use; defaults to $HOME/.kube/config.
+//	Context string: The kubeconfig context to use; defaults to the file's current context.
+//	CallTimeout time.Duration: The maximum duration of a single API call; zero means 30 seconds and a
+//	negative value disables the bound. Watches and followed logs are never bounded.
+type KubeClientOptions struct {
+	Mode           KubeClientMode
+	KubeconfigPath string
+	Context        string
+	CallTimeout    time.Duration
+}
+
+// NewKubernetesClientWithOptions creates a new Kubernetes client using the configuration source
+// selected by the options. Unlike NewKubernetesClient, it can be told to ignore the in-cluster
+// configuration, which is needed when developing against a remote cluster from inside a pod.
+//
+// Parameters:
+//
+//	opts KubeClientOptions: The configuration source and kubeconfig settings.
+//
+// Returns:
+//
+//	*kubernetes.Clientset: A pointer to a Kubernetes Clientset ready for API interactions.
+//	error: An error if the configuration fails or the client cannot be created.
+func NewKubernetesClientWithOptions(opts KubeClientOptions) (*kubernetes.Clientset, error) {
+	config, err := buildConfigWithOptions(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return newClientsetForConfig(config)
+}
+
+// buildConfigWithOptions resolves the Kubernetes client configuration according to the options
+// and installs the per-call timeout.
+//
+// Returns:
+//
+//	*rest.Config: A configuration object for the Kubernetes client.
+//	error: An error if the mode is unknown or the selected source cannot be used.
+func buildConfigWithOptions(opts KubeClientOptions) (*rest.Config, error) {
+	config, err := loadConfigForMode(opts)
+	if err != nil {
+		return nil, err
+	}
+	applyCallTimeout(config, opts.CallTimeout)
+	applyAPILatencyLogging(config)
+	return config, nil
+}
+
+// loadConfigForMode loads the client configuration from the source selected by opts.Mode.
+//
+// This unexported function is used internally by buildConfigWithOptions.
+func loadConfigForMode(opts KubeClientOptions) (*rest.Config, error) {
+	switch opts.Mode {
+	case "", KubeClientModeAuto:
+		config, err := rest.InClusterConfig()
+		if err == nil {
+			announceClientMode(language.ClientModeInCluster)
+			return config, nil
+		}
+		// Notify that the setup is not running in a Kubernetes cluster.
+		if !isQuietStartup() {
+			bannercli.PrintTypingBanner(notifyintializeNotInCluster, 200*time.Millisecond)
+			time.Sleep(500 * time.Millisecond)
+			bannercli.PrintAnimatedBanner(intializeoutOfCluster, 1, 200*time.Millisecond)
+		}
+		return buildKubeconfigConfig(opts.KubeconfigPath, opts.Context)
+	case KubeClientModeInCluster:
+		config, err := rest.InClusterConfig()
+		if err != nil {
+			return nil, fmt.Errorf(errConfig, err)
+		}
+		announceClientMode(language.ClientModeInCluster)
+		return config, nil
+	case KubeClientModeKubeconfig:
+		return buildKubeconfigConfig(opts.KubeconfigPath, opts.Context)
+	default:
+		return nil, fmt.Errorf(errUnknownClientMode, opts.Mode)
+	}
+}
+
+// buildKubeconfigConfig builds a configuration from a kubeconfig file, optionally selecting a context.
+//
+// Parameters:
+//
+//	kubeconfigPath string: The kubeconfig file to use, or empty for the default location.
+//	contextName string: The context to use, or empty for the file's current context.
+//
+// Returns:
+//
+//	*rest.Config: A configuration object for the Kubernetes client.
+//	error: An error if the kubeconfig file cannot be found or is invalid.
+func buildKubeconfigConfig(kubeconfigPath, contextName string) (*rest.Config, error) {
+	path, err := resolveKubeconfigPath(kubeconfigPath)
+	if err != nil {
+		if !isQuietStartup() {
+			bannercli.PrintTypingBanner(err.Error(), 200*time.Millisecond)
+		}
+		return nil, err
+	}
+
+	loadingRules := &clientcmd.ClientConfigLoadingRules{ExplicitPath: path}
+	overrides := &clientcmd.ConfigOverrides{CurrentContext: contextName}
+	config, err := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(loadingRules, overrides).ClientConfig()
+	if err != nil {
+		return nil, err
+	}
+	announceClientMode(language.ClientModeOutOfCluster)
+	return config, nil
+}
+
+// resolveKubeconfigPath returns the explicit kubeconfig path when one is given, and otherwise the
+// default $HOME/.kube/config location.
+//
+// Returns:
+//
+//	string: The kubeconfig path to load.
+//	error: An error if no path is given and the HOME environment variable is not set.
+func resolveKubeconfigPath(kubeconfigPath string) (string, error) {
+	if kubeconfigPath != "" {
+		return kubeconfigPath, nil
+	}
+	homeDir, found := os.LookupEnv(homeEnvVar)
+	if !found {
+		return "", fmt.Errorf(errEnvVar, homeEnvVar)
+	}
+	return filepath.Join(homeDir, dotKubeDir, kubeConfigFile), nil
+}
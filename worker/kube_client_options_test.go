@@ -0,0 +1,98 @@
+package worker
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// twoClusterKubeconfig has a current context pointing at the deck cluster and a second context
+// pointing at the hold cluster.
+const twoClusterKubeconfig = `apiVersion: v1
+kind: Config
+current-context: deck
+clusters:
+- name: deck
+  cluster:
+    server: https://deck.example:6443
+- name: hold
+  cluster:
+    server: https://hold.example:6443
+users:
+- name: sparrow
+  user:
+    token: rum
+contexts:
+- name: deck
+  context:
+    cluster: deck
+    user: sparrow
+- name: hold
+  context:
+    cluster: hold
+    user: sparrow
+`
+
+// writeKubeconfig writes the kubeconfig to a temporary file and makes sure the test does not look
+// like it runs inside a cluster.
+func writeKubeconfig(t *testing.T) string {
+	t.Helper()
+	t.Setenv("KUBERNETES_SERVICE_HOST", "")
+	t.Setenv("KUBERNETES_SERVICE_PORT", "")
+
+	path := filepath.Join(t.TempDir(), "config")
+	if err := os.WriteFile(path, []byte(twoClusterKubeconfig), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestBuildConfigWithOptionsModes(t *testing.T) {
+	// The animated startup banners take seconds per build.
+	SetQuietStartup(true)
+	t.Cleanup(func() { SetQuietStartup(false) })
+	path := writeKubeconfig(t)
+
+	for name, tc := range map[string]struct {
+		opts       KubeClientOptions
+		wantServer string
+	}{
+		"auto falls back to the kubeconfig": {KubeClientOptions{KubeconfigPath: path}, "https://deck.example:6443"},
+		"kubeconfig current context":        {KubeClientOptions{Mode: KubeClientModeKubeconfig, KubeconfigPath: path}, "https://deck.example:6443"},
+		"kubeconfig named context":          {KubeClientOptions{Mode: KubeClientModeKubeconfig, KubeconfigPath: path, Context: "hold"}, "https://hold.example:6443"},
+	} {
+		config, err := buildConfigWithOptions(tc.opts)
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		if config.Host != tc.wantServer {
+			t.Fatalf("%s: got server %q, want %q", name, config.Host, tc.wantServer)
+		}
+	}
+
+	for name, opts := range map[string]KubeClientOptions{
+		"in-cluster outside a cluster": {Mode: KubeClientModeInCluster, KubeconfigPath: path},
+		"unknown mode":                 {Mode: "sideways", KubeconfigPath: path},
+		"unknown context":              {Mode: KubeClientModeKubeconfig, KubeconfigPath: path, Context: "brig"},
+	} {
+		if _, err := buildConfigWithOptions(opts); err == nil {
+			t.Fatalf("%s: got no error", name)
+		}
+	}
+}
+
+func TestResolveKubeconfigPath(t *testing.T) {
+	if path, err := resolveKubeconfigPath("/etc/pearl/config"); err != nil || path != "/etc/pearl/config" {
+		t.Fatalf("got %q, %v, want the explicit path", path, err)
+	}
+
+	t.Setenv("HOME", "/home/sparrow")
+	if path, err := resolveKubeconfigPath(""); err != nil || path != "/home/sparrow/.kube/config" {
+		t.Fatalf("got %q, %v, want the default path under HOME", path, err)
+	}
+
+	os.Unsetenv("HOME")
+	if _, err := resolveKubeconfigPath(""); err == nil {
+		t.Fatal("got no error without HOME")
+	}
+}
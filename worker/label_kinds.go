@@ -0,0 +1,291 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/H0llyW00dzZ/K8sBlackPearl/language"
+	"github.com/H0llyW00dzZ/K8sBlackPearl/navigator"
+	"github.com/H0llyW00dzZ/go-urlshortner/logmonitor/constant"
+	"go.uber.org/zap"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+)
+
+// kindLabeler lists and patches the objects of a single resource kind through its typed client.
+type kindLabeler struct {
+	list  func(ctx context.Context, clientset kubernetes.Interface, namespace string, options v1.ListOptions) ([]v1.ObjectMeta, error)
+	patch func(ctx context.Context, clientset kubernetes.Interface, namespace, name string, data []byte) error
+}
+
+// kindLabelers are the resource kinds LabelResourcesAcrossKinds can label, keyed by their plural
+// lowercase name.
+var kindLabelers = map[string]kindLabeler{
+	"deployments": {
+		list: func(ctx context.Context, clientset kubernetes.Interface, namespace string, options v1.ListOptions) ([]v1.ObjectMeta, error) {
+			list, err := clientset.AppsV1().Deployments(namespace).List(ctx, options)
+			if err != nil {
+				return nil, err
+			}
+			objects := make([]v1.ObjectMeta, 0, len(list.Items))
+			for _, item := range list.Items {
+				objects = append(objects, item.ObjectMeta)
+			}
+			return objects, nil
+		},
+		patch: func(ctx context.Context, clientset kubernetes.Interface, namespace, name string, data []byte) error {
+			_, err := clientset.AppsV1().Deployments(namespace).Patch(ctx, name, types.StrategicMergePatchType, data, v1.PatchOptions{})
+			return err
+		},
+	},
+	"statefulsets": {
+		list: func(ctx context.Context, clientset kubernetes.Interface, namespace string, options v1.ListOptions) ([]v1.ObjectMeta, error) {
+			list, err := clientset.AppsV1().StatefulSets(namespace).List(ctx, options)
+			if err != nil {
+				return nil, err
+			}
+			objects := make([]v1.ObjectMeta, 0, len(list.Items))
+			for _, item := range list.Items {
+				objects = append(objects, item.ObjectMeta)
+			}
+			return objects, nil
+		},
+		patch: func(ctx context.Context, clientset kubernetes.Interface, namespace, name string, data []byte) error {
+			_, err := clientset.AppsV1().StatefulSets(namespace).Patch(ctx, name, types.StrategicMergePatchType, data, v1.PatchOptions{})
+			return err
+		},
+	},
+	"daemonsets": {
+		list: func(ctx context.Context, clientset kubernetes.Interface, namespace string, options v1.ListOptions) ([]v1.ObjectMeta, error) {
+			list, err := clientset.AppsV1().DaemonSets(namespace).List(ctx, options)
+			if err != nil {
+				return nil, err
+			}
+			objects := make([]v1.ObjectMeta, 0, len(list.Items))
+			for _, item := range list.Items {
+				objects = append(objects, item.ObjectMeta)
+			}
+			return objects, nil
+		},
+		patch: func(ctx context.Context, clientset kubernetes.Interface, namespace, name string, data []byte) error {
+			_, err := clientset.AppsV1().DaemonSets(namespace).Patch(ctx, name, types.StrategicMergePatchType, data, v1.PatchOptions{})
+			return err
+		},
+	},
+	"services": {
+		list: func(ctx context.Context, clientset kubernetes.Interface, namespace string, options v1.ListOptions) ([]v1.ObjectMeta, error) {
+			list, err := clientset.CoreV1().Services(namespace).List(ctx, options)
+			if err != nil {
+				return nil, err
+			}
+			objects := make([]v1.ObjectMeta, 0, len(list.Items))
+			for _, item := range list.Items {
+				objects = append(objects, item.ObjectMeta)
+			}
+			return objects, nil
+		},
+		patch: func(ctx context.Context, clientset kubernetes.Interface, namespace, name string, data []byte) error {
+			_, err := clientset.CoreV1().Services(namespace).Patch(ctx, name, types.StrategicMergePatchType, data, v1.PatchOptions{})
+			return err
+		},
+	},
+	"configmaps": {
+		list: func(ctx context.Context, clientset kubernetes.Interface, namespace string, options v1.ListOptions) ([]v1.ObjectMeta, error) {
+			list, err := clientset.CoreV1().ConfigMaps(namespace).List(ctx, options)
+			if err != nil {
+				return nil, err
+			}
+			objects := make([]v1.ObjectMeta, 0, len(list.Items))
+			for _, item := range list.Items {
+				objects = append(objects, item.ObjectMeta)
+			}
+			return objects, nil
+		},
+		patch: func(ctx context.Context, clientset kubernetes.Interface, namespace, name string, data []byte) error {
+			_, err := clientset.CoreV1().ConfigMaps(namespace).Patch(ctx, name, types.StrategicMergePatchType, data, v1.PatchOptions{})
+			return err
+		},
+	},
+	"secrets": {
+		list: func(ctx context.Context, clientset kubernetes.Interface, namespace string, options v1.ListOptions) ([]v1.ObjectMeta, error) {
+			list, err := clientset.CoreV1().Secrets(namespace).List(ctx, options)
+			if err != nil {
+				return nil, err
+			}
+			objects := make([]v1.ObjectMeta, 0, len(list.Items))
+			for _, item := range list.Items {
+				objects = append(objects, item.ObjectMeta)
+			}
+			return objects, nil
+		},
+		patch: func(ctx context.Context, clientset kubernetes.Interface, namespace, name string, data []byte) error {
+			_, err := clientset.CoreV1().Secrets(namespace).Patch(ctx, name, types.StrategicMergePatchType, data, v1.PatchOptions{})
+			return err
+		},
+	},
+	"pods": {
+		list: func(ctx context.Context, clientset kubernetes.Interface, namespace string, options v1.ListOptions) ([]v1.ObjectMeta, error) {
+			options.Limit = defaultPageSize
+			list, err := listAllPods(ctx, clientset, namespace, options, 0)
+			if err != nil {
+				return nil, err
+			}
+			objects := make([]v1.ObjectMeta, 0, len(list.Items))
+			for _, item := range list.Items {
+				objects = append(objects, item.ObjectMeta)
+			}
+			return objects, nil
+		},
+		patch: func(ctx context.Context, clientset kubernetes.Interface, namespace, name string, data []byte) error {
+			_, err := clientset.CoreV1().Pods(namespace).Patch(ctx, name, types.StrategicMergePatchType, data, v1.PatchOptions{})
+			return err
+		},
+	},
+}
+
+// LabelResourcesAcrossKinds sets a label on the objects of several resource kinds matching a label
+// selector, and reports the outcome of every kind, followed by a summary, through the results channel.
+// Each object is labeled with a strategic merge patch that only carries the label being set, and objects
+// that already carry the label with the desired value are skipped. The kinds are labeled independently,
+// so a kind that cannot be listed or patched (for example, because of missing RBAC permissions) does not
+// prevent the others from being labeled.
+//
+// Parameters:
+//
+//	ctx context.Context: Context for cancellation; it is checked between kinds and between objects.
+//	clientset kubernetes.Interface: A Kubernetes clientset for interacting with the Kubernetes API.
+//	namespace string: The namespace of the objects.
+//	kinds []string: The kinds to label, as keys of kindLabelers.
+//	labelSelector string: The label selector of the objects to label; empty selects every object.
+//	labelKey string: The key of the label to be added or updated.
+//	labelValue string: The value for the label.
+//	results chan<- string: A channel that receives the report; it must be drained concurrently.
+//	logger *zap.Logger: A logger for structured logging.
+//
+// Returns the context error if the context is cancelled, or the errors of every kind that failed, joined.
+func LabelResourcesAcrossKinds(ctx context.Context, clientset kubernetes.Interface, namespace string, kinds []string, labelSelector, labelKey, labelValue string, results chan<- string, logger *zap.Logger) error {
+	// Only the label being set is sent, so the merge leaves every other label and field untouched.
+	patchData, err := json.Marshal(map[string]interface{}{
+		metaData: map[string]interface{}{
+			labeLs: map[string]string{labelKey: labelValue},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	var totalLabeled, totalSkipped int
+	for _, kind := range kinds {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		labeled, skipped, err := labelKind(ctx, clientset, kindLabelers[kind], namespace, labelSelector, labelKey, labelValue, patchData)
+		totalLabeled += labeled
+		totalSkipped += skipped
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return ctxErr
+		}
+
+		kindFields := []zap.Field{
+			zap.String(language.InventoryResource, kind),
+			zap.Int(language.LabeledCount, labeled),
+			zap.Int(language.SkippedCount, skipped),
+		}
+		if err != nil {
+			message := fmt.Sprintf(language.ErrorFailedToLabelKind, kind, labeled, err)
+			results <- message
+			navigator.LogErrorWithEmojiRateLimited(constant.ErrorEmoji, message, append(kindFields, zap.Error(err))...)
+			errs = append(errs, fmt.Errorf("%s: %w", kind, err))
+			continue
+		}
+		message := fmt.Sprintf(language.KindLabeled, kind, labeled, skipped)
+		results <- message
+		navigator.LogInfoWithEmoji(constant.SuccessEmoji, message, kindFields...)
+	}
+
+	summary := fmt.Sprintf(language.LabelKindsSummary, labelKey, labelValue, len(kinds), namespace, totalLabeled, totalSkipped, len(errs))
+	results <- summary
+	if len(errs) > 0 {
+		navigator.LogInfoWithEmoji(language.WarningEmoji, summary)
+		return errors.Join(errs...)
+	}
+	navigator.LogInfoWithEmoji(constant.SuccessEmoji, summary)
+	return nil
+}
+
+// labelKind labels the objects of a single kind matching the selector, skipping those that already
+// carry the label. Patching stops at the first object that fails, since the failures of one kind
+// typically share a cause.
+//
+// This unexported function is used internally by LabelResourcesAcrossKinds.
+func labelKind(ctx context.Context, clientset kubernetes.Interface, labeler kindLabeler, namespace, labelSelector, labelKey, labelValue string, patchData []byte) (labeled, skipped int, err error) {
+	objects, err := labeler.list(ctx, clientset, namespace, v1.ListOptions{LabelSelector: labelSelector})
+	if err != nil {
+		return 0, 0, err
+	}
+	for _, object := range objects {
+		if err := ctx.Err(); err != nil {
+			return labeled, skipped, err
+		}
+		if value, exists := object.Labels[labelKey]; exists && value == labelValue {
+			skipped++
+			continue
+		}
+		if err := labeler.patch(ctx, clientset, namespace, object.Name, patchData); err != nil {
+			return labeled, skipped, fmt.Errorf("%s: %w", object.Name, err)
+		}
+		labeled++
+	}
+	return labeled, skipped, nil
+}
+
+// extractLabelKindsParameters extracts the required 'kinds', 'labelKey', and 'labelValue' parameters
+// and the optional 'labelSelector' parameter. Kinds are matched case-insensitively and may be given in
+// their singular form, such as "Deployment"; duplicates are dropped. An empty selector labels every
+// object of each kind.
+//
+// This function is used by task runners that label resources across kinds.
+func extractLabelKindsParameters(parameters map[string]interface{}) (kinds []string, selector, labelKey, labelValue string, err error) {
+	rawKinds, err := getParamAsSlice(parameters, kindS)
+	if err != nil {
+		return nil, "", "", "", err
+	}
+	if len(rawKinds) == 0 {
+		return nil, "", "", "", newParameterError(kindS, nil, language.ErrorParameterMissing, kindS)
+	}
+	seen := make(map[string]bool, len(rawKinds))
+	for _, item := range rawKinds {
+		name, ok := item.(string)
+		if !ok {
+			return nil, "", "", "", newParameterError(kindS, nil, language.ErrorParameterMustBeList, kindS)
+		}
+		kind := strings.ToLower(name)
+		if _, known := kindLabelers[kind]; !known {
+			kind += "s"
+		}
+		if _, known := kindLabelers[kind]; !known {
+			return nil, "", "", "", newParameterError(kindS, nil, language.ErrorUnsupportedLabelKind, name)
+		}
+		if !seen[kind] {
+			seen[kind] = true
+			kinds = append(kinds, kind)
+		}
+	}
+
+	selector, err = getOptionalParamAsString(parameters, labelSelector, "")
+	if err != nil {
+		return nil, "", "", "", err
+	}
+
+	labelKey, labelValue, err = extractLabelParameters(parameters)
+	if err != nil {
+		return nil, "", "", "", err
+	}
+
+	return kinds, selector, labelKey, labelValue, nil
+}
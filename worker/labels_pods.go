@@ -18,7 +18,7 @@ import (
 // Parameters:
 //
 //	ctx context.Context: A context.Context for managing cancellation and deadlines.
-//	clientset *kubernetes.Clientset: A *kubernetes.Clientset instance used to interact with the Kubernetes API.
+//	clientset kubernetes.Interface: A kubernetes.Interface instance used to interact with the Kubernetes API.
 //	podName: The name of the pod to label.
 //	namespace: The namespace in which the pod is located.
 //	labelKey: The key of the label to be added or updated.
@@ -27,7 +27,7 @@ import (
 // Returns:
 //
 //	error: An error if the pod cannot be retrieved or updated with the new label.
-func labelSinglePodWithResourceVersion(ctx context.Context, clientset *kubernetes.Clientset, podName, namespace, labelKey, labelValue string) error {
+func labelSinglePodWithResourceVersion(ctx context.Context, clientset kubernetes.Interface, podName, namespace, labelKey, labelValue string) error {
 	latestPod, err := fetchLatestPodVersion(ctx, clientset, podName, namespace)
 	if err != nil {
 		return wrapPodError(podName, err)
@@ -46,7 +46,7 @@ func labelSinglePodWithResourceVersion(ctx context.Context, clientset *kubernete
 // Parameters:
 //
 //	ctx context.Context: A context.Context for managing cancellation and deadlines.
-//	clientset *kubernetes.Clientset: A *kubernetes.Clientset instance used to interact with the Kubernetes API.
+//	clientset kubernetes.Interface: A kubernetes.Interface instance used to interact with the Kubernetes API.
 //	podName: The name of the pod to retrieve.
 //	namespace string: The namespace in which the pod is located.
 //
@@ -54,7 +54,7 @@ func labelSinglePodWithResourceVersion(ctx context.Context, clientset *kubernete
 //
 //	*corev1.Pod: A pointer to the retrieved corev1.Pod instance.
 //	error: An error if the pod cannot be retrieved.
-func fetchLatestPodVersion(ctx context.Context, clientset *kubernetes.Clientset, podName, namespace string) (*corev1.Pod, error) {
+func fetchLatestPodVersion(ctx context.Context, clientset kubernetes.Interface, podName, namespace string) (*corev1.Pod, error) {
 	return clientset.CoreV1().Pods(namespace).Get(ctx, podName, v1.GetOptions{})
 }
 
@@ -75,12 +75,12 @@ func shouldUpdatePod(pod *corev1.Pod, labelKey, labelValue string) bool {
 }
 
 // updatePodLabels applies the update to the pod's labels using a strategic merge patch.
-// It ensures that only the labels are updated, leaving the rest of the pod configuration unchanged.
+// It ensures that only the given label is updated, leaving the rest of the pod configuration unchanged.
 //
 // Parameters:
 //
 //	ctx context.Context: A context.Context for managing cancellation and deadlines.
-//	clientset *kubernetes.Clientset: A *kubernetes.Clientset instance used to interact with the Kubernetes API.
+//	clientset kubernetes.Interface: A kubernetes.Interface instance used to interact with the Kubernetes API.
 //	pod *corev1.Pod: A pointer to the corev1.Pod instance to update.
 //	namespace: The namespace in which the pod is located.
 //	podName: The name of the pod to update.
@@ -90,12 +90,13 @@ func shouldUpdatePod(pod *corev1.Pod, labelKey, labelValue string) bool {
 // Returns:
 //
 //	error: An error if the patch cannot be created or applied to the pod.
-func updatePodLabels(ctx context.Context, clientset *kubernetes.Clientset, pod *corev1.Pod, namespace, podName, labelKey, labelValue string) error {
+func updatePodLabels(ctx context.Context, clientset kubernetes.Interface, pod *corev1.Pod, namespace, podName, labelKey, labelValue string) error {
 	pod.Labels = getUpdatedLabels(pod.Labels, labelKey, labelValue)
 
+	// Only the label being set is sent, so the merge leaves every other label and field untouched.
 	patchData, err := json.Marshal(map[string]interface{}{
 		metaData: map[string]interface{}{
-			labeLs: pod.Labels,
+			labeLs: map[string]string{labelKey: labelValue},
 		},
 	})
 	if err != nil {
@@ -147,29 +148,34 @@ func wrapPodError(podName string, err error) error {
 
 // LabelPods sets a specific label on all pods within the specified namespace that do not already have it.
 // This function iterates over all pods in the namespace and delegates the labeling of each individual pod
-// to the labelSinglePod function.
+// to the labelSinglePod function. The context is checked before each pod, so a cancelled context stops
+// a large labeling run without touching the remaining pods.
 //
 // Parameters:
 //
 //	ctx context.Context: A context.Context for managing cancellation and deadlines.
-//	clientset *kubernetes.Clientset: A *kubernetes.Clientset instance used to interact with the Kubernetes API.
+//	clientset kubernetes.Interface: A kubernetes.Interface instance used to interact with the Kubernetes API.
 //	namespace: The namespace in which the pods are located.
 //	labelKey: The key of the label to be added or updated.
 //	labelValue string: The value for the label.
 //
 // Returns:
 //
-//	error: An error if listing pods or updating any pod's labels fails.
-func LabelPods(ctx context.Context, clientset *kubernetes.Clientset, namespace, labelKey, labelValue string) error {
-	// Retrieve a list of all pods in the given namespace using the provided context.
-	pods, err := clientset.CoreV1().Pods(namespace).List(ctx, v1.ListOptions{})
+//	error: An error if listing pods or updating any pod's labels fails, or the context error if the
+//	context is cancelled before every pod is labeled.
+func LabelPods(ctx context.Context, clientset kubernetes.Interface, namespace, labelKey, labelValue string) error {
+	// Retrieve a list of all pods in the given namespace page by page using the provided context.
+	pods, err := listAllPods(ctx, clientset, namespace, v1.ListOptions{Limit: defaultPageSize}, 0)
 	if err != nil {
 		return fmt.Errorf(language.ErrorListingPods, err)
 	}
 
 	// Iterate over the list of pods and update their labels if necessary.
-	for _, pod := range pods.Items {
-		if err := labelSinglePodWithResourceVersion(ctx, clientset, pod.Name, namespace, labelKey, labelValue); err != nil {
+	for i := range pods.Items {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := labelSinglePod(ctx, clientset, &pods.Items[i], namespace, labelKey, labelValue); err != nil {
 			return err
 		}
 	}
@@ -177,13 +183,14 @@ func LabelPods(ctx context.Context, clientset *kubernetes.Clientset, namespace,
 }
 
 // labelSinglePod applies the label to a single pod if it doesn't already have it.
-// This function checks the existing labels of the pod and only performs an update
-// if the label is not already set to the desired value.
+// This function checks the existing labels of the pod and only sends a strategic merge patch
+// through updatePodLabels if the label is not already set to the desired value. The patch touches
+// only the label being set, so concurrent changes to other fields of the pod are never overwritten.
 //
 // Parameters:
 //
 //	ctx context.Context: A context.Context for managing cancellation and deadlines.
-//	clientset *kubernetes.Clientset: A *kubernetes.Clientset instance used to interact with the Kubernetes API.
+//	clientset kubernetes.Interface: A kubernetes.Interface instance used to interact with the Kubernetes API.
 //	pod *corev1.Pod: A pointer to the corev1.Pod instance to label.
 //	namespace: The namespace in which the pod is located.
 //	labelKey: The key of the label to be added or updated.
@@ -192,25 +199,12 @@ func LabelPods(ctx context.Context, clientset *kubernetes.Clientset, namespace,
 // Returns:
 //
 //	error: An error if the pod's labels cannot be updated.
-func labelSinglePod(ctx context.Context, clientset *kubernetes.Clientset, pod *corev1.Pod, namespace, labelKey, labelValue string) error {
+func labelSinglePod(ctx context.Context, clientset kubernetes.Interface, pod *corev1.Pod, namespace, labelKey, labelValue string) error {
 	// If the pod already has the label with the correct value, skip updating.
-	if pod.Labels[labelKey] == labelValue {
+	if !shouldUpdatePod(pod, labelKey, labelValue) {
 		return nil
 	}
-
-	// Prepare the pod's labels for update or create a new label map if none exist.
-	podCopy := pod.DeepCopy()
-	if podCopy.Labels == nil {
-		podCopy.Labels = make(map[string]string)
-	}
-	podCopy.Labels[labelKey] = labelValue
-
-	// Update the pod with the new label using the provided context.
-	_, err := clientset.CoreV1().Pods(namespace).Update(ctx, podCopy, v1.UpdateOptions{})
-	if err != nil {
-		return fmt.Errorf(language.ErrorUpdatingPod, err)
-	}
-	return nil
+	return updatePodLabels(ctx, clientset, pod.DeepCopy(), namespace, pod.Name, labelKey, labelValue)
 }
 
 // extractLabelParameters extracts and validates the label key and value from the parameters.
@@ -236,12 +230,12 @@ func labelSinglePod(ctx context.Context, clientset *kubernetes.Clientset, pod *c
 func extractLabelParameters(parameters map[string]interface{}) (labelKey string, labelValue string, err error) {
 	labelKey, err = getParamAsString(parameters, labeLKey)
 	if err != nil {
-		return "", "", fmt.Errorf(language.ErrorParameterMustBeString, err)
+		return "", "", newParameterError(labeLKey, err, language.ErrorParameterMustBeString, err)
 	}
 
 	labelValue, err = getParamAsString(parameters, labeLValue)
 	if err != nil {
-		return "", "", fmt.Errorf(language.ErrorParameterMustBeString, err)
+		return "", "", newParameterError(labeLValue, err, language.ErrorParameterMustBeString, err)
 	}
 
 	return labelKey, labelValue, nil
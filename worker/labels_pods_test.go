@@ -0,0 +1,123 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/H0llyW00dzZ/K8sBlackPearl/worker/configuration"
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func TestCrewLabelPodsAgainstFakeClientset(t *testing.T) {
+	clientset := fake.NewSimpleClientset(
+		&corev1.Pod{ObjectMeta: v1.ObjectMeta{Name: "unlabeled", Namespace: "crew"}},
+		&corev1.Pod{ObjectMeta: v1.ObjectMeta{Name: "labeled", Namespace: "crew", Labels: map[string]string{"ship": "pearl"}}},
+	)
+	task := configuration.Task{
+		Name:       "label-pods",
+		Type:       "CrewWriteLabelPods",
+		Parameters: map[string]interface{}{"labelKey": "ship", "labelValue": "pearl"},
+	}
+
+	if err := (&CrewLabelPodsTaskRunner{}).Run(context.Background(), clientset, "crew", task, task.Parameters, 0); err != nil {
+		t.Fatalf("Run returned an error: %v", err)
+	}
+
+	for _, name := range []string{"unlabeled", "labeled"} {
+		pod, err := clientset.CoreV1().Pods("crew").Get(context.Background(), name, v1.GetOptions{})
+		if err != nil {
+			t.Fatalf("getting pod %q: %v", name, err)
+		}
+		if got := pod.Labels["ship"]; got != "pearl" {
+			t.Errorf("pod %q has label ship=%q, want pearl", name, got)
+		}
+	}
+
+	patches := 0
+	for _, action := range clientset.Actions() {
+		if action.GetVerb() == "patch" {
+			patches++
+		}
+	}
+	if patches != 1 {
+		t.Errorf("got %d patches, want 1: the already labeled pod must be skipped", patches)
+	}
+}
+
+func TestLabelSinglePodKeepsConcurrentChanges(t *testing.T) {
+	clientset := fake.NewSimpleClientset(&corev1.Pod{
+		ObjectMeta: v1.ObjectMeta{Name: "deckhand", Namespace: "crew"},
+		Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: "app", Image: "pearl:1"}}},
+	})
+	stale, err := clientset.CoreV1().Pods("crew").Get(context.Background(), "deckhand", v1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Another writer changes the pod after it was read for labeling.
+	concurrent := stale.DeepCopy()
+	concurrent.Annotations = map[string]string{"checked-by": "gibbs"}
+	concurrent.Labels = map[string]string{"rank": "bosun"}
+	if _, err := clientset.CoreV1().Pods("crew").Update(context.Background(), concurrent, v1.UpdateOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	clientset.ClearActions()
+
+	if err := labelSinglePod(context.Background(), clientset, stale, "crew", "ship", "pearl"); err != nil {
+		t.Fatalf("labelSinglePod: %v", err)
+	}
+
+	pod, err := clientset.CoreV1().Pods("crew").Get(context.Background(), "deckhand", v1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pod.Labels["ship"] != "pearl" || pod.Labels["rank"] != "bosun" || pod.Annotations["checked-by"] != "gibbs" {
+		t.Fatalf("got labels %v and annotations %v, want the new label next to the concurrent changes", pod.Labels, pod.Annotations)
+	}
+	for _, action := range clientset.Actions() {
+		if action.GetVerb() == "update" {
+			t.Fatal("labeling wrote the whole pod with an update instead of patching its labels")
+		}
+	}
+}
+
+func TestLabelPodsStopsBetweenPodsOnCancellation(t *testing.T) {
+	clientset := fake.NewSimpleClientset()
+	for i := 0; i < 5; i++ {
+		pod := &corev1.Pod{ObjectMeta: v1.ObjectMeta{Name: fmt.Sprintf("deckhand-%d", i), Namespace: "crew"}}
+		if err := clientset.Tracker().Add(pod); err != nil {
+			t.Fatal(err)
+		}
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	// Shutdown is requested while the first pod is being labeled.
+	clientset.PrependReactor("patch", "pods", func(k8stesting.Action) (bool, runtime.Object, error) {
+		cancel()
+		return false, nil, nil
+	})
+
+	if err := LabelPods(ctx, clientset, "crew", "ship", "pearl"); !errors.Is(err, context.Canceled) {
+		t.Fatalf("got error %v, want the cancellation", err)
+	}
+
+	labeled := 0
+	pods, err := clientset.CoreV1().Pods("crew").List(context.Background(), v1.ListOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, pod := range pods.Items {
+		if pod.Labels["ship"] == "pearl" {
+			labeled++
+		}
+	}
+	if labeled != 1 {
+		t.Fatalf("%d pods were labeled, want only the one labeled before the cancellation", labeled)
+	}
+}
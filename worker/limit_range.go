@@ -0,0 +1,184 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/H0llyW00dzZ/K8sBlackPearl/language"
+	"github.com/H0llyW00dzZ/K8sBlackPearl/navigator"
+	"github.com/H0llyW00dzZ/go-urlshortner/logmonitor/constant"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/util/retry"
+)
+
+// CreateLimitRange creates a LimitRange in the specified namespace. If the LimitRange already exists
+// and overwrite is enabled, the existing object's spec is replaced with the provided limits, retrying
+// on conflicts. The outcome is reported through the results channel.
+//
+// Parameters:
+//
+//	ctx context.Context: Context for cancellation and timeout.
+//	clientset kubernetes.Interface: A Kubernetes clientset for interacting with the Kubernetes API.
+//	namespace string: The Kubernetes namespace in which to create the LimitRange.
+//	limitRangeName string: The name of the LimitRange to create.
+//	limits []corev1.LimitRangeItem: The limit items that make up the LimitRange spec.
+//	overwrite bool: Whether an existing LimitRange with the same name should be replaced.
+//	results chan<- string: A channel to send operation results for logging.
+//	logger *zap.Logger: A logger for structured logging.
+//
+// Returns an error if the LimitRange cannot be created or overwritten.
+func CreateLimitRange(ctx context.Context, clientset kubernetes.Interface, namespace, limitRangeName string, limits []corev1.LimitRangeItem, overwrite bool, results chan<- string, logger *zap.Logger) error {
+	limitRange := &corev1.LimitRange{
+		ObjectMeta: v1.ObjectMeta{
+			Name: limitRangeName,
+		},
+		Spec: corev1.LimitRangeSpec{
+			Limits: limits,
+		},
+	}
+
+	applyDefaultObjectMeta(limitRange)
+	created, err := clientset.CoreV1().LimitRanges(namespace).Create(ctx, limitRange, v1.CreateOptions{})
+	if err == nil {
+		publishCreatedObject(ctx, created)
+		successMsg := fmt.Sprintf(language.LimitRangeSuccessfullyCreated, limitRangeName, namespace)
+		results <- successMsg
+		navigator.LogInfoWithEmoji(constant.SuccessEmoji, successMsg)
+		return nil
+	}
+
+	if !apierrors.IsAlreadyExists(err) {
+		return reportLimitRangeFailure(results, limitRangeName, fmt.Errorf(language.ErrorCreatingLimitRange, err))
+	}
+
+	if !overwrite {
+		return reportLimitRangeFailure(results, limitRangeName, fmt.Errorf(language.ErrorLimitRangeAlreadyExists, limitRangeName))
+	}
+
+	err = retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		current, getErr := clientset.CoreV1().LimitRanges(namespace).Get(ctx, limitRangeName, v1.GetOptions{})
+		if getErr != nil {
+			return getErr
+		}
+		current.Spec = limitRange.Spec
+		_, updateErr := clientset.CoreV1().LimitRanges(namespace).Update(ctx, current, v1.UpdateOptions{})
+		return updateErr
+	})
+	if err != nil {
+		return reportLimitRangeFailure(results, limitRangeName, err)
+	}
+
+	successMsg := fmt.Sprintf(language.LimitRangeSuccessfullyUpdated, limitRangeName, namespace)
+	results <- successMsg
+	navigator.LogInfoWithEmoji(constant.SuccessEmoji, successMsg)
+	return nil
+}
+
+// reportLimitRangeFailure sends an error message to the results channel, logs the failure,
+// and returns the error so callers can propagate it directly.
+//
+// This unexported function is used internally by CreateLimitRange to report failures.
+func reportLimitRangeFailure(results chan<- string, limitRangeName string, err error) error {
+	errorMessage := fmt.Sprintf(language.ErrorFailedToCreateLimitRange, limitRangeName, err)
+	results <- errorMessage
+	navigator.LogErrorWithEmojiRateLimited(constant.ErrorEmoji, errorMessage, zap.Error(err))
+	return err
+}
+
+// extractLimitRangeParameters extracts and validates the 'limitRangeName', 'limits', and 'overwrite'
+// parameters from a map of parameters. Every quantity string is validated using resource.ParseQuantity.
+//
+// This function is used by task runners that create LimitRanges.
+func extractLimitRangeParameters(parameters map[string]interface{}) (string, []corev1.LimitRangeItem, bool, error) {
+	limitRangeName, err := getParamAsString(parameters, limitRangeNamE)
+	if err != nil || limitRangeName == "" {
+		return "", nil, false, newParameterError(limitRangeNamE, nil, language.ErrorParameterLimitRangeName)
+	}
+
+	rawLimits, err := getParamAsSlice(parameters, limiTs)
+	if err != nil {
+		return "", nil, false, err
+	}
+
+	limits := make([]corev1.LimitRangeItem, 0, len(rawLimits))
+	for i, rawLimit := range rawLimits {
+		item, err := parseLimitRangeItem(rawLimit)
+		if err != nil {
+			return "", nil, false, newParameterError(limiTs, err, language.ErrorParameterLimitItem, i, err)
+		}
+		limits = append(limits, item)
+	}
+
+	overwrite, err := getOptionalParamAsBool(parameters, overwritE, false)
+	if err != nil {
+		return "", nil, false, err
+	}
+
+	return limitRangeName, limits, overwrite, nil
+}
+
+// parseLimitRangeItem converts a single decoded 'limits' entry into a corev1.LimitRangeItem.
+// The entry must declare a type of 'Container' or 'Pod' and may provide 'default',
+// 'defaultRequest', 'max', and 'min' maps of resource quantities.
+func parseLimitRangeItem(rawLimit interface{}) (corev1.LimitRangeItem, error) {
+	entry, ok := toStringInterfaceMap(rawLimit)
+	if !ok {
+		return corev1.LimitRangeItem{}, newParameterError(limiTs, nil, language.ErrorParameterInvalid, limiTs)
+	}
+
+	limitType, err := getParamAsString(entry, limitTypE)
+	if err != nil {
+		return corev1.LimitRangeItem{}, err
+	}
+	switch corev1.LimitType(limitType) {
+	case corev1.LimitTypeContainer, corev1.LimitTypePod:
+	default:
+		return corev1.LimitRangeItem{}, fmt.Errorf(language.ErrorInvalidLimitType, limitType)
+	}
+
+	item := corev1.LimitRangeItem{Type: corev1.LimitType(limitType)}
+	targets := []struct {
+		key  string
+		list *corev1.ResourceList
+	}{
+		{defaulT, &item.Default},
+		{defaultRequesT, &item.DefaultRequest},
+		{maX, &item.Max},
+		{miN, &item.Min},
+	}
+	for _, target := range targets {
+		if _, exists := entry[target.key]; !exists {
+			continue
+		}
+		list, err := parseResourceList(entry, target.key)
+		if err != nil {
+			return corev1.LimitRangeItem{}, err
+		}
+		*target.list = list
+	}
+
+	return item, nil
+}
+
+// parseResourceList reads a map of resource names to quantity strings from the parameters
+// and converts it into a corev1.ResourceList, validating each quantity.
+func parseResourceList(params map[string]interface{}, key string) (corev1.ResourceList, error) {
+	rawList, err := getParamAsStringMap(params, key)
+	if err != nil {
+		return nil, err
+	}
+	list := make(corev1.ResourceList, len(rawList))
+	for resourceName, quantityStr := range rawList {
+		quantity, err := resource.ParseQuantity(quantityStr)
+		if err != nil {
+			return nil, fmt.Errorf(language.ErrorInvalidQuantity, quantityStr, resourceName, err)
+		}
+		list[corev1.ResourceName(resourceName)] = quantity
+	}
+	return list, nil
+}
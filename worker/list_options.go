@@ -1,8 +1,6 @@
 package worker
 
 import (
-	"fmt"
-
 	"github.com/H0llyW00dzZ/K8sBlackPearl/language"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -20,17 +18,17 @@ import (
 func getListOptions(params map[string]interface{}) (v1.ListOptions, error) {
 	labelSelector, err := getParamAsString(params, labelSelector)
 	if err != nil {
-		return v1.ListOptions{}, fmt.Errorf(language.ErrorParamLabelSelector)
+		return v1.ListOptions{}, newParameterError(labelSelector, nil, language.ErrorParamLabelSelector)
 	}
 
 	fieldSelector, err := getParamAsString(params, fieldSelector)
 	if err != nil {
-		return v1.ListOptions{}, fmt.Errorf(language.ErrorParamFieldSelector)
+		return v1.ListOptions{}, newParameterError(fieldSelector, nil, language.ErrorParamFieldSelector)
 	}
 
 	limit, err := getParamAsInt64(params, limIt)
 	if err != nil {
-		return v1.ListOptions{}, fmt.Errorf(language.ErrorParamLimit)
+		return v1.ListOptions{}, newParameterError(limIt, nil, language.ErrorParamLimit)
 	}
 
 	listOptions := v1.ListOptions{
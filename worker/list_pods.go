@@ -13,12 +13,14 @@ import (
 // listPods retrieves a list of Pods from the specified namespace using the provided list options.
 // This function abstracts the Kubernetes API call to fetch Pods, simplifying the task runner's
 // main logic. The list options can include selectors to filter the Pods by labels, fields, and more.
+// When StartPodInformer has been called for the namespace, the Pods are read from its cache instead,
+// capped at the Limit of the list options.
 //
 // Parameters:
 //
 //	ctx context.Context: A context.Context object, which governs the lifetime of the request to the Kubernetes API.
 //	  It can be used to cancel the request, set deadlines, or pass request-scoped values.
-//	clientset *kubernetes.Clientset: A *kubernetes.Clientset that provides access to the Kubernetes API.
+//	clientset kubernetes.Interface: A kubernetes.Interface that provides access to the Kubernetes API.
 //	namespace string: A string specifying the namespace from which to list the Pods. Namespaces are a way to divide cluster resources.
 //	listOptions v1.ListOptions: A v1.ListOptions struct that defines the conditions and limits for the API query, such as label and field selectors.
 //
@@ -26,10 +28,101 @@ import (
 //
 //	*corev1.PodList: A pointer to a corev1.PodList containing the Pods that match the list options, along with metadata about the list.
 //	error: An error if the call to the Kubernetes API fails, otherwise nil.
-func listPods(ctx context.Context, clientset *kubernetes.Clientset, namespace string, listOptions v1.ListOptions) (*corev1.PodList, error) {
+func listPods(ctx context.Context, clientset kubernetes.Interface, namespace string, listOptions v1.ListOptions) (*corev1.PodList, error) {
+	if pods, cached := listPodsFromCache(namespace, listOptions); cached {
+		if listOptions.Limit > 0 && int64(len(pods.Items)) > listOptions.Limit {
+			pods.Items = pods.Items[:listOptions.Limit]
+		}
+		return pods, nil
+	}
 	pods, err := clientset.CoreV1().Pods(namespace).List(ctx, listOptions)
 	if err != nil { // This is a more idiomatic way of handling errors
 		return nil, fmt.Errorf("%w: %s", err, language.ErrorFailedtoListPods)
 	}
 	return pods, nil
 }
+
+// listAllPods retrieves Pods from the specified namespace page by page, following the Continue
+// token returned by the API server until every page has been read. The Limit of the provided list
+// options is used as the page size, and maxItems, when greater than zero, caps the overall number
+// of Pods returned across all pages. When StartPodInformer has been called for the namespace, every
+// Pod is read from its cache at once instead.
+//
+// Parameters:
+//
+//	ctx context.Context: A context.Context object, which governs the lifetime of the requests to the Kubernetes API.
+//	clientset kubernetes.Interface: A kubernetes.Interface that provides access to the Kubernetes API.
+//	namespace string: A string specifying the namespace from which to list the Pods.
+//	listOptions v1.ListOptions: A v1.ListOptions struct with the selectors and page size for each request.
+//	maxItems int64: The overall cap on the number of Pods returned, or zero for no cap.
+//
+// Returns:
+//
+//	*corev1.PodList: A pointer to a corev1.PodList containing the Pods gathered from every page.
+//	error: An error if any call to the Kubernetes API fails, otherwise nil.
+func listAllPods(ctx context.Context, clientset kubernetes.Interface, namespace string, listOptions v1.ListOptions, maxItems int64) (*corev1.PodList, error) {
+	if pods, cached := listPodsFromCache(namespace, listOptions); cached {
+		if maxItems > 0 && int64(len(pods.Items)) > maxItems {
+			pods.Items = pods.Items[:maxItems]
+		}
+		return pods, nil
+	}
+
+	allPods := &corev1.PodList{}
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		page, err := listPods(ctx, clientset, namespace, listOptions)
+		if err != nil {
+			return nil, err
+		}
+		allPods.Items = append(allPods.Items, page.Items...)
+		allPods.ResourceVersion = page.ResourceVersion
+
+		if maxItems > 0 && int64(len(allPods.Items)) >= maxItems {
+			allPods.Items = allPods.Items[:maxItems]
+			return allPods, nil
+		}
+		if page.Continue == "" {
+			return allPods, nil
+		}
+		listOptions.Continue = page.Continue
+	}
+}
+
+// listPodsFromParameters lists Pods according to the task parameters. When the 'paginate' parameter
+// is true, every page is retrieved using listAllPods, with the optional 'maxItems' parameter capping
+// the total. Otherwise a single page is retrieved, preserving the original behavior.
+//
+// Parameters:
+//
+//	ctx context.Context: A context.Context object, which governs the lifetime of the requests to the Kubernetes API.
+//	clientset kubernetes.Interface: A kubernetes.Interface that provides access to the Kubernetes API.
+//	namespace string: A string specifying the namespace from which to list the Pods.
+//	listOptions v1.ListOptions: A v1.ListOptions struct built from the task parameters.
+//	parameters map[string]interface{}: The task parameters holding the pagination settings.
+//
+// Returns:
+//
+//	*corev1.PodList: A pointer to a corev1.PodList containing the listed Pods.
+//	error: An error if the parameters are invalid or the Kubernetes API call fails.
+func listPodsFromParameters(ctx context.Context, clientset kubernetes.Interface, namespace string, listOptions v1.ListOptions, parameters map[string]interface{}) (*corev1.PodList, error) {
+	paginate, err := getOptionalParamAsBool(parameters, paginatE, false)
+	if err != nil {
+		return nil, err
+	}
+	if !paginate {
+		return listPods(ctx, clientset, namespace, listOptions)
+	}
+
+	var maxItems int64
+	if _, exists := parameters[maxItemS]; exists {
+		maxItems, err = getParamAsInt64(parameters, maxItemS)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return listAllPods(ctx, clientset, namespace, listOptions, maxItems)
+}
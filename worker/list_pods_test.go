@@ -0,0 +1,54 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+// newPagedPodClientset returns a clientset whose pod lists are served as the given number of pages
+// of two pods each, linked by Continue tokens. The fake clientset ignores Limit and Continue, so the
+// reactor hands out the pages in order.
+func newPagedPodClientset(pages int) (*fake.Clientset, *int) {
+	clientset := fake.NewSimpleClientset()
+	served := 0
+	clientset.PrependReactor("list", "pods", func(k8stesting.Action) (bool, runtime.Object, error) {
+		page := &corev1.PodList{}
+		for i := 0; i < 2; i++ {
+			page.Items = append(page.Items, corev1.Pod{ObjectMeta: v1.ObjectMeta{Name: fmt.Sprintf("pod-%d-%d", served, i), Namespace: "default"}})
+		}
+		served++
+		if served < pages {
+			page.Continue = fmt.Sprintf("page-%d", served)
+		}
+		return true, page, nil
+	})
+	return clientset, &served
+}
+
+func TestListPodsFromParametersPaginates(t *testing.T) {
+	for name, tc := range map[string]struct {
+		parameters map[string]interface{}
+		wantPods   int
+		wantPages  int
+	}{
+		"single page":    {map[string]interface{}{}, 2, 1},
+		"every page":     {map[string]interface{}{"paginate": true}, 6, 3},
+		"capped overall": {map[string]interface{}{"paginate": true, "maxItems": 3}, 3, 2},
+	} {
+		clientset, served := newPagedPodClientset(3)
+		pods, err := listPodsFromParameters(context.Background(), clientset, "default", v1.ListOptions{Limit: 2}, tc.parameters)
+		if err != nil {
+			t.Fatalf("%s: listPodsFromParameters: %v", name, err)
+		}
+		if len(pods.Items) != tc.wantPods || *served != tc.wantPages {
+			t.Fatalf("%s: got %d pods from %d pages, want %d pods from %d pages", name, len(pods.Items), *served, tc.wantPods, tc.wantPages)
+		}
+	}
+}
@@ -3,6 +3,7 @@ package worker
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/H0llyW00dzZ/K8sBlackPearl/language"
 	"github.com/H0llyW00dzZ/K8sBlackPearl/navigator"
@@ -40,6 +41,13 @@ func logPod(baseFields []zap.Field, pod *corev1.Pod) {
 	navigator.LogInfoWithEmoji(language.PirateEmoji, fmt.Sprintf(language.ProcessingPods, pod.Name), podFields...)
 }
 
+// podHealthResult is the outcome of the health check of a single pod.
+type podHealthResult struct {
+	podName string
+	message string
+	healthy bool
+}
+
 // checkPodsHealth initiates concurrent health checks for all pods in the provided list.
 // It returns a channel that communicates each pod's health status back to the caller,
 // allowing for asynchronous processing of the results.
@@ -51,9 +59,9 @@ func logPod(baseFields []zap.Field, pod *corev1.Pod) {
 //
 // Returns:
 //
-//	chan string: A channel of strings, where each string represents a pods health status message.
-func (c *CrewProcessCheckHealthTask) checkPodsHealth(ctx context.Context, podList *corev1.PodList) chan string {
-	results := make(chan string, len(podList.Items))
+//	chan podHealthResult: A channel of results, each carrying a pod's health status message.
+func (c *CrewProcessCheckHealthTask) checkPodsHealth(ctx context.Context, podList *corev1.PodList) chan podHealthResult {
+	results := make(chan podHealthResult, len(podList.Items))
 	go c.checkHealthWorker(ctx, podList, results)
 	return results
 }
@@ -61,52 +69,87 @@ func (c *CrewProcessCheckHealthTask) checkPodsHealth(ctx context.Context, podLis
 // checkHealthWorker is responsible for conducting health checks on each pod in the list.
 // It reports each pod's health status back to the caller via the provided results channel.
 // This function is designed to run as a goroutine, allowing multiple pods to be checked
-// concurrently for efficiency.
+// concurrently for efficiency. Every send also watches the context, so the goroutine exits
+// instead of blocking when the reader has returned early because of a cancellation.
 //
 // Parameters:
 //
 //	ctx context.Context: A context.Context to allow for cancellation of the health checks.
 //	podList *corev1.PodList: A pointer to a corev1.PodList containing the pods to be checked.
-//	results chan<- string: A channel for sending back health status messages.
-func (c *CrewProcessCheckHealthTask) checkHealthWorker(ctx context.Context, podList *corev1.PodList, results chan<- string) {
+//	results chan<- podHealthResult: A channel for sending back health status results.
+func (c *CrewProcessCheckHealthTask) checkHealthWorker(ctx context.Context, podList *corev1.PodList, results chan<- podHealthResult) {
 	defer close(results)
 	for _, pod := range podList.Items {
-		if ctx.Err() != nil {
-			return
-		}
+		healthy := CrewCheckingisPodHealthy(&pod)
 		healthStatus := language.NotHealthyStatus
-		if CrewCheckingisPodHealthy(&pod) {
+		if healthy {
 			healthStatus = language.HealthyStatus
 		}
-		statusMsg := fmt.Sprintf(language.PodAndStatusAndHealth, pod.Name, pod.Status.Phase, healthStatus)
-		results <- statusMsg
+		result := podHealthResult{
+			podName: pod.Name,
+			message: fmt.Sprintf(language.PodAndStatusAndHealth, pod.Name, pod.Status.Phase, healthStatus),
+			healthy: healthy,
+		}
+		// The reader stops on cancellation, so the send must not block once the context is done.
+		select {
+		case results <- result:
+		case <-ctx.Done():
+			return
+		}
 	}
 }
 
-// logResults continuously listens for health status messages on the results channel
-// and logs them. The function will keep logging until there are no more messages to
-// process or until the context is cancelled, whichever comes first. This function
+// logResults continuously listens for health status results on the results channel
+// and logs them. The function will keep logging until there are no more results to
+// process or until the context is cancelled, whichever comes first, and then logs a summary
+// of how many pods were checked and how many were unhealthy. This function
 // effectively decouples the logging of results from the health checking process.
 //
+// The health check is informational, so a cancellation is not a failure by itself: the summary
+// of the pods checked so far is logged as partial and nil is returned. When failIfUnhealthy is set,
+// any unhealthy pod fails the task instead, and so does a cancellation, since the pods that were not
+// checked cannot be vouched for. An unhealthy pod is not marked as non-retriable, so retries give
+// the pods time to recover.
+//
 // Parameters:
 //
 //	ctx context.Context: A context.Context to allow for cancellation of the logging process.
-//	results chan string: A channel from which to read health status messages.
+//	results chan podHealthResult: A channel from which to read health status results.
+//	total int: The number of pods being checked.
+//	failIfUnhealthy bool: Whether unhealthy pods, or an incomplete check, should fail the task.
 //
 // Returns:
 //
-//	error: An error if the context is cancelled, signaling incomplete logging.
-func (c *CrewProcessCheckHealthTask) logResults(ctx context.Context, results chan string) error {
+//	error: An error if failIfUnhealthy is set and a pod is unhealthy or the check was cancelled.
+func (c *CrewProcessCheckHealthTask) logResults(ctx context.Context, results chan podHealthResult, total int, failIfUnhealthy bool) error {
+	checked := 0
+	var unhealthy []string
 	for {
 		select {
 		case <-ctx.Done():
-			navigator.LogErrorWithEmojiRateLimited(language.PirateEmoji, language.ErrorPodsCancelled, zap.Error(ctx.Err()))
-			return ctx.Err()
+			navigator.LogErrorWithEmojiRateLimited(language.PirateEmoji, language.ErrorPodsCancelled, zap.Error(contextError(ctx)))
+			navigator.LogInfoWithEmoji(language.WarningEmoji, fmt.Sprintf(language.HealthCheckPartial, checked, total, len(unhealthy)))
+			if !failIfUnhealthy {
+				return nil
+			}
+			if len(unhealthy) == 0 {
+				return ctx.Err()
+			}
+			return fmt.Errorf(language.ErrorPodsUnhealthy, len(unhealthy), checked, strings.Join(unhealthy, ", "))
 		case result, ok := <-results:
 			if !ok {
-				return nil // Channel closed, all results processed.
+				// Channel closed, all results processed.
+				navigator.LogInfoWithEmoji(language.PirateEmoji, fmt.Sprintf(language.HealthCheckSummary, checked, len(unhealthy)))
+				if failIfUnhealthy && len(unhealthy) > 0 {
+					return fmt.Errorf(language.ErrorPodsUnhealthy, len(unhealthy), checked, strings.Join(unhealthy, ", "))
+				}
+				return nil
+			}
+			checked++
+			if !result.healthy {
+				unhealthy = append(unhealthy, result.podName)
 			}
-			navigator.LogInfoWithEmoji(language.PirateEmoji, result)
+			navigator.LogInfoWithEmoji(language.PirateEmoji, result.message)
 		}
 	}
 }
@@ -0,0 +1,99 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/H0llyW00dzZ/K8sBlackPearl/navigator"
+
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestCheckHealthWorkerExitsWhenTheReaderIsCancelled(t *testing.T) {
+	podList := &corev1.PodList{}
+	for i := 0; i < 10; i++ {
+		podList.Items = append(podList.Items, corev1.Pod{
+			ObjectMeta: v1.ObjectMeta{Name: fmt.Sprintf("pod-%d", i)},
+			Status:     corev1.PodStatus{Phase: corev1.PodRunning},
+		})
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	// An unbuffered channel makes every send wait for the reader.
+	results := make(chan podHealthResult)
+	exited := make(chan struct{})
+	go func() {
+		defer close(exited)
+		(&CrewProcessCheckHealthTask{}).checkHealthWorker(ctx, podList, results)
+	}()
+
+	if result := <-results; result.podName != "pod-0" {
+		t.Fatalf("got the result of %q first, want pod-0", result.podName)
+	}
+	// The reader stops mid-stream, as logResults does on cancellation.
+	cancel()
+
+	select {
+	case <-exited:
+	case <-time.After(5 * time.Second):
+		t.Fatal("the health check goroutine is still blocked on sending after the cancellation")
+	}
+}
+
+func TestHealthCheckFailsOnUnhealthyPodsOnlyWhenAsked(t *testing.T) {
+	for _, failIfUnhealthy := range []bool{false, true} {
+		results := make(chan podHealthResult, 2)
+		results <- podHealthResult{podName: "web", healthy: true}
+		results <- podHealthResult{podName: "db", healthy: false}
+		close(results)
+
+		err := (&CrewProcessCheckHealthTask{}).logResults(context.Background(), results, 2, failIfUnhealthy)
+		if !failIfUnhealthy && err != nil {
+			t.Fatalf("got error %v, want the informational check to succeed", err)
+		}
+		if failIfUnhealthy && (err == nil || err.Error() != "1 of 2 checked pod(s) are unhealthy: db") {
+			t.Fatalf("got error %v, want the unhealthy pod to fail the task", err)
+		}
+	}
+}
+
+func TestHealthCheckReportsAPartialSummaryWhenCancelled(t *testing.T) {
+	navigator.SetEmojiEnabled(false)
+	t.Cleanup(func() { navigator.SetEmojiEnabled(true) })
+	for _, tc := range []struct {
+		failIfUnhealthy bool
+		healthy         bool
+		wantErr         error
+	}{
+		{failIfUnhealthy: false, healthy: false, wantErr: nil},
+		{failIfUnhealthy: true, healthy: true, wantErr: context.Canceled},
+	} {
+		logs := observeLogs(t)
+		ctx, cancel := context.WithCancel(context.Background())
+		// An unbuffered channel that is never closed leaves the cancellation as the only way out.
+		results := make(chan podHealthResult)
+		go func() {
+			results <- podHealthResult{podName: "web", healthy: tc.healthy}
+			cancel()
+		}()
+
+		err := (&CrewProcessCheckHealthTask{}).logResults(ctx, results, 3, tc.failIfUnhealthy)
+		cancel()
+		if !errors.Is(err, tc.wantErr) || (tc.wantErr == nil && err != nil) {
+			t.Fatalf("failIfUnhealthy %v: got error %v, want %v", tc.failIfUnhealthy, err, tc.wantErr)
+		}
+		unhealthy := 0
+		if !tc.healthy {
+			unhealthy = 1
+		}
+		want := fmt.Sprintf("Health check cancelled: 1 of 3 pod(s) checked, %d unhealthy", unhealthy)
+		if partial := logs.FilterMessageSnippet("Health check cancelled").All(); len(partial) != 1 || !strings.HasPrefix(partial[0].Message, want) {
+			t.Fatalf("failIfUnhealthy %v: got partial summaries %v, want %q", tc.failIfUnhealthy, partial, want)
+		}
+	}
+}
@@ -0,0 +1,16 @@
+package worker
+
+import (
+	"os"
+	"testing"
+
+	"github.com/H0llyW00dzZ/K8sBlackPearl/navigator"
+	"go.uber.org/zap"
+)
+
+// TestMain installs a no-op logger, so runners under test log quietly instead of printing that no
+// logger is set.
+func TestMain(m *testing.M) {
+	navigator.SetLogger(zap.NewNop())
+	os.Exit(m.Run())
+}
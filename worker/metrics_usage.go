@@ -0,0 +1,152 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/H0llyW00dzZ/K8sBlackPearl/language"
+	"github.com/H0llyW00dzZ/K8sBlackPearl/navigator"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/dynamic"
+	metricsv1beta1 "k8s.io/metrics/pkg/apis/metrics/v1beta1"
+)
+
+var (
+	// podMetricsResource identifies the pod metrics served by the metrics.k8s.io API.
+	podMetricsResource = metricsv1beta1.SchemeGroupVersion.WithResource("pods")
+
+	// nodeMetricsResource identifies the node metrics served by the metrics.k8s.io API.
+	nodeMetricsResource = metricsv1beta1.SchemeGroupVersion.WithResource("nodes")
+)
+
+// listPodMetrics retrieves the current pod usage from the metrics.k8s.io API for the given namespace,
+// filtered by the label selector. When the metrics API is not served by the cluster (for example,
+// metrics-server is not installed), a non-retriable error is returned.
+//
+// Parameters:
+//
+//	ctx context.Context: Context for cancellation and timeout.
+//	dynamicClient dynamic.Interface: A dynamic client used to query the metrics API.
+//	namespace string: The namespace whose pod metrics should be listed.
+//	labelSelector string: An optional label selector to filter pods.
+//
+// Returns:
+//
+//	[]metricsv1beta1.PodMetrics: The usage reported for each matching pod.
+//	error: An error if the metrics cannot be retrieved.
+func listPodMetrics(ctx context.Context, dynamicClient dynamic.Interface, namespace, labelSelector string) ([]metricsv1beta1.PodMetrics, error) {
+	list, err := dynamicClient.Resource(podMetricsResource).Namespace(namespace).List(ctx, v1.ListOptions{LabelSelector: labelSelector})
+	if err != nil {
+		return nil, wrapMetricsError(err)
+	}
+
+	var podMetrics metricsv1beta1.PodMetricsList
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(list.UnstructuredContent(), &podMetrics); err != nil {
+		return nil, err
+	}
+	return podMetrics.Items, nil
+}
+
+// listNodeMetrics retrieves the current node usage from the metrics.k8s.io API, filtered by the
+// label selector. When the metrics API is not served by the cluster, a non-retriable error is returned.
+//
+// Parameters:
+//
+//	ctx context.Context: Context for cancellation and timeout.
+//	dynamicClient dynamic.Interface: A dynamic client used to query the metrics API.
+//	labelSelector string: An optional label selector to filter nodes.
+//
+// Returns:
+//
+//	[]metricsv1beta1.NodeMetrics: The usage reported for each matching node.
+//	error: An error if the metrics cannot be retrieved.
+func listNodeMetrics(ctx context.Context, dynamicClient dynamic.Interface, labelSelector string) ([]metricsv1beta1.NodeMetrics, error) {
+	list, err := dynamicClient.Resource(nodeMetricsResource).List(ctx, v1.ListOptions{LabelSelector: labelSelector})
+	if err != nil {
+		return nil, wrapMetricsError(err)
+	}
+
+	var nodeMetrics metricsv1beta1.NodeMetricsList
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(list.UnstructuredContent(), &nodeMetrics); err != nil {
+		return nil, err
+	}
+	return nodeMetrics.Items, nil
+}
+
+// wrapMetricsError converts the errors returned when the metrics API is missing into a clear,
+// non-retriable error. Any other error is returned unchanged so it can be retried.
+//
+// This unexported function is used internally by listPodMetrics and listNodeMetrics.
+func wrapMetricsError(err error) error {
+	if apierrors.IsNotFound(err) || meta.IsNoMatchError(err) {
+		return markNonRetriable(fmt.Errorf(language.ErrorMetricsAPIUnavailable, metricsv1beta1.SchemeGroupVersion.String(), err))
+	}
+	return err
+}
+
+// reportPodMetrics sends one usage line per pod through the results channel and logs the usage
+// of every pod and its containers with structured fields.
+//
+// Parameters:
+//
+//	baseFields []zap.Field: A slice of zap.Field structs providing contextual logging information.
+//	podMetrics []metricsv1beta1.PodMetrics: The pod usage to report.
+//	results chan<- string: A channel with room for one message per pod.
+func reportPodMetrics(baseFields []zap.Field, podMetrics []metricsv1beta1.PodMetrics, results chan<- string) {
+	for _, pod := range podMetrics {
+		totalCPU, totalMemory := sumContainerUsage(pod.Containers)
+		usageMsg := fmt.Sprintf(language.PodUsage, pod.Name, totalCPU.String(), totalMemory.String())
+		results <- usageMsg
+
+		podFields := append([]zap.Field(nil), baseFields...)
+		podFields = append(podFields,
+			zap.String(language.PodsName, pod.Name),
+			zap.String(language.CPUUsage, totalCPU.String()),
+			zap.String(language.MemoryUsage, totalMemory.String()),
+			zap.Int(language.ContainerCount, len(pod.Containers)),
+		)
+		navigator.LogInfoWithEmoji(language.PirateEmoji, usageMsg, podFields...)
+	}
+}
+
+// reportNodeMetrics sends one usage line per node through the results channel and logs the usage
+// of every node with structured fields.
+//
+// Parameters:
+//
+//	baseFields []zap.Field: A slice of zap.Field structs providing contextual logging information.
+//	nodeMetrics []metricsv1beta1.NodeMetrics: The node usage to report.
+//	results chan<- string: A channel with room for one message per node.
+func reportNodeMetrics(baseFields []zap.Field, nodeMetrics []metricsv1beta1.NodeMetrics, results chan<- string) {
+	for _, node := range nodeMetrics {
+		cpu := node.Usage[corev1.ResourceCPU]
+		memory := node.Usage[corev1.ResourceMemory]
+		usageMsg := fmt.Sprintf(language.NodeUsage, node.Name, cpu.String(), memory.String())
+		results <- usageMsg
+
+		nodeFields := append([]zap.Field(nil), baseFields...)
+		nodeFields = append(nodeFields,
+			zap.String(language.NodeName, node.Name),
+			zap.String(language.CPUUsage, cpu.String()),
+			zap.String(language.MemoryUsage, memory.String()),
+		)
+		navigator.LogInfoWithEmoji(language.PirateEmoji, usageMsg, nodeFields...)
+	}
+}
+
+// sumContainerUsage adds up the CPU and memory usage of all containers in a pod.
+//
+// This unexported function is used internally by reportPodMetrics.
+func sumContainerUsage(containers []metricsv1beta1.ContainerMetrics) (totalCPU, totalMemory resource.Quantity) {
+	for _, container := range containers {
+		totalCPU.Add(container.Usage[corev1.ResourceCPU])
+		totalMemory.Add(container.Usage[corev1.ResourceMemory])
+	}
+	return totalCPU, totalMemory
+}
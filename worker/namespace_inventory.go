@@ -0,0 +1,189 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/H0llyW00dzZ/K8sBlackPearl/language"
+	"github.com/H0llyW00dzZ/K8sBlackPearl/navigator"
+	"github.com/H0llyW00dzZ/go-urlshortner/logmonitor/constant"
+	"go.uber.org/zap"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// inventoryEntry is the tally of a single resource type in a namespace. When the resource could not
+// be listed, err is set and the counts are empty.
+type inventoryEntry struct {
+	resource  string
+	count     int
+	breakdown map[string]int // Counts per phase, for resources that have one.
+	err       error
+}
+
+// inventoryLister counts the objects of a single resource type in a namespace.
+type inventoryLister struct {
+	resource string
+	count    func(ctx context.Context, clientset kubernetes.Interface, namespace string) (int, map[string]int, error)
+}
+
+// namespaceInventoryListers are the resource types tallied by collectNamespaceInventory, in report order.
+var namespaceInventoryListers = []inventoryLister{
+	{"deployments", func(ctx context.Context, clientset kubernetes.Interface, namespace string) (int, map[string]int, error) {
+		list, err := clientset.AppsV1().Deployments(namespace).List(ctx, v1.ListOptions{})
+		if err != nil {
+			return 0, nil, err
+		}
+		return len(list.Items), nil, nil
+	}},
+	{"statefulsets", func(ctx context.Context, clientset kubernetes.Interface, namespace string) (int, map[string]int, error) {
+		list, err := clientset.AppsV1().StatefulSets(namespace).List(ctx, v1.ListOptions{})
+		if err != nil {
+			return 0, nil, err
+		}
+		return len(list.Items), nil, nil
+	}},
+	{"pods", func(ctx context.Context, clientset kubernetes.Interface, namespace string) (int, map[string]int, error) {
+		list, err := listAllPods(ctx, clientset, namespace, v1.ListOptions{Limit: defaultPageSize}, 0)
+		if err != nil {
+			return 0, nil, err
+		}
+		phases := make(map[string]int)
+		for _, pod := range list.Items {
+			phases[string(pod.Status.Phase)]++
+		}
+		return len(list.Items), phases, nil
+	}},
+	{"services", func(ctx context.Context, clientset kubernetes.Interface, namespace string) (int, map[string]int, error) {
+		list, err := clientset.CoreV1().Services(namespace).List(ctx, v1.ListOptions{})
+		if err != nil {
+			return 0, nil, err
+		}
+		return len(list.Items), nil, nil
+	}},
+	{"configmaps", func(ctx context.Context, clientset kubernetes.Interface, namespace string) (int, map[string]int, error) {
+		list, err := clientset.CoreV1().ConfigMaps(namespace).List(ctx, v1.ListOptions{})
+		if err != nil {
+			return 0, nil, err
+		}
+		return len(list.Items), nil, nil
+	}},
+	{"secrets", func(ctx context.Context, clientset kubernetes.Interface, namespace string) (int, map[string]int, error) {
+		// Secrets are only counted; nothing about their contents is reported.
+		list, err := clientset.CoreV1().Secrets(namespace).List(ctx, v1.ListOptions{})
+		if err != nil {
+			return 0, nil, err
+		}
+		return len(list.Items), nil, nil
+	}},
+	{"persistentvolumeclaims", func(ctx context.Context, clientset kubernetes.Interface, namespace string) (int, map[string]int, error) {
+		list, err := clientset.CoreV1().PersistentVolumeClaims(namespace).List(ctx, v1.ListOptions{})
+		if err != nil {
+			return 0, nil, err
+		}
+		phases := make(map[string]int)
+		for _, claim := range list.Items {
+			phases[string(claim.Status.Phase)]++
+		}
+		return len(list.Items), phases, nil
+	}},
+	{"networkpolicies", func(ctx context.Context, clientset kubernetes.Interface, namespace string) (int, map[string]int, error) {
+		list, err := clientset.NetworkingV1().NetworkPolicies(namespace).List(ctx, v1.ListOptions{})
+		if err != nil {
+			return 0, nil, err
+		}
+		return len(list.Items), nil, nil
+	}},
+}
+
+// collectNamespaceInventory tallies the key resources of a namespace. Each resource type is listed
+// independently, so a failure to list one type (for example, because of missing RBAC permissions)
+// is recorded on its entry and does not prevent the others from being counted.
+//
+// Parameters:
+//
+//	ctx context.Context: Context for cancellation and timeout.
+//	clientset kubernetes.Interface: A Kubernetes clientset for interacting with the Kubernetes API.
+//	namespace string: The namespace to inventory.
+//
+// Returns:
+//
+//	[]inventoryEntry: One entry per resource type, in the order of namespaceInventoryListers.
+//	error: The context error if the context is cancelled before every resource type is listed.
+func collectNamespaceInventory(ctx context.Context, clientset kubernetes.Interface, namespace string) ([]inventoryEntry, error) {
+	entries := make([]inventoryEntry, 0, len(namespaceInventoryListers))
+	for _, lister := range namespaceInventoryListers {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		count, breakdown, err := lister.count(ctx, clientset, namespace)
+		entries = append(entries, inventoryEntry{resource: lister.resource, count: count, breakdown: breakdown, err: err})
+	}
+	return entries, nil
+}
+
+// reportNamespaceInventory sends one line per resource type through the results channel and logs the
+// same information with structured fields. Resource types that could not be listed are reported as such.
+//
+// Parameters:
+//
+//	ctx context.Context: Context for cancellation.
+//	baseFields []zap.Field: A slice of zap.Field structs providing contextual logging information.
+//	entries []inventoryEntry: The tallies to report.
+//	results chan<- string: A channel with room for one message per entry.
+//
+// Returns an error if the context is cancelled, or if no resource type could be listed at all.
+func reportNamespaceInventory(ctx context.Context, baseFields []zap.Field, entries []inventoryEntry, results chan<- string) error {
+	var unreadable []string
+	for _, entry := range entries {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		entryFields := append([]zap.Field(nil), baseFields...)
+		entryFields = append(entryFields, zap.String(language.InventoryResource, entry.resource))
+		if entry.err != nil {
+			message := fmt.Sprintf(language.ErrorInventoryResourceUnreadable, entry.resource, entry.err)
+			results <- message
+			navigator.LogErrorWithEmojiRateLimited(constant.ErrorEmoji, message, append(entryFields, zap.Error(entry.err))...)
+			unreadable = append(unreadable, entry.resource)
+			continue
+		}
+
+		message := fmt.Sprintf(language.InventoryResourceCount, entry.resource, entry.count)
+		if len(entry.breakdown) > 0 {
+			message = fmt.Sprintf(language.InventoryResourceCountByPhase, entry.resource, entry.count, formatPhaseCounts(entry.breakdown))
+		}
+		results <- message
+
+		entryFields = append(entryFields, zap.Int(language.InventoryCount, entry.count))
+		if len(entry.breakdown) > 0 {
+			entryFields = append(entryFields, zap.Any(language.InventoryByPhase, entry.breakdown))
+		}
+		navigator.LogInfoWithEmoji(language.PirateEmoji, message, entryFields...)
+	}
+
+	if len(unreadable) == len(entries) && len(entries) > 0 {
+		return fmt.Errorf(language.ErrorInventoryUnavailable, strings.Join(unreadable, ", "))
+	}
+	return nil
+}
+
+// formatPhaseCounts renders phase counts as "Pending=1, Running=3", sorted by phase.
+//
+// This unexported function is used internally by reportNamespaceInventory.
+func formatPhaseCounts(counts map[string]int) string {
+	phases := make([]string, 0, len(counts))
+	for phase := range counts {
+		phases = append(phases, phase)
+	}
+	sort.Strings(phases)
+
+	parts := make([]string, 0, len(phases))
+	for _, phase := range phases {
+		parts = append(parts, fmt.Sprintf("%s=%d", phase, counts[phase]))
+	}
+	return strings.Join(parts, ", ")
+}
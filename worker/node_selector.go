@@ -0,0 +1,101 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/H0llyW00dzZ/K8sBlackPearl/language"
+	"github.com/H0llyW00dzZ/K8sBlackPearl/navigator"
+	"github.com/H0llyW00dzZ/go-urlshortner/logmonitor/constant"
+	"go.uber.org/zap"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/util/retry"
+)
+
+// SetDeploymentNodeSelector sets the node selector of a deployment's pod template, which pins its
+// pods to matching nodes and triggers a rollout. By default the given entries are merged into the
+// existing selector; with replace set, the selector is replaced as a whole, so an empty nodeSelector
+// clears it. The update is retried on conflicts with a fresh read.
+//
+// Parameters:
+//
+//	ctx context.Context: Context for cancellation and timeout.
+//	clientset kubernetes.Interface: A Kubernetes clientset for interacting with the Kubernetes API.
+//	namespace string: The namespace of the deployment.
+//	deploymentName string: The name of the deployment to update.
+//	nodeSelector map[string]string: The node labels the pods must match.
+//	replace bool: True to replace the existing selector instead of merging into it.
+//	results chan<- string: A channel to send operation results for logging.
+//	logger *zap.Logger: A logger for structured logging.
+//
+// Returns an error if the deployment cannot be read or updated.
+func SetDeploymentNodeSelector(ctx context.Context, clientset kubernetes.Interface, namespace, deploymentName string, nodeSelector map[string]string, replace bool, results chan<- string, logger *zap.Logger) error {
+	var applied map[string]string
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		deployment, err := clientset.AppsV1().Deployments(namespace).Get(ctx, deploymentName, v1.GetOptions{})
+		if err != nil {
+			return fmt.Errorf(language.FailedToGetDeployment, deploymentName, err)
+		}
+
+		podSpec := &deployment.Spec.Template.Spec
+		if replace || podSpec.NodeSelector == nil {
+			podSpec.NodeSelector = make(map[string]string, len(nodeSelector))
+		}
+		for key, value := range nodeSelector {
+			podSpec.NodeSelector[key] = value
+		}
+		if len(podSpec.NodeSelector) == 0 {
+			podSpec.NodeSelector = nil
+		}
+		applied = podSpec.NodeSelector
+
+		_, err = clientset.AppsV1().Deployments(namespace).Update(ctx, deployment, v1.UpdateOptions{})
+		return err
+	})
+	if err != nil {
+		errorMessage := fmt.Sprintf(language.ErrorFailedToSetNodeSelector, deploymentName, err)
+		results <- errorMessage
+		navigator.LogErrorWithEmojiRateLimited(constant.ErrorEmoji, errorMessage, zap.Error(err))
+		return err
+	}
+
+	successMsg := fmt.Sprintf(language.DeploymentNodeSelectorSet, deploymentName, labels.Set(applied).String())
+	if len(applied) == 0 {
+		successMsg = fmt.Sprintf(language.DeploymentNodeSelectorCleared, deploymentName)
+	}
+	results <- successMsg
+	navigator.LogInfoWithEmoji(constant.SuccessEmoji, successMsg)
+	return nil
+}
+
+// extractNodeSelectorParameters extracts and validates the 'deploymentName', 'nodeSelector', and
+// optional 'replace' parameters. 'nodeSelector' must be a map of string values; it may only be
+// empty or omitted when 'replace' is true, which clears the selector.
+//
+// This function is used by task runners that set deployment node selectors.
+func extractNodeSelectorParameters(parameters map[string]interface{}) (string, map[string]string, bool, error) {
+	deploymentName, err := extractDeploymentNameParameter(parameters)
+	if err != nil {
+		return "", nil, false, err
+	}
+
+	replace, err := getOptionalParamAsBool(parameters, replacE, false)
+	if err != nil {
+		return "", nil, false, err
+	}
+
+	var nodeSelector map[string]string
+	if _, exists := parameters[nodeSelectoR]; exists {
+		nodeSelector, err = getParamAsStringMap(parameters, nodeSelectoR)
+		if err != nil {
+			return "", nil, false, err
+		}
+	}
+	if len(nodeSelector) == 0 && !replace {
+		return "", nil, false, newParameterError(nodeSelectoR, nil, language.ErrorParameterMissing, nodeSelectoR)
+	}
+
+	return deploymentName, nodeSelector, replace, nil
+}
@@ -0,0 +1,72 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/H0llyW00dzZ/K8sBlackPearl/worker/configuration"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestCrewSetDeploymentNodeSelector(t *testing.T) {
+	for name, tc := range map[string]struct {
+		parameters map[string]interface{}
+		want       map[string]string
+	}{
+		"merges into the existing selector": {
+			parameters: map[string]interface{}{"deploymentName": "api", "nodeSelector": map[string]interface{}{"pool": "spot"}},
+			want:       map[string]string{"disktype": "ssd", "pool": "spot"},
+		},
+		"replaces the existing selector": {
+			parameters: map[string]interface{}{"deploymentName": "api", "nodeSelector": map[string]interface{}{"pool": "spot"}, "replace": true},
+			want:       map[string]string{"pool": "spot"},
+		},
+		"clears the selector": {
+			parameters: map[string]interface{}{"deploymentName": "api", "replace": true},
+		},
+	} {
+		clientset := fake.NewSimpleClientset(&appsv1.Deployment{
+			ObjectMeta: v1.ObjectMeta{Name: "api", Namespace: "default"},
+			Spec: appsv1.DeploymentSpec{Template: corev1.PodTemplateSpec{Spec: corev1.PodSpec{
+				NodeSelector: map[string]string{"disktype": "ssd"},
+			}}},
+		})
+		task := configuration.Task{Name: "pin", Type: "CrewSetDeploymentNodeSelector", Parameters: tc.parameters}
+
+		if err := (&CrewSetDeploymentNodeSelector{}).Run(context.Background(), clientset, "default", task, task.Parameters, 0); err != nil {
+			t.Fatalf("%s: Run: %v", name, err)
+		}
+
+		deployment, err := clientset.AppsV1().Deployments("default").Get(context.Background(), "api", v1.GetOptions{})
+		if err != nil {
+			t.Fatalf("%s: get deployment: %v", name, err)
+		}
+		if got := deployment.Spec.Template.Spec.NodeSelector; !reflect.DeepEqual(got, tc.want) {
+			t.Fatalf("%s: got node selector %v, want %v", name, got, tc.want)
+		}
+	}
+}
+
+func TestCrewSetDeploymentNodeSelectorRejectsInvalidParameters(t *testing.T) {
+	for name, parameters := range map[string]map[string]interface{}{
+		"non-string value":               {"deploymentName": "api", "nodeSelector": map[string]interface{}{"pool": 3}},
+		"empty selector without replace": {"deploymentName": "api", "nodeSelector": map[string]interface{}{}},
+		"missing deployment name":        {"nodeSelector": map[string]interface{}{"pool": "spot"}},
+	} {
+		clientset := newDeploymentClientset()
+		task := configuration.Task{Name: "pin", Type: "CrewSetDeploymentNodeSelector", Parameters: parameters}
+
+		err := (&CrewSetDeploymentNodeSelector{}).Run(context.Background(), clientset, "default", task, task.Parameters, 0)
+		if !errors.Is(err, ErrInvalidParameter) {
+			t.Fatalf("%s: got error %v, want an invalid parameter error", name, err)
+		}
+		if updates := countUpdates(clientset); updates != 0 {
+			t.Fatalf("%s: got %d updates, want none", name, updates)
+		}
+	}
+}
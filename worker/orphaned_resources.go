@@ -0,0 +1,322 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/H0llyW00dzZ/K8sBlackPearl/language"
+	"github.com/H0llyW00dzZ/K8sBlackPearl/navigator"
+	"github.com/H0llyW00dzZ/go-urlshortner/logmonitor/constant"
+	"go.uber.org/zap"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// rootCAConfigMap is the ConfigMap the control plane publishes in every namespace; it is never
+// reported as orphaned.
+const rootCAConfigMap = "kube-root-ca.crt"
+
+// resourceReferences holds the names of the ConfigMaps, Secrets, and PersistentVolumeClaims that are
+// referenced in a namespace. claimPrefixes holds the name prefixes of the claims created from
+// StatefulSet volumeClaimTemplates.
+type resourceReferences struct {
+	configMaps    map[string]bool
+	secrets       map[string]bool
+	claims        map[string]bool
+	claimPrefixes []string
+}
+
+// GetOrphanedResources reports the ConfigMaps, Secrets, and PersistentVolumeClaims of a namespace that
+// appear to be unreferenced, as candidates for cleanup, followed by a summary, through the results
+// channel. A resource counts as referenced when it is named by a pod, or by the pod template of a
+// Deployment, StatefulSet, DaemonSet, Job, or CronJob, through a volume, a projected volume, an env or
+// envFrom source, or an image pull secret; when it is a claim created from a StatefulSet
+// volumeClaimTemplate; when it is a secret named by a ServiceAccount or used for TLS by an Ingress; or
+// when it is a service account token secret or the kube-root-ca.crt ConfigMap. Every list is read
+// page by page, so a large namespace is never fetched in a single response. Nothing is modified.
+//
+// The detection is heuristic, and its results must be reviewed before anything is deleted: resources
+// read through the API by an application or an operator, referenced by custom resources or by other
+// controllers (such as Helm release secrets or cert-manager certificates), or referenced only by
+// workloads that do not exist yet, are reported as unreferenced.
+//
+// Parameters:
+//
+//	ctx context.Context: Context for cancellation.
+//	clientset kubernetes.Interface: A Kubernetes clientset for interacting with the Kubernetes API.
+//	namespace string: The namespace to check.
+//	results chan<- string: A channel that receives the report; it must be drained concurrently.
+//	logger *zap.Logger: A logger for structured logging.
+//
+// Returns an error if any of the resources or their referrers cannot be listed, in which case nothing
+// is reported as unreferenced, or if the context is cancelled.
+func GetOrphanedResources(ctx context.Context, clientset kubernetes.Interface, namespace string, results chan<- string, logger *zap.Logger) error {
+	refs, err := collectResourceReferences(ctx, clientset, namespace)
+	if err != nil {
+		return reportOrphanedResourcesFailure(results, namespace, err)
+	}
+
+	var orphanedConfigMaps, orphanedSecrets, orphanedClaims []string
+	var configMapCount, secretCount, claimCount int
+	err = forEachPage(ctx, clientset.CoreV1().ConfigMaps(namespace).List, func(page *corev1.ConfigMapList) {
+		configMapCount += len(page.Items)
+		for _, configMap := range page.Items {
+			if !refs.configMaps[configMap.Name] && configMap.Name != rootCAConfigMap {
+				orphanedConfigMaps = append(orphanedConfigMaps, configMap.Name)
+			}
+		}
+	})
+	if err != nil {
+		return reportOrphanedResourcesFailure(results, namespace, err)
+	}
+	err = forEachPage(ctx, clientset.CoreV1().Secrets(namespace).List, func(page *corev1.SecretList) {
+		secretCount += len(page.Items)
+		for _, secret := range page.Items {
+			if !refs.secrets[secret.Name] && secret.Type != corev1.SecretTypeServiceAccountToken {
+				orphanedSecrets = append(orphanedSecrets, secret.Name)
+			}
+		}
+	})
+	if err != nil {
+		return reportOrphanedResourcesFailure(results, namespace, err)
+	}
+	err = forEachPage(ctx, clientset.CoreV1().PersistentVolumeClaims(namespace).List, func(page *corev1.PersistentVolumeClaimList) {
+		claimCount += len(page.Items)
+		for _, claim := range page.Items {
+			if !refs.claims[claim.Name] && !refs.fromClaimTemplate(claim.Name) {
+				orphanedClaims = append(orphanedClaims, claim.Name)
+			}
+		}
+	})
+	if err != nil {
+		return reportOrphanedResourcesFailure(results, namespace, err)
+	}
+
+	for _, orphans := range []struct {
+		kind  string
+		names []string
+	}{
+		{"configmap", orphanedConfigMaps},
+		{"secret", orphanedSecrets},
+		{"persistentvolumeclaim", orphanedClaims},
+	} {
+		sort.Strings(orphans.names)
+		for _, name := range orphans.names {
+			if err := ctx.Err(); err != nil {
+				return reportOrphanedResourcesFailure(results, namespace, err)
+			}
+			message := fmt.Sprintf(language.OrphanedResource, orphans.kind, name)
+			results <- message
+			navigator.LogInfoWithEmoji(language.WarningEmoji, message,
+				zap.String(language.InventoryResource, orphans.kind),
+				zap.String(language.ResourceName, name),
+			)
+		}
+	}
+
+	summary := fmt.Sprintf(language.OrphanedResourcesSummary, namespace,
+		len(orphanedConfigMaps), configMapCount,
+		len(orphanedSecrets), secretCount,
+		len(orphanedClaims), claimCount,
+	)
+	results <- summary
+	navigator.LogInfoWithEmoji(constant.SuccessEmoji, summary)
+	return nil
+}
+
+// collectResourceReferences gathers the ConfigMaps, Secrets, and PersistentVolumeClaims referenced by
+// the pods, workload pod templates, StatefulSet volumeClaimTemplates, ServiceAccounts, and Ingresses
+// of a namespace.
+//
+// This unexported function is used internally by GetOrphanedResources.
+func collectResourceReferences(ctx context.Context, clientset kubernetes.Interface, namespace string) (*resourceReferences, error) {
+	refs := &resourceReferences{
+		configMaps: make(map[string]bool),
+		secrets:    make(map[string]bool),
+		claims:     make(map[string]bool),
+	}
+
+	pods, err := listAllPods(ctx, clientset, namespace, v1.ListOptions{Limit: defaultPageSize}, 0)
+	if err != nil {
+		return nil, err
+	}
+	for i := range pods.Items {
+		refs.addPodSpec(&pods.Items[i].Spec)
+	}
+
+	err = forEachPage(ctx, clientset.AppsV1().Deployments(namespace).List, func(page *appsv1.DeploymentList) {
+		for i := range page.Items {
+			refs.addPodSpec(&page.Items[i].Spec.Template.Spec)
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = forEachPage(ctx, clientset.AppsV1().StatefulSets(namespace).List, func(page *appsv1.StatefulSetList) {
+		for i := range page.Items {
+			statefulSet := &page.Items[i]
+			refs.addPodSpec(&statefulSet.Spec.Template.Spec)
+			// Claims created from a template are named <template>-<statefulset>-<ordinal>.
+			for _, template := range statefulSet.Spec.VolumeClaimTemplates {
+				refs.claimPrefixes = append(refs.claimPrefixes, template.Name+"-"+statefulSet.Name+"-")
+			}
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = forEachPage(ctx, clientset.AppsV1().DaemonSets(namespace).List, func(page *appsv1.DaemonSetList) {
+		for i := range page.Items {
+			refs.addPodSpec(&page.Items[i].Spec.Template.Spec)
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = forEachPage(ctx, clientset.BatchV1().Jobs(namespace).List, func(page *batchv1.JobList) {
+		for i := range page.Items {
+			refs.addPodSpec(&page.Items[i].Spec.Template.Spec)
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = forEachPage(ctx, clientset.BatchV1().CronJobs(namespace).List, func(page *batchv1.CronJobList) {
+		for i := range page.Items {
+			refs.addPodSpec(&page.Items[i].Spec.JobTemplate.Spec.Template.Spec)
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = forEachPage(ctx, clientset.CoreV1().ServiceAccounts(namespace).List, func(page *corev1.ServiceAccountList) {
+		for _, serviceAccount := range page.Items {
+			for _, secret := range serviceAccount.Secrets {
+				refs.secrets[secret.Name] = true
+			}
+			for _, secret := range serviceAccount.ImagePullSecrets {
+				refs.secrets[secret.Name] = true
+			}
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = forEachPage(ctx, clientset.NetworkingV1().Ingresses(namespace).List, func(page *networkingv1.IngressList) {
+		for _, ingress := range page.Items {
+			for _, tls := range ingress.Spec.TLS {
+				refs.secrets[tls.SecretName] = true
+			}
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return refs, nil
+}
+
+// pagedList is a list response that may be continued with a further page.
+type pagedList interface {
+	GetContinue() string
+}
+
+// forEachPage lists a resource page by page, defaultPageSize items at a time, following the continue
+// token until the last page, and passes every page to visit.
+//
+// This unexported function is used internally by GetOrphanedResources and collectResourceReferences.
+func forEachPage[L pagedList](ctx context.Context, list func(context.Context, v1.ListOptions) (L, error), visit func(page L)) error {
+	listOptions := v1.ListOptions{Limit: defaultPageSize}
+	for {
+		page, err := list(ctx, listOptions)
+		if err != nil {
+			return err
+		}
+		visit(page)
+		if page.GetContinue() == "" {
+			return nil
+		}
+		listOptions.Continue = page.GetContinue()
+	}
+}
+
+// addPodSpec records the resources a pod spec references through its volumes, projected volumes,
+// env and envFrom sources, and image pull secrets.
+func (r *resourceReferences) addPodSpec(spec *corev1.PodSpec) {
+	for _, volume := range spec.Volumes {
+		switch {
+		case volume.ConfigMap != nil:
+			r.configMaps[volume.ConfigMap.Name] = true
+		case volume.Secret != nil:
+			r.secrets[volume.Secret.SecretName] = true
+		case volume.PersistentVolumeClaim != nil:
+			r.claims[volume.PersistentVolumeClaim.ClaimName] = true
+		case volume.Projected != nil:
+			for _, source := range volume.Projected.Sources {
+				if source.ConfigMap != nil {
+					r.configMaps[source.ConfigMap.Name] = true
+				}
+				if source.Secret != nil {
+					r.secrets[source.Secret.Name] = true
+				}
+			}
+		}
+	}
+	for _, secret := range spec.ImagePullSecrets {
+		r.secrets[secret.Name] = true
+	}
+
+	containers := append(append([]corev1.Container(nil), spec.InitContainers...), spec.Containers...)
+	for _, container := range spec.EphemeralContainers {
+		containers = append(containers, corev1.Container(container.EphemeralContainerCommon))
+	}
+	for _, container := range containers {
+		for _, source := range container.EnvFrom {
+			if source.ConfigMapRef != nil {
+				r.configMaps[source.ConfigMapRef.Name] = true
+			}
+			if source.SecretRef != nil {
+				r.secrets[source.SecretRef.Name] = true
+			}
+		}
+		for _, env := range container.Env {
+			if env.ValueFrom == nil {
+				continue
+			}
+			if env.ValueFrom.ConfigMapKeyRef != nil {
+				r.configMaps[env.ValueFrom.ConfigMapKeyRef.Name] = true
+			}
+			if env.ValueFrom.SecretKeyRef != nil {
+				r.secrets[env.ValueFrom.SecretKeyRef.Name] = true
+			}
+		}
+	}
+}
+
+// fromClaimTemplate reports whether a claim was created from a StatefulSet volumeClaimTemplate.
+func (r *resourceReferences) fromClaimTemplate(claimName string) bool {
+	for _, prefix := range r.claimPrefixes {
+		if strings.HasPrefix(claimName, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// reportOrphanedResourcesFailure sends an error message to the results channel, logs the failure,
+// and returns the error so callers can propagate it directly.
+//
+// This unexported function is used internally by GetOrphanedResources to report failures.
+func reportOrphanedResourcesFailure(results chan<- string, namespace string, err error) error {
+	errorMessage := fmt.Sprintf(language.ErrorFailedToFindOrphanedResources, namespace, err)
+	results <- errorMessage
+	navigator.LogErrorWithEmojiRateLimited(constant.ErrorEmoji, errorMessage, zap.Error(err))
+	return err
+}
@@ -0,0 +1,152 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/H0llyW00dzZ/K8sBlackPearl/language"
+	"github.com/H0llyW00dzZ/K8sBlackPearl/navigator"
+	"github.com/H0llyW00dzZ/go-urlshortner/logmonitor/constant"
+	"go.uber.org/zap"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// maxOwnerChainDepth bounds the walk up the ownership chain, guarding against reference cycles.
+const maxOwnerChainDepth = 10
+
+// GetPodOwnerChain traces a pod up through its owners, following ownerReferences through
+// ReplicaSets, Deployments, StatefulSets, DaemonSets, Jobs, and CronJobs, and reports the chain, such
+// as "Pod/web-1 -> ReplicaSet/web-7d9 -> Deployment/web", through the results channel. At each level
+// the controller reference is followed, or the first reference when none is marked as controller, and
+// any other owners are listed separately. The walk stops at an object without owners, at an owner of
+// a kind it does not know, or at an owner that no longer exists. A pod without owners is reported as
+// an orphan. Nothing is modified.
+//
+// Parameters:
+//
+//	ctx context.Context: Context for cancellation and timeout.
+//	clientset kubernetes.Interface: A Kubernetes clientset for interacting with the Kubernetes API.
+//	namespace string: The namespace of the pod.
+//	podName string: The name of the pod to trace.
+//	results chan<- string: A channel to send operation results for logging.
+//	logger *zap.Logger: A logger for structured logging.
+//
+// Returns an error if the pod or one of its owners cannot be read.
+func GetPodOwnerChain(ctx context.Context, clientset kubernetes.Interface, namespace, podName string, results chan<- string, logger *zap.Logger) error {
+	pod, err := clientset.CoreV1().Pods(namespace).Get(ctx, podName, v1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return reportOwnerChainFailure(results, podName, markNonRetriable(fmt.Errorf(language.ErrorGettingPod, err)))
+	}
+	if err != nil {
+		return reportOwnerChainFailure(results, podName, fmt.Errorf(language.ErrorGettingPod, err))
+	}
+	if len(pod.OwnerReferences) == 0 {
+		message := fmt.Sprintf(language.PodHasNoOwner, podName)
+		results <- message
+		navigator.LogInfoWithEmoji(constant.SuccessEmoji, message)
+		return nil
+	}
+
+	chain := []string{ownerChainLink("Pod", podName)}
+	var otherOwners []string
+	owners := pod.OwnerReferences
+	for depth := 0; len(owners) > 0 && depth < maxOwnerChainDepth; depth++ {
+		owner := primaryOwner(owners)
+		for _, other := range owners {
+			if other.UID != owner.UID {
+				otherOwners = append(otherOwners, ownerChainLink(other.Kind, other.Name))
+			}
+		}
+
+		link := ownerChainLink(owner.Kind, owner.Name)
+		owners, err = getOwnerReferences(ctx, clientset, namespace, owner.Kind, owner.Name)
+		switch {
+		case apierrors.IsNotFound(err):
+			link += language.OwnerMissing
+			owners = nil
+		case err != nil:
+			return reportOwnerChainFailure(results, podName, err)
+		}
+		chain = append(chain, link)
+	}
+
+	message := fmt.Sprintf(language.PodOwnerChain, podName, strings.Join(chain, " -> "))
+	if len(otherOwners) > 0 {
+		message += fmt.Sprintf(language.AdditionalOwners, strings.Join(otherOwners, ", "))
+	}
+	results <- message
+	navigator.LogInfoWithEmoji(constant.SuccessEmoji, message)
+	return nil
+}
+
+// primaryOwner returns the controller among the owner references, or the first reference if none
+// is marked as controller.
+//
+// This unexported function is used internally by GetPodOwnerChain.
+func primaryOwner(owners []v1.OwnerReference) v1.OwnerReference {
+	if controller := v1.GetControllerOfNoCopy(&v1.ObjectMeta{OwnerReferences: owners}); controller != nil {
+		return *controller
+	}
+	return owners[0]
+}
+
+// getOwnerReferences returns the owner references of the named object of a known workload kind.
+// Objects of other kinds are treated as the top of the chain and return no references.
+//
+// This unexported function is used internally by GetPodOwnerChain.
+func getOwnerReferences(ctx context.Context, clientset kubernetes.Interface, namespace, kind, name string) ([]v1.OwnerReference, error) {
+	var meta v1.Object
+	var err error
+	switch kind {
+	case "ReplicaSet":
+		meta, err = clientset.AppsV1().ReplicaSets(namespace).Get(ctx, name, v1.GetOptions{})
+	case "Deployment":
+		meta, err = clientset.AppsV1().Deployments(namespace).Get(ctx, name, v1.GetOptions{})
+	case "StatefulSet":
+		meta, err = clientset.AppsV1().StatefulSets(namespace).Get(ctx, name, v1.GetOptions{})
+	case "DaemonSet":
+		meta, err = clientset.AppsV1().DaemonSets(namespace).Get(ctx, name, v1.GetOptions{})
+	case "Job":
+		meta, err = clientset.BatchV1().Jobs(namespace).Get(ctx, name, v1.GetOptions{})
+	case "CronJob":
+		meta, err = clientset.BatchV1().CronJobs(namespace).Get(ctx, name, v1.GetOptions{})
+	default:
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return meta.GetOwnerReferences(), nil
+}
+
+// ownerChainLink formats one object of the ownership chain as Kind/name.
+//
+// This unexported function is used internally by GetPodOwnerChain.
+func ownerChainLink(kind, name string) string {
+	return kind + "/" + name
+}
+
+// reportOwnerChainFailure sends an error message to the results channel, logs the failure,
+// and returns the error so callers can propagate it directly.
+//
+// This unexported function is used internally by GetPodOwnerChain to report failures.
+func reportOwnerChainFailure(results chan<- string, podName string, err error) error {
+	errorMessage := fmt.Sprintf(language.ErrorFailedToGetOwnerChain, podName, err)
+	results <- errorMessage
+	navigator.LogErrorWithEmojiRateLimited(constant.ErrorEmoji, errorMessage, zap.Error(err))
+	return err
+}
+
+// extractOwnerChainParameters extracts and validates the 'podName' parameter.
+//
+// This function is used by task runners that trace pod ownership.
+func extractOwnerChainParameters(parameters map[string]interface{}) (string, error) {
+	podName, err := getParamAsString(parameters, language.PodName)
+	if err != nil || podName == "" {
+		return "", newParameterError(language.PodName, err, language.ErrorParameterMissing, language.PodName)
+	}
+	return podName, nil
+}
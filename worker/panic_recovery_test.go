@@ -0,0 +1,55 @@
+package worker
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/H0llyW00dzZ/K8sBlackPearl/worker/configuration"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestCrewSurvivesAPanickingRunner(t *testing.T) {
+	attempts := 0
+	registerTestRunner(t, "TestPanic", func(_ context.Context, _ kubernetes.Interface, _ string, _ configuration.Task, _ map[string]interface{}, _ int) error {
+		attempts++
+		panic("cannon misfired")
+	})
+	registerTestRunner(t, "TestSucceed", func(_ context.Context, _ kubernetes.Interface, _ string, _ configuration.Task, _ map[string]interface{}, _ int) error {
+		return nil
+	})
+	tasks := []configuration.Task{
+		{Name: "misfire", Type: "TestPanic", ShipsNamespace: "default", MaxRetries: 3, RetryDelay: "1ms"},
+		{Name: "broadside", Type: "TestSucceed", ShipsNamespace: "default", MaxRetries: 3, RetryDelay: "1ms"},
+	}
+
+	results, shutdown := CaptainTellWorkers(context.Background(), fake.NewSimpleClientset(), tasks, 1)
+	var collected []string
+	timeout := time.After(5 * time.Second)
+	for len(collected) < len(tasks) {
+		select {
+		case result := <-results:
+			collected = append(collected, result)
+		case <-timeout:
+			t.Fatalf("the crew stopped after reporting %q", collected)
+		}
+	}
+	shutdown()
+	for closed := false; !closed; {
+		select {
+		case _, open := <-results:
+			closed = !open
+		case <-timeout:
+			t.Fatal("the results channel was never closed")
+		}
+	}
+
+	if collected[0] != "Failed to complete task misfire after 1 attempts" || collected[1] != "Crew Worker 0: Task 'broadside' completed successfully." {
+		t.Fatalf("got results %q, want the panicking task to fail and the next one to succeed", collected)
+	}
+	if attempts != 1 {
+		t.Fatalf("the panicking runner ran %d times, want a panic to be final", attempts)
+	}
+
+}
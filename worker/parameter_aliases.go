@@ -0,0 +1,70 @@
+package worker
+
+import (
+	"strings"
+
+	"github.com/H0llyW00dzZ/K8sBlackPearl/language"
+)
+
+// normalizeParameterKeys returns a copy of the parameters in which every snake_case key, such as
+// 'deployment_name', is replaced by the camelCase key the runners read, such as 'deploymentName', so
+// task files may use either convention. Only top-level keys are rewritten: nested maps, such as labels
+// or ConfigMap data, are user data and are kept as written. The original parameters are never modified,
+// since tasks are shared between workers. Parameters without snake_case keys are returned as is.
+//
+// Parameters:
+//
+//	parameters map[string]interface{}: The task parameters to normalize.
+//
+// Returns:
+//
+//	map[string]interface{}: The parameters keyed by their canonical camelCase names.
+//	error: A *ParameterError if a parameter is given in both conventions.
+func normalizeParameterKeys(parameters map[string]interface{}) (map[string]interface{}, error) {
+	if !containsSnakeCaseKey(parameters) {
+		return parameters, nil
+	}
+
+	normalized := make(map[string]interface{}, len(parameters))
+	for key, value := range parameters {
+		canonical := snakeToCamelCase(key)
+		if _, duplicate := normalized[canonical]; duplicate {
+			return nil, newParameterError(canonical, nil, language.ErrorParameterGivenTwice, canonical)
+		}
+		normalized[canonical] = value
+	}
+	return normalized, nil
+}
+
+// containsSnakeCaseKey reports whether any top-level parameter key contains an underscore.
+//
+// This unexported function is used internally by normalizeParameterKeys.
+func containsSnakeCaseKey(parameters map[string]interface{}) bool {
+	for key := range parameters {
+		if strings.Contains(key, "_") {
+			return true
+		}
+	}
+	return false
+}
+
+// snakeToCamelCase converts a snake_case key to camelCase by dropping each underscore and upper-casing
+// the letter that follows it. Leading underscores and keys without underscores are kept unchanged.
+//
+// This unexported function is used internally by normalizeParameterKeys.
+func snakeToCamelCase(key string) string {
+	parts := strings.Split(key, "_")
+	if len(parts) == 1 || parts[0] == "" {
+		return key
+	}
+
+	var builder strings.Builder
+	builder.WriteString(parts[0])
+	for _, part := range parts[1:] {
+		if part == "" {
+			continue
+		}
+		builder.WriteString(strings.ToUpper(part[:1]) + part[1:])
+	}
+	return builder.String()
+}
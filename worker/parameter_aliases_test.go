@@ -0,0 +1,70 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/H0llyW00dzZ/K8sBlackPearl/worker/configuration"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestRunnersAcceptBothParameterKeyStyles(t *testing.T) {
+	for name, parameters := range map[string]struct{ scale, image map[string]interface{} }{
+		"camelCase": {
+			scale: map[string]interface{}{"deploymentName": "api", "replicas": 5},
+			image: map[string]interface{}{"deploymentName": "api", "containerName": "app", "newImage": "pearl:2"},
+		},
+		"snake_case": {
+			scale: map[string]interface{}{"deployment_name": "api", "replicas": 5},
+			image: map[string]interface{}{"deployment_name": "api", "container_name": "app", "new_image": "pearl:2"},
+		},
+	} {
+		clientset := newDeploymentClientset()
+		tasks := []configuration.Task{
+			{Name: "scale", Type: "CrewScaleDeployments", MaxRetries: 1, RetryDelayDuration: time.Millisecond, Parameters: parameters.scale},
+			{Name: "image", Type: "CrewUpdateImageDeployments", MaxRetries: 1, RetryDelayDuration: time.Millisecond, Parameters: parameters.image},
+		}
+		for _, task := range tasks {
+			if err := performTask(context.Background(), clientset, "default", task, 0); err != nil {
+				t.Fatalf("%s: %s: %v", name, task.Name, err)
+			}
+		}
+
+		deployment, err := clientset.AppsV1().Deployments("default").Get(context.Background(), "api", v1.GetOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if *deployment.Spec.Replicas != 5 || deployment.Spec.Template.Spec.Containers[0].Image != "pearl:2" {
+			t.Fatalf("%s: got %d replicas and image %q, want 5 and pearl:2", name, *deployment.Spec.Replicas, deployment.Spec.Template.Spec.Containers[0].Image)
+		}
+	}
+}
+
+func TestNormalizeParameterKeys(t *testing.T) {
+	parameters := map[string]interface{}{
+		"deployment_name": "api",
+		"labels":          map[string]interface{}{"app_tier": "web"},
+	}
+	normalized, err := normalizeParameterKeys(parameters)
+	if err != nil {
+		t.Fatalf("normalizeParameterKeys: %v", err)
+	}
+	want := map[string]interface{}{
+		"deploymentName": "api",
+		"labels":         map[string]interface{}{"app_tier": "web"},
+	}
+	if !reflect.DeepEqual(normalized, want) {
+		t.Fatalf("got %v, want %v with nested keys kept as written", normalized, want)
+	}
+	if _, found := parameters["deploymentName"]; found {
+		t.Fatal("the original parameters were modified")
+	}
+
+	_, err = normalizeParameterKeys(map[string]interface{}{"deploymentName": "api", "deployment_name": "web"})
+	if !errors.Is(err, ErrInvalidParameter) {
+		t.Fatalf("got error %v for a key given in both styles, want an invalid parameter error", err)
+	}
+}
@@ -0,0 +1,66 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/H0llyW00dzZ/K8sBlackPearl/language"
+	"github.com/H0llyW00dzZ/K8sBlackPearl/navigator"
+	"github.com/H0llyW00dzZ/go-urlshortner/logmonitor/constant"
+	"go.uber.org/zap"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/util/retry"
+)
+
+// SetDeploymentPaused pauses or resumes the rollout of a deployment by setting spec.paused.
+// While a deployment is paused, changes to its pod template are recorded but not rolled out,
+// so several edits can be batched into a single rollout. The update is retried on conflicts,
+// and a deployment that is already in the requested state is reported as a no-op success.
+//
+// Parameters:
+//
+//	ctx context.Context: Context for cancellation and timeout.
+//	clientset kubernetes.Interface: A Kubernetes clientset for interacting with the Kubernetes API.
+//	namespace string: The namespace of the deployment.
+//	deploymentName string: The name of the deployment.
+//	paused bool: True to pause the rollout, false to resume it.
+//	results chan<- string: A channel to send operation results for logging.
+//	logger *zap.Logger: A logger for structured logging.
+//
+// Returns an error if the deployment cannot be read or updated.
+func SetDeploymentPaused(ctx context.Context, clientset kubernetes.Interface, namespace, deploymentName string, paused bool, results chan<- string, logger *zap.Logger) error {
+	unchanged := false
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		deployment, err := clientset.AppsV1().Deployments(namespace).Get(ctx, deploymentName, v1.GetOptions{})
+		if err != nil {
+			return fmt.Errorf(language.ErrorGettingDeployment, deploymentName, err)
+		}
+		if deployment.Spec.Paused == paused {
+			unchanged = true
+			return nil
+		}
+		deployment.Spec.Paused = paused
+		_, err = clientset.AppsV1().Deployments(namespace).Update(ctx, deployment, v1.UpdateOptions{})
+		return err
+	})
+
+	state := language.StateResumed
+	if paused {
+		state = language.StatePaused
+	}
+	if err != nil {
+		errorMessage := fmt.Sprintf(language.ErrorFailedToSetPaused, deploymentName, state, err)
+		results <- errorMessage
+		navigator.LogErrorWithEmojiRateLimited(constant.ErrorEmoji, errorMessage, zap.Error(err))
+		return err
+	}
+
+	message := fmt.Sprintf(language.DeploymentPausedState, deploymentName, state)
+	if unchanged {
+		message = fmt.Sprintf(language.DeploymentAlreadyInPausedState, deploymentName, state)
+	}
+	results <- message
+	navigator.LogInfoWithEmoji(constant.SuccessEmoji, message)
+	return nil
+}
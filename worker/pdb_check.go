@@ -0,0 +1,136 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/H0llyW00dzZ/K8sBlackPearl/language"
+	"github.com/H0llyW00dzZ/K8sBlackPearl/navigator"
+	"github.com/H0llyW00dzZ/go-urlshortner/logmonitor/constant"
+	"go.uber.org/zap"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/kubernetes"
+)
+
+// pdbStatus is the disruption status of a PodDisruptionBudget governing the inspected pods.
+type pdbStatus struct {
+	name               string
+	disruptionsAllowed int32
+	podNames           []string
+	blocking           bool
+}
+
+// checkPDBViolations finds the PodDisruptionBudgets that govern the pods of a namespace, optionally
+// restricted to the pods scheduled on a single node, and reports whether each would block an eviction
+// because it allows no further disruptions. Budgets that govern none of the inspected pods are omitted.
+//
+// Parameters:
+//
+//	ctx context.Context: Context for cancellation and timeout.
+//	clientset kubernetes.Interface: A Kubernetes clientset for interacting with the Kubernetes API.
+//	namespace string: The namespace whose pods and budgets are inspected.
+//	nodeName string: An optional node name restricting the inspection to the pods on that node.
+//
+// Returns:
+//
+//	[]pdbStatus: The status of every budget governing the inspected pods, sorted by name.
+//	error: An error if the pods or budgets cannot be listed.
+func checkPDBViolations(ctx context.Context, clientset kubernetes.Interface, namespace, nodeName string) ([]pdbStatus, error) {
+	listOptions := v1.ListOptions{Limit: defaultPageSize}
+	if nodeName != "" {
+		listOptions.FieldSelector = fields.OneTermEqualSelector(specNodeName, nodeName).String()
+	}
+	pods, err := listAllPods(ctx, clientset, namespace, listOptions, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	budgets, err := clientset.PolicyV1().PodDisruptionBudgets(namespace).List(ctx, v1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf(language.ErrorListingPDBs, err)
+	}
+
+	var statuses []pdbStatus
+	for _, budget := range budgets.Items {
+		selector, err := v1.LabelSelectorAsSelector(budget.Spec.Selector)
+		if err != nil || selector.Empty() {
+			// An invalid or empty selector does not govern any pod in a meaningful way.
+			continue
+		}
+
+		var podNames []string
+		for _, pod := range pods.Items {
+			if selector.Matches(labels.Set(pod.Labels)) {
+				podNames = append(podNames, pod.Name)
+			}
+		}
+		if len(podNames) == 0 {
+			continue
+		}
+
+		sort.Strings(podNames)
+		statuses = append(statuses, pdbStatus{
+			name:               budget.Name,
+			disruptionsAllowed: budget.Status.DisruptionsAllowed,
+			podNames:           podNames,
+			blocking:           budget.Status.DisruptionsAllowed <= 0,
+		})
+	}
+
+	sort.Slice(statuses, func(i, j int) bool {
+		return statuses[i].name < statuses[j].name
+	})
+	return statuses, nil
+}
+
+// reportPDBViolations sends one line per budget through the results channel and logs the same
+// information with structured fields. It stops early if the context is cancelled.
+//
+// Parameters:
+//
+//	ctx context.Context: Context for cancellation.
+//	baseFields []zap.Field: A slice of zap.Field structs providing contextual logging information.
+//	statuses []pdbStatus: The budgets to report.
+//	failIfBlocked bool: Whether a blocking budget should be returned as an error.
+//	results chan<- string: A channel with room for one message per budget.
+//
+// Returns an error if the context is cancelled, or if failIfBlocked is set and any budget would
+// block disruption.
+func reportPDBViolations(ctx context.Context, baseFields []zap.Field, statuses []pdbStatus, failIfBlocked bool, results chan<- string) error {
+	var blocking []string
+	for _, status := range statuses {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		statusFields := append([]zap.Field(nil), baseFields...)
+		statusFields = append(statusFields,
+			zap.String(language.PDBName, status.name),
+			zap.Int32(language.DisruptionsAllowed, status.disruptionsAllowed),
+			zap.Strings(language.PodNames, status.podNames),
+			zap.Bool(language.PDBBlocking, status.blocking),
+		)
+
+		podNames := strings.Join(status.podNames, ", ")
+		if status.blocking {
+			message := fmt.Sprintf(language.PDBBlocksDisruption, status.name, podNames)
+			results <- message
+			navigator.LogInfoWithEmoji(language.WarningEmoji, message, statusFields...)
+			blocking = append(blocking, status.name)
+			continue
+		}
+
+		message := fmt.Sprintf(language.PDBAllowsDisruption, status.name, status.disruptionsAllowed, podNames)
+		results <- message
+		navigator.LogInfoWithEmoji(constant.SuccessEmoji, message, statusFields...)
+	}
+
+	if failIfBlocked && len(blocking) > 0 {
+		return markNonRetriable(fmt.Errorf(language.ErrorPDBsBlockDisruption, strings.Join(blocking, ", ")))
+	}
+	return nil
+}
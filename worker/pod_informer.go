@@ -0,0 +1,169 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/H0llyW00dzZ/K8sBlackPearl/language"
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+)
+
+// podListers holds the pod listers started by StartPodInformer, keyed by namespace. The key
+// v1.NamespaceAll holds a lister covering every namespace.
+var (
+	podListers   = make(map[string]corelisters.PodLister)
+	podListersMu sync.RWMutex
+)
+
+// StartPodInformer starts a shared pod informer for the namespace and blocks until its cache has
+// synced, or until defaultInformerSyncTimeout elapses. Once synced, the lister is registered so that
+// read-only pod listings in this package, such as those of CrewGetPodsTaskRunner, CrewCheckHealthPods,
+// and CrewGetPodsByNode, are served from the local cache instead of a live List call. The informer
+// runs, and the lister stays registered, until ctx is cancelled.
+//
+// Pass v1.NamespaceAll to cache pods of every namespace. Listings that the cache cannot answer
+// exactly, such as those with an unsupported field selector, still go to the API server.
+//
+// Parameters:
+//
+//	ctx context.Context: Context controlling the lifetime of the informer.
+//	clientset kubernetes.Interface: A Kubernetes clientset for interacting with the Kubernetes API.
+//	namespace string: The namespace whose pods should be cached.
+//
+// Returns:
+//
+//	corelisters.PodLister: The lister reading from the synced cache.
+//	error: An error if the cache does not sync in time.
+func StartPodInformer(ctx context.Context, clientset kubernetes.Interface, namespace string) (corelisters.PodLister, error) {
+	informerCtx, stopInformer := context.WithCancel(ctx)
+	factory := informers.NewSharedInformerFactoryWithOptions(clientset, 0, informers.WithNamespace(namespace))
+	podInformer := factory.Core().V1().Pods()
+	informer := podInformer.Informer()
+	lister := podInformer.Lister()
+	factory.Start(informerCtx.Done())
+
+	syncCtx, cancel := context.WithTimeout(informerCtx, defaultInformerSyncTimeout)
+	defer cancel()
+	if !cache.WaitForCacheSync(syncCtx.Done(), informer.HasSynced) {
+		stopInformer()
+		factory.Shutdown()
+		return nil, fmt.Errorf(language.ErrorPodInformerNotSynced, namespace, defaultInformerSyncTimeout)
+	}
+
+	podListersMu.Lock()
+	podListers[namespace] = lister
+	podListersMu.Unlock()
+
+	go func() {
+		defer stopInformer()
+		<-informerCtx.Done()
+		podListersMu.Lock()
+		if podListers[namespace] == lister {
+			delete(podListers, namespace)
+		}
+		podListersMu.Unlock()
+		factory.Shutdown()
+	}()
+
+	return lister, nil
+}
+
+// podListerFor returns the registered lister covering the namespace, preferring a lister started
+// for that namespace over one covering every namespace.
+//
+// This unexported function is used internally by listPodsFromCache.
+func podListerFor(namespace string) (corelisters.PodLister, bool) {
+	podListersMu.RLock()
+	defer podListersMu.RUnlock()
+	if lister, found := podListers[namespace]; found {
+		return lister, true
+	}
+	lister, found := podListers[v1.NamespaceAll]
+	return lister, found
+}
+
+// listPodsFromCache answers a pod listing from a lister started by StartPodInformer. It reports
+// false when no lister covers the namespace or when the list options cannot be evaluated against
+// the cache, in which case the caller should list from the API server. Pods are deep-copied, since
+// cached objects are shared, and sorted by namespace and name to match the API server's order.
+// The Limit of the list options is ignored; callers apply their own cap.
+//
+// Parameters:
+//
+//	namespace string: The namespace from which to list the Pods.
+//	listOptions v1.ListOptions: The label and field selectors to apply.
+//
+// Returns:
+//
+//	*corev1.PodList: The matching Pods, or nil if the cache was not used.
+//	bool: True if the listing was answered from the cache.
+func listPodsFromCache(namespace string, listOptions v1.ListOptions) (*corev1.PodList, bool) {
+	lister, found := podListerFor(namespace)
+	if !found || listOptions.Continue != "" || listOptions.ResourceVersion != "" {
+		return nil, false
+	}
+
+	labelSelector, err := labels.Parse(listOptions.LabelSelector)
+	if err != nil {
+		return nil, false
+	}
+	fieldSelector, err := fields.ParseSelector(listOptions.FieldSelector)
+	if err != nil {
+		return nil, false
+	}
+	for _, requirement := range fieldSelector.Requirements() {
+		if _, supported := podFieldSet(&corev1.Pod{})[requirement.Field]; !supported {
+			return nil, false
+		}
+	}
+
+	var cached []*corev1.Pod
+	if namespace == v1.NamespaceAll {
+		cached, err = lister.List(labelSelector)
+	} else {
+		cached, err = lister.Pods(namespace).List(labelSelector)
+	}
+	if err != nil {
+		return nil, false
+	}
+
+	podList := &corev1.PodList{Items: make([]corev1.Pod, 0, len(cached))}
+	for _, pod := range cached {
+		if fieldSelector.Matches(podFieldSet(pod)) {
+			podList.Items = append(podList.Items, *pod.DeepCopy())
+		}
+	}
+	sort.Slice(podList.Items, func(i, j int) bool {
+		if podList.Items[i].Namespace != podList.Items[j].Namespace {
+			return podList.Items[i].Namespace < podList.Items[j].Namespace
+		}
+		return podList.Items[i].Name < podList.Items[j].Name
+	})
+	return podList, true
+}
+
+// podFieldSet returns the pod fields that the API server supports in field selectors.
+//
+// This unexported function is used internally by listPodsFromCache.
+func podFieldSet(pod *corev1.Pod) fields.Set {
+	return fields.Set{
+		"metadata.name":            pod.Name,
+		"metadata.namespace":       pod.Namespace,
+		"spec.nodeName":            pod.Spec.NodeName,
+		"spec.restartPolicy":       string(pod.Spec.RestartPolicy),
+		"spec.schedulerName":       pod.Spec.SchedulerName,
+		"spec.serviceAccountName":  pod.Spec.ServiceAccountName,
+		"status.phase":             string(pod.Status.Phase),
+		"status.podIP":             pod.Status.PodIP,
+		"status.nominatedNodeName": pod.Status.NominatedNodeName,
+	}
+}
@@ -0,0 +1,66 @@
+package worker
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+// countPodLists returns the number of live pod List calls made through the clientset.
+func countPodLists(clientset *fake.Clientset) int {
+	lists := 0
+	for _, action := range clientset.Actions() {
+		if action.Matches("list", "pods") {
+			lists++
+		}
+	}
+	return lists
+}
+
+func TestPodListingsAreServedFromTheInformerCache(t *testing.T) {
+	clientset := fake.NewSimpleClientset(
+		&corev1.Pod{ObjectMeta: v1.ObjectMeta{Name: "web-b", Namespace: "default", Labels: map[string]string{"app": "web"}}, Spec: corev1.PodSpec{NodeName: "node-1"}},
+		&corev1.Pod{ObjectMeta: v1.ObjectMeta{Name: "web-a", Namespace: "default", Labels: map[string]string{"app": "web"}}, Spec: corev1.PodSpec{NodeName: "node-2"}},
+		&corev1.Pod{ObjectMeta: v1.ObjectMeta{Name: "db", Namespace: "default", Labels: map[string]string{"app": "db"}}},
+	)
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(func() {
+		cancel()
+		for deadline := time.Now().Add(5 * time.Second); time.Now().Before(deadline); time.Sleep(time.Millisecond) {
+			if _, found := podListerFor("default"); !found {
+				return
+			}
+		}
+		t.Error("lister still registered after the informer context was cancelled")
+	})
+
+	if _, err := StartPodInformer(ctx, clientset, "default"); err != nil {
+		t.Fatalf("StartPodInformer: %v", err)
+	}
+	afterSync := countPodLists(clientset)
+
+	pods, err := listPods(ctx, clientset, "default", v1.ListOptions{LabelSelector: "app=web"})
+	if err != nil {
+		t.Fatalf("listPods: %v", err)
+	}
+	if len(pods.Items) != 2 || pods.Items[0].Name != "web-a" || pods.Items[1].Name != "web-b" {
+		t.Fatalf("got %d pods %v from the cache, want web-a and web-b in order", len(pods.Items), pods.Items)
+	}
+	if _, err := listAllPods(ctx, clientset, "default", v1.ListOptions{FieldSelector: "spec.nodeName=node-1", Limit: defaultPageSize}, 0); err != nil {
+		t.Fatalf("listAllPods: %v", err)
+	}
+	if lists := countPodLists(clientset); lists != afterSync {
+		t.Fatalf("got %d live List calls after sync, want none", lists-afterSync)
+	}
+
+	if _, err := listPods(ctx, clientset, "default", v1.ListOptions{FieldSelector: "metadata.uid=abc"}); err != nil {
+		t.Fatalf("listPods: %v", err)
+	}
+	if lists := countPodLists(clientset); lists != afterSync+1 {
+		t.Fatalf("got %d live List calls for an unsupported field selector, want 1", lists-afterSync)
+	}
+}
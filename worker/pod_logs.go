@@ -0,0 +1,119 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/H0llyW00dzZ/K8sBlackPearl/language"
+	"github.com/H0llyW00dzZ/K8sBlackPearl/navigator"
+	"github.com/H0llyW00dzZ/go-urlshortner/logmonitor/constant"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// GetPodLogsSince fetches the logs of a pod container, limited by the window and byte cap in options.
+// Setting options.SinceSeconds or options.SinceTime returns only recent lines, which suits repeated
+// monitoring tasks, and options.Previous reads the logs of the previously terminated container
+// instance, which is where the cause of a crash loop is usually found.
+//
+// Parameters:
+//
+//	ctx context.Context: Context for cancellation and timeout.
+//	clientset kubernetes.Interface: A Kubernetes clientset for interacting with the Kubernetes API.
+//	namespace string: The namespace of the pod.
+//	podName string: The name of the pod.
+//	options *corev1.PodLogOptions: The container, time window, previous flag, and byte limit to use.
+//	results chan<- string: A channel to send operation results for logging.
+//	logger *zap.Logger: A logger for structured logging.
+//
+// Returns an error if the logs cannot be retrieved. Asking for the previous instance of a container
+// that has never restarted is reported as a non-retriable error.
+func GetPodLogsSince(ctx context.Context, clientset kubernetes.Interface, namespace, podName string, options *corev1.PodLogOptions, results chan<- string, logger *zap.Logger) error {
+	logs, err := clientset.CoreV1().Pods(namespace).GetLogs(podName, options).DoRaw(ctx)
+	if err != nil {
+		if options.Previous && apierrors.IsBadRequest(err) {
+			err = markNonRetriable(fmt.Errorf(language.ErrorNoPreviousContainer, options.Container, podName, err))
+		}
+		return reportPodLogsFailure(results, podName, err)
+	}
+
+	message := fmt.Sprintf(language.PodLogsRetrieved, podName, len(logs), string(logs))
+	if options.LimitBytes != nil && int64(len(logs)) >= *options.LimitBytes {
+		message = fmt.Sprintf(language.PodLogsTruncated, podName, len(logs), string(logs))
+	}
+	results <- message
+	navigator.LogInfoWithEmoji(constant.SuccessEmoji, fmt.Sprintf(language.PodLogsFetched, len(logs), podName))
+	return nil
+}
+
+// reportPodLogsFailure sends an error message to the results channel, logs the failure,
+// and returns the error so callers can propagate it directly.
+//
+// This unexported function is used internally by GetPodLogsSince to report failures.
+func reportPodLogsFailure(results chan<- string, podName string, err error) error {
+	errorMessage := fmt.Sprintf(language.ErrorFailedToGetPodLogs, podName, err)
+	results <- errorMessage
+	navigator.LogErrorWithEmojiRateLimited(constant.ErrorEmoji, errorMessage, zap.Error(err))
+	return err
+}
+
+// extractPodLogsParameters extracts and validates the 'podName', 'containerName', 'sinceSeconds',
+// 'sinceTime', 'previous', and 'limitBytes' parameters. Only 'podName' is required. At most one of
+// 'sinceSeconds' (a positive integer) and 'sinceTime' (an RFC 3339 timestamp) may be set, and
+// 'limitBytes' defaults to defaultPodLogLimitBytes.
+//
+// This function is used by task runners that read pod logs.
+func extractPodLogsParameters(parameters map[string]interface{}) (string, *corev1.PodLogOptions, error) {
+	podName, err := getParamAsString(parameters, language.PodName)
+	if err != nil || podName == "" {
+		return "", nil, newParameterError(language.PodName, err, language.ErrorParameterMissing, language.PodName)
+	}
+
+	options := &corev1.PodLogOptions{}
+	if options.Container, err = getOptionalParamAsString(parameters, contaInerName, ""); err != nil {
+		return "", nil, err
+	}
+
+	_, hasSinceSeconds := parameters[sinceSecondS]
+	_, hasSinceTime := parameters[sinceTimE]
+	if hasSinceSeconds && hasSinceTime {
+		return "", nil, newParameterError(sinceSecondS, nil, language.ErrorPodLogsSinceAmbiguous)
+	}
+	if hasSinceSeconds {
+		sinceSeconds, err := getParamAsInt64(parameters, sinceSecondS)
+		if err != nil || sinceSeconds <= 0 {
+			return "", nil, newParameterError(sinceSecondS, err, language.ErrorParameterInvalid, sinceSecondS)
+		}
+		options.SinceSeconds = &sinceSeconds
+	}
+	if hasSinceTime {
+		sinceTimeStr, err := getParamAsString(parameters, sinceTimE)
+		if err != nil {
+			return "", nil, err
+		}
+		sinceTime, err := time.Parse(time.RFC3339, sinceTimeStr)
+		if err != nil {
+			return "", nil, newParameterError(sinceTimE, err, language.ErrorParameterInvalid, sinceTimE)
+		}
+		options.SinceTime = &v1.Time{Time: sinceTime}
+	}
+
+	if options.Previous, err = getOptionalParamAsBool(parameters, previouS, false); err != nil {
+		return "", nil, err
+	}
+
+	limitBytes := int64(defaultPodLogLimitBytes)
+	if _, exists := parameters[limitByteS]; exists {
+		limitBytes, err = getParamAsInt64(parameters, limitByteS)
+		if err != nil || limitBytes <= 0 {
+			return "", nil, newParameterError(limitByteS, err, language.ErrorParameterInvalid, limitByteS)
+		}
+	}
+	options.LimitBytes = &limitBytes
+
+	return podName, options, nil
+}
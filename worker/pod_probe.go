@@ -0,0 +1,139 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/H0llyW00dzZ/K8sBlackPearl/language"
+	"github.com/H0llyW00dzZ/K8sBlackPearl/navigator"
+	"github.com/H0llyW00dzZ/go-urlshortner/logmonitor/constant"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// podProbe describes an HTTP probe sent to a pod through the API server proxy.
+type podProbe struct {
+	podName        string
+	port           int
+	path           string
+	expectedStatus int
+	timeout        time.Duration
+}
+
+// ProbePodHTTP sends an HTTP GET to a pod's endpoint through the API server's pod proxy subresource
+// and compares the response status with the expected one. The pod must be running; a pod in any
+// other phase is reported with a clear error instead of being probed.
+//
+// Parameters:
+//
+//	ctx context.Context: Context for cancellation and timeout.
+//	clientset kubernetes.Interface: A Kubernetes clientset for interacting with the Kubernetes API.
+//	namespace string: The namespace of the pod.
+//	probe podProbe: The pod, port, path, expected status, and timeout of the probe.
+//	results chan<- string: A channel to send operation results for logging.
+//	logger *zap.Logger: A logger for structured logging.
+//
+// Returns an error if the pod cannot be probed or answers with an unexpected status.
+func ProbePodHTTP(ctx context.Context, clientset kubernetes.Interface, namespace string, probe podProbe, results chan<- string, logger *zap.Logger) error {
+	pod, err := clientset.CoreV1().Pods(namespace).Get(ctx, probe.podName, v1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return reportProbeFailure(results, probe.podName, markNonRetriable(fmt.Errorf(language.ErrorNamedPodNotFound, probe.podName, namespace)))
+	}
+	if err != nil {
+		return reportProbeFailure(results, probe.podName, err)
+	}
+	if pod.Status.Phase != corev1.PodRunning {
+		return reportProbeFailure(results, probe.podName, fmt.Errorf(language.ErrorPodNotRunning, probe.podName, pod.Status.Phase))
+	}
+
+	probeCtx, cancel := context.WithTimeout(ctx, probe.timeout)
+	defer cancel()
+
+	var statusCode int
+	result := clientset.CoreV1().RESTClient().Get().
+		Namespace(namespace).
+		Resource("pods").
+		Name(probe.podName + ":" + strconv.Itoa(probe.port)).
+		SubResource("proxy").
+		Suffix(probe.path).
+		Do(probeCtx).
+		StatusCode(&statusCode)
+	if statusCode == 0 {
+		// No HTTP response was received, so there is no status to compare.
+		return reportProbeFailure(results, probe.podName, fmt.Errorf(language.ErrorProbingPod, result.Error()))
+	}
+	if statusCode != probe.expectedStatus {
+		return reportProbeFailure(results, probe.podName, fmt.Errorf(language.ErrorUnexpectedProbeStatus, statusCode, probe.expectedStatus))
+	}
+
+	successMsg := fmt.Sprintf(language.PodProbeSucceeded, probe.podName, probe.port, probe.path, statusCode)
+	results <- successMsg
+	navigator.LogInfoWithEmoji(constant.SuccessEmoji, successMsg, zap.String(language.PodName, probe.podName), zap.Int(language.StatusCode, statusCode))
+	return nil
+}
+
+// reportProbeFailure sends an error message to the results channel, logs the failure,
+// and returns the error so callers can propagate it directly.
+//
+// This unexported function is used internally by ProbePodHTTP to report failures.
+func reportProbeFailure(results chan<- string, podName string, err error) error {
+	errorMessage := fmt.Sprintf(language.ErrorFailedToProbePod, podName, err)
+	results <- errorMessage
+	navigator.LogErrorWithEmojiRateLimited(constant.ErrorEmoji, errorMessage, zap.Error(err))
+	return err
+}
+
+// extractPodProbeParameters extracts and validates the 'podName', 'port', 'path', 'expectedStatus',
+// and 'timeout' parameters. 'path' defaults to "/", 'expectedStatus' to 200, and 'timeout' to 10s.
+//
+// This function is used by task runners that probe pods.
+func extractPodProbeParameters(parameters map[string]interface{}) (podProbe, error) {
+	podName, err := getParamAsString(parameters, language.PodName)
+	if err != nil || podName == "" {
+		return podProbe{}, newParameterError(language.PodName, err, language.ErrorParameterMissing, language.PodName)
+	}
+
+	port, err := getParamAsInt(parameters, porT)
+	if err != nil {
+		return podProbe{}, err
+	}
+	if port < 1 || port > 65535 {
+		return podProbe{}, newParameterError(porT, nil, language.ErrorInvalidPort, port)
+	}
+
+	path, err := getOptionalParamAsString(parameters, patH, "/")
+	if err != nil {
+		return podProbe{}, err
+	}
+	if !strings.HasPrefix(path, "/") {
+		path = "/" + path
+	}
+
+	expectedStatus := defaultProbeStatus
+	if _, exists := parameters[expectedStatuS]; exists {
+		expectedStatus, err = getParamAsInt(parameters, expectedStatuS)
+		if err != nil {
+			return podProbe{}, err
+		}
+		if expectedStatus < 100 || expectedStatus > 599 {
+			return podProbe{}, newParameterError(expectedStatuS, nil, language.ErrorParameterInvalid, expectedStatuS)
+		}
+	}
+
+	timeoutStr, err := getOptionalParamAsString(parameters, timeouT, defaultProbeTimeout)
+	if err != nil {
+		return podProbe{}, err
+	}
+	timeout, err := time.ParseDuration(timeoutStr)
+	if err != nil || timeout <= 0 {
+		return podProbe{}, newParameterError(timeouT, err, language.ErrorParameterInvalid, timeouT)
+	}
+
+	return podProbe{podName: podName, port: port, path: path, expectedStatus: expectedStatus, timeout: timeout}, nil
+}
@@ -0,0 +1,181 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/H0llyW00dzZ/K8sBlackPearl/language"
+	"github.com/H0llyW00dzZ/K8sBlackPearl/navigator"
+	"github.com/H0llyW00dzZ/go-urlshortner/logmonitor/constant"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// podResources holds the effective CPU and memory requests and limits of one pod, or of a set of pods.
+type podResources struct {
+	cpuRequests    resource.Quantity
+	cpuLimits      resource.Quantity
+	memoryRequests resource.Quantity
+	memoryLimits   resource.Quantity
+}
+
+// add adds the quantities of other to r.
+func (r *podResources) add(other podResources) {
+	r.cpuRequests.Add(other.cpuRequests)
+	r.cpuLimits.Add(other.cpuLimits)
+	r.memoryRequests.Add(other.memoryRequests)
+	r.memoryLimits.Add(other.memoryLimits)
+}
+
+// GetPodResourceRequests sums the CPU and memory requests and limits of the pods matching a label
+// selector, for bin-packing analysis, and reports the totals through the results channel. Each pod
+// counts with the resources the scheduler reserves for it: the sum of its containers and sidecar init
+// containers, or its largest regular init container if that is larger, plus the pod overhead.
+// Containers without a request or limit count as zero, and pods that request nothing are counted
+// separately. Pods that have Succeeded or Failed are skipped, since they no longer hold resources.
+// Nothing is modified.
+//
+// Parameters:
+//
+//	ctx context.Context: Context for cancellation; it is checked between pages and between pods.
+//	clientset kubernetes.Interface: A Kubernetes clientset for interacting with the Kubernetes API.
+//	namespace string: The namespace of the pods.
+//	labelSelector string: The label selector of the pods to sum; empty selects every pod.
+//	perPod bool: Whether to also report the resources of every pod.
+//	results chan<- string: A channel that receives the report; it must be drained concurrently when
+//	perPod is set.
+//	logger *zap.Logger: A logger for structured logging.
+//
+// Returns an error if the pods cannot be listed or the context is cancelled.
+func GetPodResourceRequests(ctx context.Context, clientset kubernetes.Interface, namespace, labelSelector string, perPod bool, results chan<- string, logger *zap.Logger) error {
+	pods, err := listAllPods(ctx, clientset, namespace, v1.ListOptions{LabelSelector: labelSelector, Limit: defaultPageSize}, 0)
+	if err != nil {
+		return reportPodResourcesFailure(results, labelSelector, err)
+	}
+
+	var total podResources
+	var counted, withoutRequests int
+	for i := range pods.Items {
+		if err := ctx.Err(); err != nil {
+			return reportPodResourcesFailure(results, labelSelector, err)
+		}
+		pod := &pods.Items[i]
+		if pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+			continue
+		}
+
+		resources := effectivePodResources(pod)
+		total.add(resources)
+		counted++
+		if resources.cpuRequests.IsZero() && resources.memoryRequests.IsZero() {
+			withoutRequests++
+		}
+		if perPod {
+			results <- fmt.Sprintf(language.PodResources, pod.Name, resources.cpuRequests.String(), resources.cpuLimits.String(), resources.memoryRequests.String(), resources.memoryLimits.String())
+		}
+	}
+
+	successMsg := fmt.Sprintf(language.PodResourcesTotal, counted, namespace, total.cpuRequests.String(), total.cpuLimits.String(), total.memoryRequests.String(), total.memoryLimits.String(), withoutRequests)
+	results <- successMsg
+	navigator.LogInfoWithEmoji(constant.SuccessEmoji, successMsg,
+		zap.String(language.LabelSelector, labelSelector),
+		zap.Int(language.PodCount, counted),
+	)
+	return nil
+}
+
+// effectivePodResources computes the requests and limits the scheduler accounts for a pod: its
+// containers and sidecar init containers (those with a restartPolicy of Always) run side by side and
+// are summed, each regular init container runs alone alongside the sidecars started before it, and
+// the larger of the two is taken, plus the pod overhead.
+//
+// This unexported function is used internally by GetPodResourceRequests.
+func effectivePodResources(pod *corev1.Pod) podResources {
+	var running, sidecars, effective podResources
+	for _, container := range pod.Spec.Containers {
+		running.add(containerResources(container))
+	}
+	for _, container := range pod.Spec.InitContainers {
+		resources := containerResources(container)
+		if container.RestartPolicy != nil && *container.RestartPolicy == corev1.ContainerRestartPolicyAlways {
+			sidecars.add(resources)
+			running.add(resources)
+			continue
+		}
+		resources.add(sidecars)
+		effective = maxPodResources(effective, resources)
+	}
+	effective = maxPodResources(effective, running)
+
+	overhead := podResources{
+		cpuRequests:    pod.Spec.Overhead[corev1.ResourceCPU],
+		cpuLimits:      pod.Spec.Overhead[corev1.ResourceCPU],
+		memoryRequests: pod.Spec.Overhead[corev1.ResourceMemory],
+		memoryLimits:   pod.Spec.Overhead[corev1.ResourceMemory],
+	}
+	effective.add(overhead)
+	return effective
+}
+
+// containerResources returns the CPU and memory requests and limits of a container; unset values
+// are zero.
+//
+// This unexported function is used internally by effectivePodResources.
+func containerResources(container corev1.Container) podResources {
+	return podResources{
+		cpuRequests:    container.Resources.Requests[corev1.ResourceCPU],
+		cpuLimits:      container.Resources.Limits[corev1.ResourceCPU],
+		memoryRequests: container.Resources.Requests[corev1.ResourceMemory],
+		memoryLimits:   container.Resources.Limits[corev1.ResourceMemory],
+	}
+}
+
+// maxPodResources returns the larger of a and b for each quantity.
+//
+// This unexported function is used internally by effectivePodResources.
+func maxPodResources(a, b podResources) podResources {
+	larger := func(x, y resource.Quantity) resource.Quantity {
+		if y.Cmp(x) > 0 {
+			return y
+		}
+		return x
+	}
+	return podResources{
+		cpuRequests:    larger(a.cpuRequests, b.cpuRequests),
+		cpuLimits:      larger(a.cpuLimits, b.cpuLimits),
+		memoryRequests: larger(a.memoryRequests, b.memoryRequests),
+		memoryLimits:   larger(a.memoryLimits, b.memoryLimits),
+	}
+}
+
+// reportPodResourcesFailure sends an error message to the results channel, logs the failure,
+// and returns the error so callers can propagate it directly.
+//
+// This unexported function is used internally by GetPodResourceRequests to report failures.
+func reportPodResourcesFailure(results chan<- string, labelSelector string, err error) error {
+	errorMessage := fmt.Sprintf(language.ErrorFailedToSumPodResources, labelSelector, err)
+	results <- errorMessage
+	navigator.LogErrorWithEmojiRateLimited(constant.ErrorEmoji, errorMessage, zap.Error(err))
+	return err
+}
+
+// extractPodResourcesParameters extracts the optional 'labelSelector' and 'perPod' parameters.
+// An empty selector sums every pod of the namespace.
+//
+// This function is used by task runners that sum pod resources.
+func extractPodResourcesParameters(parameters map[string]interface{}) (string, bool, error) {
+	selector, err := getOptionalParamAsString(parameters, labelSelector, "")
+	if err != nil {
+		return "", false, err
+	}
+
+	perPod, err := getOptionalParamAsBool(parameters, perPoD, false)
+	if err != nil {
+		return "", false, err
+	}
+
+	return selector, perPod, nil
+}
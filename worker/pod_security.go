@@ -0,0 +1,190 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/H0llyW00dzZ/K8sBlackPearl/language"
+	"github.com/H0llyW00dzZ/K8sBlackPearl/navigator"
+	"github.com/H0llyW00dzZ/go-urlshortner/logmonitor/constant"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// podSecurityPolicy selects the securityContext rules a pod security check enforces.
+type podSecurityPolicy struct {
+	requireRunAsNonRoot        bool
+	forbidPrivileged           bool
+	forbidPrivilegeEscalation  bool
+	requireDropAllCapabilities bool
+}
+
+// CheckPodSecurity audits the securityContext of every container and init container of the pods
+// matching a label selector against a policy, and reports each container that violates it, followed
+// by a summary, through the results channel. A container must run as non-root, either through
+// runAsNonRoot or a non-zero runAsUser, set on the container or inherited from the pod; must not be
+// privileged; must set allowPrivilegeEscalation to false, since it defaults to true; and must drop the
+// ALL capability. Rules the policy disables are not checked. Nothing is modified.
+//
+// Parameters:
+//
+//	ctx context.Context: Context for cancellation; it is checked between pages and between pods.
+//	clientset kubernetes.Interface: A Kubernetes clientset for interacting with the Kubernetes API.
+//	namespace string: The namespace of the pods.
+//	labelSelector string: The label selector of the pods to audit; empty selects every pod.
+//	policy podSecurityPolicy: The rules to enforce.
+//	failIfViolation bool: Whether a violation should be returned as an error.
+//	results chan<- string: A channel that receives the report; it must be drained concurrently.
+//	logger *zap.Logger: A logger for structured logging.
+//
+// Returns an error if the pods cannot be listed, the context is cancelled, or failIfViolation is set
+// and a violation is found.
+func CheckPodSecurity(ctx context.Context, clientset kubernetes.Interface, namespace, labelSelector string, policy podSecurityPolicy, failIfViolation bool, results chan<- string, logger *zap.Logger) error {
+	pods, err := listAllPods(ctx, clientset, namespace, v1.ListOptions{LabelSelector: labelSelector, Limit: defaultPageSize}, 0)
+	if err != nil {
+		return reportPodSecurityFailure(results, labelSelector, err)
+	}
+
+	violating := 0
+	for i := range pods.Items {
+		if err := ctx.Err(); err != nil {
+			return reportPodSecurityFailure(results, labelSelector, err)
+		}
+		pod := &pods.Items[i]
+		containers := append(append([]corev1.Container(nil), pod.Spec.InitContainers...), pod.Spec.Containers...)
+		for _, container := range containers {
+			violations := containerSecurityViolations(pod.Spec.SecurityContext, container.SecurityContext, policy)
+			if len(violations) == 0 {
+				continue
+			}
+			violating++
+			message := fmt.Sprintf(language.PodSecurityViolation, pod.Name, container.Name, strings.Join(violations, ", "))
+			results <- message
+			navigator.LogInfoWithEmoji(language.WarningEmoji, message,
+				zap.String(language.PodsName, pod.Name),
+				zap.Strings(language.Violations, violations),
+			)
+		}
+	}
+
+	summary := fmt.Sprintf(language.PodSecuritySummary, len(pods.Items), namespace, violating)
+	results <- summary
+	if violating == 0 {
+		navigator.LogInfoWithEmoji(constant.SuccessEmoji, summary)
+		return nil
+	}
+	navigator.LogInfoWithEmoji(language.WarningEmoji, summary)
+	if failIfViolation {
+		return markNonRetriable(fmt.Errorf(language.ErrorPodSecurityViolations, violating, namespace))
+	}
+	return nil
+}
+
+// containerSecurityViolations returns the policy rules broken by a container, given its own
+// securityContext and the securityContext of its pod, either of which may be nil.
+//
+// This unexported function is used internally by CheckPodSecurity.
+func containerSecurityViolations(podContext *corev1.PodSecurityContext, containerContext *corev1.SecurityContext, policy podSecurityPolicy) []string {
+	if containerContext == nil {
+		containerContext = &corev1.SecurityContext{}
+	}
+	if podContext == nil {
+		podContext = &corev1.PodSecurityContext{}
+	}
+
+	var violations []string
+	if policy.requireRunAsNonRoot {
+		runAsNonRoot := containerContext.RunAsNonRoot
+		if runAsNonRoot == nil {
+			runAsNonRoot = podContext.RunAsNonRoot
+		}
+		runAsUser := containerContext.RunAsUser
+		if runAsUser == nil {
+			runAsUser = podContext.RunAsUser
+		}
+		switch {
+		case runAsUser != nil && *runAsUser == 0:
+			violations = append(violations, language.ViolationRunsAsRoot)
+		case runAsUser == nil && (runAsNonRoot == nil || !*runAsNonRoot):
+			violations = append(violations, language.ViolationMayRunAsRoot)
+		}
+	}
+	if policy.forbidPrivileged && containerContext.Privileged != nil && *containerContext.Privileged {
+		violations = append(violations, language.ViolationPrivileged)
+	}
+	if policy.forbidPrivilegeEscalation && (containerContext.AllowPrivilegeEscalation == nil || *containerContext.AllowPrivilegeEscalation) {
+		violations = append(violations, language.ViolationPrivilegeEscalation)
+	}
+	if policy.requireDropAllCapabilities && !dropsAllCapabilities(containerContext.Capabilities) {
+		violations = append(violations, language.ViolationCapabilitiesNotDropped)
+	}
+	return violations
+}
+
+// dropsAllCapabilities reports whether the capabilities drop ALL.
+//
+// This unexported function is used internally by containerSecurityViolations.
+func dropsAllCapabilities(capabilities *corev1.Capabilities) bool {
+	if capabilities == nil {
+		return false
+	}
+	for _, capability := range capabilities.Drop {
+		if strings.EqualFold(string(capability), "ALL") {
+			return true
+		}
+	}
+	return false
+}
+
+// reportPodSecurityFailure sends an error message to the results channel, logs the failure,
+// and returns the error so callers can propagate it directly.
+//
+// This unexported function is used internally by CheckPodSecurity to report failures.
+func reportPodSecurityFailure(results chan<- string, labelSelector string, err error) error {
+	errorMessage := fmt.Sprintf(language.ErrorFailedToCheckPodSecurity, labelSelector, err)
+	results <- errorMessage
+	navigator.LogErrorWithEmojiRateLimited(constant.ErrorEmoji, errorMessage, zap.Error(err))
+	return err
+}
+
+// extractPodSecurityParameters extracts the optional 'labelSelector', 'policy', and 'failIfViolation'
+// parameters. An empty selector audits every pod of the namespace. The policy may disable any of the
+// 'requireRunAsNonRoot', 'forbidPrivileged', 'forbidPrivilegeEscalation', and
+// 'requireDropAllCapabilities' rules, all of which are enabled by default.
+//
+// This function is used by task runners that audit pod security.
+func extractPodSecurityParameters(parameters map[string]interface{}) (string, podSecurityPolicy, bool, error) {
+	selector, err := getOptionalParamAsString(parameters, labelSelector, "")
+	if err != nil {
+		return "", podSecurityPolicy{}, false, err
+	}
+
+	rules := map[string]interface{}{}
+	if rawPolicy, exists := parameters[policY]; exists {
+		var ok bool
+		if rules, ok = toStringInterfaceMap(rawPolicy); !ok {
+			return "", podSecurityPolicy{}, false, newParameterError(policY, nil, language.ErrorParameterInvalid, policY)
+		}
+	}
+	var policy podSecurityPolicy
+	for key, target := range map[string]*bool{
+		requireRunAsNonRooT:        &policy.requireRunAsNonRoot,
+		forbidPrivilegeD:           &policy.forbidPrivileged,
+		forbidPrivilegeEscalatioN:  &policy.forbidPrivilegeEscalation,
+		requireDropAllCapabilitieS: &policy.requireDropAllCapabilities,
+	} {
+		if *target, err = getOptionalParamAsBool(rules, key, true); err != nil {
+			return "", podSecurityPolicy{}, false, err
+		}
+	}
+
+	failIfViolation, err := getOptionalParamAsBool(parameters, failIfViolatioN, false)
+	if err != nil {
+		return "", podSecurityPolicy{}, false, err
+	}
+
+	return selector, policy, failIfViolation, nil
+}
@@ -0,0 +1,92 @@
+package worker
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/H0llyW00dzZ/K8sBlackPearl/worker/configuration"
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+// newPodSecurityClientset returns a clientset holding a compliant pod, a pod whose container runs
+// privileged as root, and a pod without any securityContext.
+func newPodSecurityClientset() *fake.Clientset {
+	yes, no, root := true, false, int64(0)
+	hardened := &corev1.SecurityContext{
+		RunAsNonRoot:             &yes,
+		AllowPrivilegeEscalation: &no,
+		Capabilities:             &corev1.Capabilities{Drop: []corev1.Capability{"ALL"}},
+	}
+	audited := map[string]string{"audit": "true"}
+	return fake.NewSimpleClientset(
+		&corev1.Pod{
+			ObjectMeta: v1.ObjectMeta{Name: "hardened", Namespace: "default", Labels: audited},
+			Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: "app", SecurityContext: hardened}}},
+		},
+		&corev1.Pod{
+			ObjectMeta: v1.ObjectMeta{Name: "rootful", Namespace: "default", Labels: audited},
+			Spec: corev1.PodSpec{
+				SecurityContext: &corev1.PodSecurityContext{RunAsUser: &root},
+				Containers: []corev1.Container{{Name: "app", SecurityContext: &corev1.SecurityContext{
+					Privileged:               &yes,
+					AllowPrivilegeEscalation: &no,
+					Capabilities:             &corev1.Capabilities{Drop: []corev1.Capability{"all"}},
+				}}},
+			},
+		},
+		&corev1.Pod{
+			ObjectMeta: v1.ObjectMeta{Name: "bare", Namespace: "default", Labels: audited},
+			Spec:       corev1.PodSpec{InitContainers: []corev1.Container{{Name: "init"}}, Containers: []corev1.Container{{Name: "app", SecurityContext: hardened}}},
+		},
+		&corev1.Pod{
+			ObjectMeta: v1.ObjectMeta{Name: "unaudited", Namespace: "default"},
+			Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: "app"}}},
+		},
+	)
+}
+
+func TestCrewCheckPodSecurityReportsViolations(t *testing.T) {
+	for _, failIfViolation := range []bool{false, true} {
+		parameters := map[string]interface{}{"labelSelector": "audit=true", "failIfViolation": failIfViolation}
+		task := configuration.Task{Name: "audit", Type: "CrewCheckPodSecurity", Parameters: parameters}
+		var collector resultCollector
+
+		err := (&CrewCheckPodSecurity{}).Run(collector.context(context.Background()), newPodSecurityClientset(), "default", task, parameters, 0)
+		if failIfViolation != (err != nil) {
+			t.Fatalf("failIfViolation %v: got error %v", failIfViolation, err)
+		}
+		if err != nil && !isNonRetriable(err) {
+			t.Fatalf("got error %v, want violations to fail without retries", err)
+		}
+
+		want := []string{
+			"Pod 'bare' container 'init' violates the security policy: may run as root (runAsNonRoot not set), allows privilege escalation, does not drop ALL capabilities",
+			"Pod 'rootful' container 'app' violates the security policy: runs as root (runAsUser 0), privileged",
+			"Pod security check complete: 3 pod(s) checked in namespace 'default', 2 container(s) with violations",
+		}
+		if got := collector.all(); !reflect.DeepEqual(got, want) {
+			t.Fatalf("failIfViolation %v: got results %q, want %q", failIfViolation, got, want)
+		}
+	}
+}
+
+func TestCrewCheckPodSecurityHonorsThePolicy(t *testing.T) {
+	parameters := map[string]interface{}{
+		"labelSelector":   "audit=true",
+		"failIfViolation": true,
+		"policy": map[string]interface{}{
+			"requireRunAsNonRoot":        false,
+			"forbidPrivileged":           false,
+			"forbidPrivilegeEscalation":  false,
+			"requireDropAllCapabilities": false,
+		},
+	}
+	task := configuration.Task{Name: "audit", Type: "CrewCheckPodSecurity", Parameters: parameters}
+
+	if err := (&CrewCheckPodSecurity{}).Run(context.Background(), newPodSecurityClientset(), "default", task, parameters, 0); err != nil {
+		t.Fatalf("got error %v with every rule disabled, want the pods to comply", err)
+	}
+}
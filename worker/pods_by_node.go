@@ -0,0 +1,103 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/H0llyW00dzZ/K8sBlackPearl/language"
+	"github.com/H0llyW00dzZ/K8sBlackPearl/navigator"
+	"go.uber.org/zap"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/client-go/kubernetes"
+)
+
+// nodePodGroup is the set of pods scheduled on a single node.
+// Pods that have not been scheduled yet are grouped under an empty node name.
+type nodePodGroup struct {
+	nodeName string
+	podNames []string
+}
+
+// groupPodsByNode lists the pods in a namespace and groups them by spec.nodeName. The list can be
+// narrowed with a label selector and restricted to a single node, in which case the node is matched
+// with a field selector on the API server. Groups are sorted by node name, with unscheduled pods last.
+//
+// Parameters:
+//
+//	ctx context.Context: Context for cancellation and timeout.
+//	clientset kubernetes.Interface: A Kubernetes clientset for interacting with the Kubernetes API.
+//	namespace string: The namespace whose pods should be grouped.
+//	labelSelector string: An optional label selector to filter pods.
+//	nodeName string: An optional node name restricting the listing to a single node.
+//
+// Returns:
+//
+//	[]nodePodGroup: The pods grouped by node.
+//	error: An error if the pods cannot be listed.
+func groupPodsByNode(ctx context.Context, clientset kubernetes.Interface, namespace, labelSelector, nodeName string) ([]nodePodGroup, error) {
+	listOptions := v1.ListOptions{LabelSelector: labelSelector, Limit: defaultPageSize}
+	if nodeName != "" {
+		listOptions.FieldSelector = fields.OneTermEqualSelector(specNodeName, nodeName).String()
+	}
+
+	pods, err := listAllPods(ctx, clientset, namespace, listOptions, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	byNode := make(map[string][]string)
+	for _, pod := range pods.Items {
+		byNode[pod.Spec.NodeName] = append(byNode[pod.Spec.NodeName], pod.Name)
+	}
+
+	groups := make([]nodePodGroup, 0, len(byNode))
+	for node, names := range byNode {
+		sort.Strings(names)
+		groups = append(groups, nodePodGroup{nodeName: node, podNames: names})
+	}
+	sort.Slice(groups, func(i, j int) bool {
+		// Unscheduled pods have an empty node name and are reported after every node.
+		if groups[i].nodeName == "" || groups[j].nodeName == "" {
+			return groups[j].nodeName == ""
+		}
+		return groups[i].nodeName < groups[j].nodeName
+	})
+	return groups, nil
+}
+
+// reportPodsByNode sends one line per node through the results channel and logs the same
+// information with structured fields. It stops early if the context is cancelled.
+//
+// Parameters:
+//
+//	ctx context.Context: Context for cancellation.
+//	baseFields []zap.Field: A slice of zap.Field structs providing contextual logging information.
+//	groups []nodePodGroup: The pods grouped by node.
+//	results chan<- string: A channel with room for one message per group.
+//
+// Returns an error if the context is cancelled before all groups are reported.
+func reportPodsByNode(ctx context.Context, baseFields []zap.Field, groups []nodePodGroup, results chan<- string) error {
+	for _, group := range groups {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		nodeName := group.nodeName
+		if nodeName == "" {
+			nodeName = language.UnscheduledPods
+		}
+		message := fmt.Sprintf(language.NodePodsSummary, nodeName, len(group.podNames), strings.Join(group.podNames, ", "))
+		results <- message
+
+		groupFields := append([]zap.Field(nil), baseFields...)
+		groupFields = append(groupFields,
+			zap.String(language.NodeName, nodeName),
+			zap.Int(language.PodCount, len(group.podNames)),
+			zap.Strings(language.PodNames, group.podNames),
+		)
+		navigator.LogInfoWithEmoji(language.PirateEmoji, message, groupFields...)
+	}
+	return nil
+}
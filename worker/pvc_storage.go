@@ -16,14 +16,14 @@ import (
 // Parameters:
 //
 //	ctx context.Context: Context for cancellation and timeout.
-//	clientset *kubernetes.Clientset: A Kubernetes clientset to interact with the Kubernetes API.
+//	clientset kubernetes.Interface: A Kubernetes clientset to interact with the Kubernetes API.
 //	shipsNamespace: The Kubernetes namespace in which to create the PVC.
 //	storageClassName: The name of the storage class to use for the PVC.
 //	pvcName: The name of the PVC to create.
 //	storageSize string: The size of the PVC in gigabytes.
 //
 // Returns an error if the PVC cannot be created.
-func createPVC(ctx context.Context, clientset *kubernetes.Clientset, shipsNamespace, storageClassName, pvcName, storageSize string) error {
+func createPVC(ctx context.Context, clientset kubernetes.Interface, shipsNamespace, storageClassName, pvcName, storageSize string) error {
 	// Define the PVC object.
 	pvc := &corev1.PersistentVolumeClaim{
 		ObjectMeta: v1.ObjectMeta{
@@ -46,10 +46,12 @@ func createPVC(ctx context.Context, clientset *kubernetes.Clientset, shipsNamesp
 	}
 
 	// Create the PVC using the Kubernetes API.
-	_, err := clientset.CoreV1().PersistentVolumeClaims(shipsNamespace).Create(ctx, pvc, v1.CreateOptions{})
+	applyDefaultObjectMeta(pvc)
+	created, err := clientset.CoreV1().PersistentVolumeClaims(shipsNamespace).Create(ctx, pvc, v1.CreateOptions{})
 	if err != nil {
 		return fmt.Errorf(language.ErrorCreatingPvc, err)
 	}
+	publishCreatedObject(ctx, created)
 
 	return nil
 }
@@ -0,0 +1,105 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/H0llyW00dzZ/K8sBlackPearl/language"
+	"github.com/H0llyW00dzZ/K8sBlackPearl/navigator"
+	"github.com/H0llyW00dzZ/go-urlshortner/logmonitor/constant"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// WaitForPVCBound polls a PersistentVolumeClaim until its phase is Bound, the claim is Lost, or the
+// timeout elapses. A progress message is sent through the results channel whenever the phase changes,
+// and the bound volume is reported on success. A timeout is reported with the last observed phase.
+//
+// Parameters:
+//
+//	ctx context.Context: Context for cancellation; the wait is additionally bounded by timeout.
+//	clientset kubernetes.Interface: A Kubernetes clientset for interacting with the Kubernetes API.
+//	namespace string: The namespace of the PVC.
+//	claimName string: The name of the PVC to wait for.
+//	timeout time.Duration: The maximum time to wait.
+//	results chan<- string: A channel that receives progress messages; it must be drained concurrently.
+//	logger *zap.Logger: A logger for structured logging.
+//
+// Returns an error if the PVC is lost, cannot be read, or is not bound before the timeout.
+func WaitForPVCBound(ctx context.Context, clientset kubernetes.Interface, namespace, claimName string, timeout time.Duration, results chan<- string, logger *zap.Logger) error {
+	waitCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	var lastPhase corev1.PersistentVolumeClaimPhase
+	for {
+		pvc, err := clientset.CoreV1().PersistentVolumeClaims(namespace).Get(waitCtx, claimName, v1.GetOptions{})
+		switch {
+		case err != nil && waitCtx.Err() != nil:
+			// The wait ended while the request was in flight; report it below.
+		case apierrors.IsNotFound(err):
+			return reportPVCWaitFailure(results, claimName, markNonRetriable(fmt.Errorf(language.ErrorPVCNotFound, claimName, namespace)))
+		case err != nil:
+			return reportPVCWaitFailure(results, claimName, err)
+		default:
+			switch pvc.Status.Phase {
+			case corev1.ClaimBound:
+				successMsg := fmt.Sprintf(language.PVCBound, claimName, pvc.Spec.VolumeName)
+				results <- successMsg
+				navigator.LogInfoWithEmoji(constant.SuccessEmoji, successMsg)
+				return nil
+			case corev1.ClaimLost:
+				return reportPVCWaitFailure(results, claimName, markNonRetriable(fmt.Errorf(language.ErrorPVCLost, claimName, pvc.Spec.VolumeName)))
+			}
+
+			if pvc.Status.Phase != lastPhase {
+				results <- fmt.Sprintf(language.PVCPhase, claimName, pvc.Status.Phase)
+				lastPhase = pvc.Status.Phase
+			}
+		}
+
+		if !waitForNextAttempt(waitCtx, podPollInterval) {
+			// A cancellation of the caller's context is not a timeout, so it is returned as is.
+			if err := ctx.Err(); err != nil {
+				return reportPVCWaitFailure(results, claimName, err)
+			}
+			return reportPVCWaitFailure(results, claimName, fmt.Errorf(language.ErrorPVCNotBoundInTime, claimName, timeout, lastPhase))
+		}
+	}
+}
+
+// reportPVCWaitFailure sends an error message to the results channel, logs the failure,
+// and returns the error so callers can propagate it directly.
+//
+// This unexported function is used internally by WaitForPVCBound to report failures.
+func reportPVCWaitFailure(results chan<- string, claimName string, err error) error {
+	errorMessage := fmt.Sprintf(language.ErrorFailedWaitingForPVC, claimName, err)
+	results <- errorMessage
+	navigator.LogErrorWithEmojiRateLimited(constant.ErrorEmoji, errorMessage, zap.Error(err))
+	return err
+}
+
+// extractPVCWaitParameters extracts and validates the 'pvcName' and optional 'timeout' parameters.
+// The timeout defaults to defaultWatchTimeout.
+//
+// This function is used by task runners that wait for PVCs.
+func extractPVCWaitParameters(parameters map[string]interface{}) (string, time.Duration, error) {
+	claimName, err := getParamAsString(parameters, pvcName)
+	if err != nil || claimName == "" {
+		return "", 0, newParameterError(pvcName, err, language.ErrorParameterpvcName)
+	}
+
+	timeoutStr, err := getOptionalParamAsString(parameters, timeouT, defaultWatchTimeout)
+	if err != nil {
+		return "", 0, err
+	}
+	timeout, err := time.ParseDuration(timeoutStr)
+	if err != nil || timeout <= 0 {
+		return "", 0, newParameterError(timeouT, err, language.ErrorParameterInvalid, timeouT)
+	}
+
+	return claimName, timeout, nil
+}
@@ -0,0 +1,51 @@
+package worker
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/H0llyW00dzZ/ChatGPT-Next-Web-Session-Exporter/bannercli"
+	"github.com/H0llyW00dzZ/K8sBlackPearl/language"
+	"github.com/H0llyW00dzZ/K8sBlackPearl/navigator"
+	"go.uber.org/zap"
+)
+
+// quietStartup is the package-level switch that replaces the startup banners with a log line.
+var (
+	quietStartup   bool
+	quietStartupMu sync.RWMutex
+)
+
+// SetQuietStartup controls whether the client constructors print their animated startup banners,
+// in a thread-safe manner. When quiet is true, the banners and their pauses are skipped and a single
+// structured log line reports whether the in-cluster or the out-of-cluster configuration was loaded,
+// which suits production log pipelines. The default, false, keeps the banners for interactive use.
+func SetQuietStartup(quiet bool) {
+	quietStartupMu.Lock()
+	defer quietStartupMu.Unlock()
+	quietStartup = quiet
+}
+
+// isQuietStartup reports whether the startup banners are suppressed.
+//
+// This unexported function is used internally by loadConfigForMode and buildKubeconfigConfig.
+func isQuietStartup() bool {
+	quietStartupMu.RLock()
+	defer quietStartupMu.RUnlock()
+	return quietStartup
+}
+
+// announceClientMode reports that the client configuration was loaded in the given mode: as the
+// ready banner by default, or as a single structured log line in quiet mode.
+//
+// This unexported function is used internally by loadConfigForMode and buildKubeconfigConfig.
+func announceClientMode(mode string) {
+	if !isQuietStartup() {
+		bannercli.PrintTypingBanner(readyTogo, 200*time.Millisecond)
+		return
+	}
+	navigator.LogInfoWithEmoji(language.PirateEmoji, fmt.Sprintf(language.KubernetesClientConfigured, mode),
+		zap.String(language.ClientMode, mode),
+	)
+}
@@ -0,0 +1,42 @@
+package worker
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestQuietStartupReplacesTheBannersWithALogLine(t *testing.T) {
+	SetQuietStartup(true)
+	t.Cleanup(func() { SetQuietStartup(false) })
+	path := writeKubeconfig(t)
+	logs := observeLogs(t)
+	output, err := os.Create(filepath.Join(t.TempDir(), "stdout"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer output.Close()
+	stdout := os.Stdout
+	os.Stdout = output
+	t.Cleanup(func() { os.Stdout = stdout })
+
+	if _, err := buildConfigWithOptions(KubeClientOptions{KubeconfigPath: path}); err != nil {
+		t.Fatalf("buildConfigWithOptions: %v", err)
+	}
+	if _, err := buildConfigWithOptions(KubeClientOptions{KubeconfigPath: filepath.Join(t.TempDir(), "missing")}); err == nil {
+		t.Fatal("a missing kubeconfig was accepted")
+	}
+	os.Stdout = stdout
+
+	printed, err := os.ReadFile(output.Name())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(printed) != 0 {
+		t.Fatalf("got banner output %q in quiet mode, want none", printed)
+	}
+	entries := logs.FilterFieldKey("client_mode").All()
+	if len(entries) != 1 || entries[0].ContextMap()["client_mode"] != "out-of-cluster" {
+		t.Fatalf("got %d client mode log lines, want one reporting out-of-cluster", len(entries))
+	}
+}
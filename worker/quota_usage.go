@@ -0,0 +1,152 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/H0llyW00dzZ/K8sBlackPearl/language"
+	"github.com/H0llyW00dzZ/K8sBlackPearl/navigator"
+	"github.com/H0llyW00dzZ/go-urlshortner/logmonitor/constant"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// quotaUsage is the usage of a single resource limited by a ResourceQuota.
+type quotaUsage struct {
+	quotaName string
+	resource  corev1.ResourceName
+	used      resource.Quantity
+	hard      resource.Quantity
+	remaining resource.Quantity
+	percent   int64
+}
+
+// exhausted reports whether the resource is fully consumed.
+func (u quotaUsage) exhausted() bool {
+	return u.used.Cmp(u.hard) >= 0
+}
+
+// nearLimit reports whether the resource has reached quotaNearLimitPercent of its limit.
+func (u quotaUsage) nearLimit() bool {
+	return u.percent >= quotaNearLimitPercent
+}
+
+// getResourceQuotaUsage lists the ResourceQuotas of a namespace and computes, for every resource they
+// limit, the used amount, the hard limit, the remaining headroom, and the percentage consumed.
+//
+// Parameters:
+//
+//	ctx context.Context: Context for cancellation and timeout.
+//	clientset kubernetes.Interface: A Kubernetes clientset for interacting with the Kubernetes API.
+//	namespace string: The namespace whose quotas are inspected.
+//
+// Returns:
+//
+//	[]quotaUsage: The usage of each limited resource, sorted by quota and resource name.
+//	error: An error if the quotas cannot be listed.
+func getResourceQuotaUsage(ctx context.Context, clientset kubernetes.Interface, namespace string) ([]quotaUsage, error) {
+	quotas, err := clientset.CoreV1().ResourceQuotas(namespace).List(ctx, v1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf(language.ErrorListingResourceQuotas, err)
+	}
+
+	var usages []quotaUsage
+	for _, quota := range quotas.Items {
+		for name, hard := range quota.Status.Hard {
+			used := quota.Status.Used[name]
+			remaining := hard.DeepCopy()
+			remaining.Sub(used)
+			if remaining.Sign() < 0 {
+				remaining = resource.Quantity{Format: hard.Format}
+			}
+
+			var percent int64 = 100
+			if hard.MilliValue() > 0 {
+				percent = used.MilliValue() * 100 / hard.MilliValue()
+			}
+			usages = append(usages, quotaUsage{
+				quotaName: quota.Name,
+				resource:  name,
+				used:      used,
+				hard:      hard,
+				remaining: remaining,
+				percent:   percent,
+			})
+		}
+	}
+
+	sort.Slice(usages, func(i, j int) bool {
+		if usages[i].quotaName != usages[j].quotaName {
+			return usages[i].quotaName < usages[j].quotaName
+		}
+		return usages[i].resource < usages[j].resource
+	})
+	return usages, nil
+}
+
+// reportResourceQuotaUsage sends one line per limited resource through the results channel and logs
+// the same information with structured fields. Resources at or near their limit are flagged. A
+// namespace without quotas is reported with a single line. It stops early if the context is cancelled.
+//
+// Parameters:
+//
+//	ctx context.Context: Context for cancellation.
+//	baseFields []zap.Field: A slice of zap.Field structs providing contextual logging information.
+//	namespace string: The namespace whose quotas are reported.
+//	usages []quotaUsage: The resource usages to report.
+//	failIfExhausted bool: Whether a fully consumed resource should be returned as an error.
+//	results chan<- string: A channel with room for one message per usage, and at least one message.
+//
+// Returns an error if the context is cancelled, or if failIfExhausted is set and any resource is
+// fully consumed.
+func reportResourceQuotaUsage(ctx context.Context, baseFields []zap.Field, namespace string, usages []quotaUsage, failIfExhausted bool, results chan<- string) error {
+	if len(usages) == 0 {
+		message := fmt.Sprintf(language.NoResourceQuotas, namespace)
+		results <- message
+		navigator.LogInfoWithEmoji(language.PirateEmoji, message, baseFields...)
+		return nil
+	}
+
+	var exhausted []string
+	for _, usage := range usages {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		usageFields := append([]zap.Field(nil), baseFields...)
+		usageFields = append(usageFields,
+			zap.String(language.QuotaName, usage.quotaName),
+			zap.String(language.QuotaResource, string(usage.resource)),
+			zap.String(language.QuotaUsed, usage.used.String()),
+			zap.String(language.QuotaHard, usage.hard.String()),
+			zap.String(language.QuotaRemaining, usage.remaining.String()),
+			zap.Int64(language.QuotaPercentUsed, usage.percent),
+		)
+
+		switch {
+		case usage.exhausted():
+			message := fmt.Sprintf(language.QuotaExhausted, usage.quotaName, usage.resource, usage.used.String(), usage.hard.String())
+			results <- message
+			navigator.LogInfoWithEmoji(language.WarningEmoji, message, usageFields...)
+			exhausted = append(exhausted, usage.quotaName+"/"+string(usage.resource))
+		case usage.nearLimit():
+			message := fmt.Sprintf(language.QuotaNearLimit, usage.quotaName, usage.resource, usage.used.String(), usage.hard.String(), usage.percent, usage.remaining.String())
+			results <- message
+			navigator.LogInfoWithEmoji(language.WarningEmoji, message, usageFields...)
+		default:
+			message := fmt.Sprintf(language.QuotaUsage, usage.quotaName, usage.resource, usage.used.String(), usage.hard.String(), usage.percent, usage.remaining.String())
+			results <- message
+			navigator.LogInfoWithEmoji(constant.SuccessEmoji, message, usageFields...)
+		}
+	}
+
+	if failIfExhausted && len(exhausted) > 0 {
+		return markNonRetriable(fmt.Errorf(language.ErrorQuotasExhausted, strings.Join(exhausted, ", ")))
+	}
+	return nil
+}
@@ -0,0 +1,166 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/H0llyW00dzZ/K8sBlackPearl/language"
+	"github.com/H0llyW00dzZ/K8sBlackPearl/navigator"
+	"github.com/H0llyW00dzZ/K8sBlackPearl/worker/configuration"
+	"go.uber.org/zap"
+	"k8s.io/client-go/kubernetes"
+)
+
+// cycleTallyKey is the context key under which the outcome tally of a reconcile cycle is stored.
+type cycleTallyKey struct{}
+
+// cycleTally counts the task outcomes of a single reconcile cycle. It is safe for concurrent use
+// by multiple workers.
+type cycleTally struct {
+	mu       sync.Mutex
+	outcomes map[string]int
+}
+
+// RunContinuous runs the task list repeatedly, starting a new cycle every interval, until the context
+// is cancelled, for controller-style operation. Every cycle starts workerCount workers with a fresh
+// secret cache and a fresh task output store, and claims its tasks through the store set with
+// SetClaimStore, or a fresh TaskStatusMap. The claims a cycle still holds when it ends are released,
+// so each task executes once per cycle, even through a shared store; tasks with an IdempotencyKey
+// already recorded by the completion store are still skipped. Cycles never overlap: a tick that fires
+// while the previous cycle is still running is skipped and logged. After each cycle a summary of its
+// outcomes is logged and sent through the results channel.
+//
+// Parameters:
+//
+//	ctx context.Context: Context whose cancellation stops the loop and the cycle in flight.
+//	clientset kubernetes.Interface: Kubernetes API client for task operations.
+//	tasks []configuration.Task: Slice of Task structs to be executed every cycle.
+//	workerCount int: Number of worker goroutines to start for each cycle.
+//	interval time.Duration: Time between the starts of consecutive cycles; it must be positive.
+//
+// Returns:
+//
+//	<-chan string: A read-only channel to receive task results and cycle summaries. It is closed once
+//	the context is cancelled and the cycle in flight has finished, and must be drained until then.
+//	error: An error if the interval is not positive, in which case no cycle is started.
+func RunContinuous(ctx context.Context, clientset kubernetes.Interface, tasks []configuration.Task, workerCount int, interval time.Duration) (<-chan string, error) {
+	if interval <= 0 {
+		return nil, fmt.Errorf(language.ErrorReconcileIntervalInvalid, interval)
+	}
+	results := make(chan string)
+	logTaskSummary(tasks)
+
+	go func() {
+		defer close(results)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		cycle := 1
+		done := make(chan struct{})
+		go runReconcileCycle(ctx, clientset, tasks, workerCount, cycle, results, done)
+		for {
+			select {
+			case <-ctx.Done():
+				<-done // The cycle in flight observes the cancellation; wait for it to return.
+				return
+			case <-ticker.C:
+			}
+
+			select {
+			case <-done:
+			default:
+				navigator.LogInfoWithEmoji(language.WarningEmoji, fmt.Sprintf(language.ReconcileTickSkipped, cycle),
+					zap.Int(language.ReconcileCycle, cycle),
+				)
+				continue
+			}
+			if ctx.Err() != nil {
+				return
+			}
+			cycle++
+			done = make(chan struct{})
+			go runReconcileCycle(ctx, clientset, tasks, workerCount, cycle, results, done)
+		}
+	}()
+
+	return results, nil
+}
+
+// runReconcileCycle runs a single cycle of RunContinuous to completion, releases the claims it still
+// holds, then logs its summary, sends it through the results channel, and closes done.
+//
+// This unexported function is used internally by RunContinuous.
+func runReconcileCycle(ctx context.Context, clientset kubernetes.Interface, tasks []configuration.Task, workerCount, cycle int, results chan<- string, done chan<- struct{}) {
+	defer close(done)
+
+	tally := &cycleTally{outcomes: make(map[string]int)}
+	cycleCtx := context.WithValue(ctx, cycleTallyKey{}, tally)
+	cycleCtx = WithSecretCache(cycleCtx, NewSecretCache())
+	cycleCtx = WithTaskOutputStore(cycleCtx, newRunTaskOutputStore(tasks))
+
+	claims := newRunClaimStore()
+	start := time.Now()
+	startCrew(cycleCtx, clientset, tasks, workerCount, 0, results, claims).Wait()
+	elapsed := time.Since(start).Truncate(time.Millisecond)
+
+	// Successful tasks keep their claim; releasing it lets the next cycle run them again when the
+	// store outlives the cycle. The claims are released even when the loop is being cancelled.
+	releaseCtx := context.WithoutCancel(ctx)
+	for _, task := range tasks {
+		claims.Release(releaseCtx, task.Name)
+	}
+
+	tally.mu.Lock()
+	succeeded := tally.outcomes[language.OutcomeSuccess]
+	failed := tally.outcomes[language.OutcomeFailure]
+	warnings := tally.outcomes[language.OutcomeWarning]
+	skipped := tally.outcomes[language.OutcomeSkipped]
+	interrupted := tally.outcomes[language.OutcomeDeadlineExceeded]
+	tally.mu.Unlock()
+
+	summary := fmt.Sprintf(language.ReconcileCycleSummary, cycle, elapsed, succeeded, failed, warnings, skipped, interrupted)
+	navigator.LogInfoWithEmoji(language.PirateEmoji, summary,
+		zap.Int(language.ReconcileCycle, cycle),
+		zap.Duration(language.CycleDuration, elapsed),
+		zap.Int(language.OutcomeSuccess, succeeded),
+		zap.Int(language.OutcomeFailure, failed),
+		zap.Int(language.OutcomeWarning, warnings),
+		zap.Int(language.OutcomeSkipped, skipped),
+		zap.Int(language.OutcomeDeadlineExceeded, interrupted),
+	)
+	results <- summary
+}
+
+// recordCycleOutcome counts a task outcome in the tally of the reconcile cycle carried by the
+// context, if any.
+//
+// This unexported function is used internally by processTask.
+func recordCycleOutcome(ctx context.Context, outcome string) {
+	tally, _ := ctx.Value(cycleTallyKey{}).(*cycleTally)
+	if tally == nil {
+		return
+	}
+	tally.mu.Lock()
+	defer tally.mu.Unlock()
+	tally.outcomes[outcome]++
+}
+
+// taskOutcome classifies the result of a task execution as one of the Outcome constants. A failure
+// is a warning when the task is marked ContinueOnError, unless the run deadline interrupted it.
+//
+// This unexported function is used internally by processTask.
+func taskOutcome(task configuration.Task, err error) string {
+	switch {
+	case err == nil:
+		return language.OutcomeSuccess
+	case errors.Is(err, ErrRunDeadlineExceeded):
+		return language.OutcomeDeadlineExceeded
+	case task.ContinueOnError:
+		return language.OutcomeWarning
+	default:
+		return language.OutcomeFailure
+	}
+}
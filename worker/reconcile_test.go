@@ -0,0 +1,66 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/H0llyW00dzZ/K8sBlackPearl/worker/configuration"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestRunContinuousRejectsNonPositiveInterval(t *testing.T) {
+	for _, interval := range []time.Duration{0, -time.Second} {
+		if results, err := RunContinuous(context.Background(), fake.NewSimpleClientset(), nil, 1, interval); err == nil || results != nil {
+			t.Fatalf("interval %v: got results %v and error %v, want only an error", interval, results, err)
+		}
+	}
+}
+
+func TestRunContinuousReleasesClaimsOfASharedStore(t *testing.T) {
+	SetClaimStore(NewTaskStatusMap())
+	t.Cleanup(func() { SetClaimStore(nil) })
+
+	var runs atomic.Int32
+	registerTestRunner(t, "TestCountRuns", func(_ context.Context, _ kubernetes.Interface, _ string, _ configuration.Task, _ map[string]interface{}, _ int) error {
+		runs.Add(1)
+		return nil
+	})
+	registerTestRunner(t, "TestRunDeadline", func(_ context.Context, _ kubernetes.Interface, _ string, _ configuration.Task, _ map[string]interface{}, _ int) error {
+		return fmt.Errorf("interrupted: %w", ErrRunDeadlineExceeded)
+	})
+	tasks := []configuration.Task{
+		{Name: "count", Type: "TestCountRuns", ShipsNamespace: "default", MaxRetries: 1, RetryDelay: "1ms"},
+		{Name: "deadline", Type: "TestRunDeadline", ShipsNamespace: "default", MaxRetries: 1, RetryDelay: "1ms"},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	results, err := RunContinuous(ctx, fake.NewSimpleClientset(), tasks, 1, 20*time.Millisecond)
+	if err != nil {
+		t.Fatalf("RunContinuous: %v", err)
+	}
+
+	var summaries []string
+	for result := range results {
+		if strings.HasPrefix(result, "Reconcile cycle") {
+			summaries = append(summaries, result)
+			if len(summaries) == 2 {
+				cancel()
+			}
+		}
+	}
+
+	if got := runs.Load(); got < 2 {
+		t.Fatalf("the task ran %d time(s) over %d cycles, want once per cycle", got, len(summaries))
+	}
+	for _, summary := range summaries {
+		if !strings.Contains(summary, "1 succeeded, 0 failed, 0 warning(s), 0 skipped, 1 interrupted by the run deadline") {
+			t.Fatalf("unexpected cycle summary %q", summary)
+		}
+	}
+}
@@ -0,0 +1,74 @@
+package worker
+
+import (
+	"context"
+	"sort"
+
+	"github.com/H0llyW00dzZ/K8sBlackPearl/language"
+	"go.uber.org/zap"
+)
+
+// requestMetadataKey is the context key under which RequestMetadata is stored.
+type requestMetadataKey struct{}
+
+// RequestMetadata carries request-scoped values, such as a tenant or trace identifier, that callers
+// want to see on every log entry written by the runners of a run. Empty fields are omitted.
+//
+// Fields:
+//
+//	RequestID string: An identifier of the request that started the run.
+//	TenantID string: The tenant on whose behalf the tasks run.
+//	TraceID string: A distributed tracing identifier.
+//	Extra map[string]string: Additional values, logged under their own keys.
+type RequestMetadata struct {
+	RequestID string
+	TenantID  string
+	TraceID   string
+	Extra     map[string]string
+}
+
+// WithRequestMetadata returns a copy of the context carrying the given RequestMetadata.
+// Pass the returned context to CaptainTellWorkers or CrewWorker so that the runners include
+// the metadata in their structured log fields.
+func WithRequestMetadata(ctx context.Context, md RequestMetadata) context.Context {
+	return context.WithValue(ctx, requestMetadataKey{}, md)
+}
+
+// RequestMetadataFromContext returns the RequestMetadata carried by the context, if any.
+func RequestMetadataFromContext(ctx context.Context) (RequestMetadata, bool) {
+	md, ok := ctx.Value(requestMetadataKey{}).(RequestMetadata)
+	return md, ok
+}
+
+// requestMetadataFields converts the RequestMetadata carried by the context into zap fields.
+// It returns nil when the context carries no metadata.
+//
+// This unexported function is used internally by createLogFieldsForRunnerTask.
+func requestMetadataFields(ctx context.Context) []zap.Field {
+	md, ok := RequestMetadataFromContext(ctx)
+	if !ok {
+		return nil
+	}
+
+	var fields []zap.Field
+	if md.RequestID != "" {
+		fields = append(fields, zap.String(language.RequestID, md.RequestID))
+	}
+	if md.TenantID != "" {
+		fields = append(fields, zap.String(language.TenantID, md.TenantID))
+	}
+	if md.TraceID != "" {
+		fields = append(fields, zap.String(language.TraceID, md.TraceID))
+	}
+
+	// Sort the extra keys so the field order is stable across log entries.
+	keys := make([]string, 0, len(md.Extra))
+	for key := range md.Extra {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		fields = append(fields, zap.String(key, md.Extra[key]))
+	}
+	return fields
+}
@@ -0,0 +1,52 @@
+package worker
+
+import (
+	"context"
+	"testing"
+
+	"github.com/H0llyW00dzZ/K8sBlackPearl/worker/configuration"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestRequestMetadataAppearsInRunnerLogFields(t *testing.T) {
+	clientset := fake.NewSimpleClientset(&appsv1.Deployment{
+		ObjectMeta: v1.ObjectMeta{Name: "api", Namespace: "default"},
+	})
+	tasks := []configuration.Task{{
+		Name:           "annotate-api",
+		Type:           "CrewAnnotateDeployment",
+		ShipsNamespace: "default",
+		MaxRetries:     1,
+		RetryDelay:     "1ms",
+		Parameters:     map[string]interface{}{"deploymentName": "api", "annotationKey": "owner", "annotationValue": "sparrow"},
+	}}
+	ctx := WithRequestMetadata(context.Background(), RequestMetadata{
+		RequestID: "req-1",
+		TenantID:  "black-pearl",
+		TraceID:   "trace-7",
+		Extra:     map[string]string{"ship": "interceptor"},
+	})
+	logs := observeLogs(t)
+
+	runCrewToCompletion(ctx, clientset, tasks, 1)
+
+	want := map[string]string{"request_id": "req-1", "tenant_id": "black-pearl", "trace_id": "trace-7", "ship": "interceptor"}
+	runnerEntries := 0
+	for _, entry := range logs.All() {
+		fields := entry.ContextMap()
+		if fields["task_name"] != "annotate-api" {
+			continue
+		}
+		runnerEntries++
+		for key, value := range want {
+			if fields[key] != value {
+				t.Fatalf("the entry %q has %s=%v, want %q; fields: %v", entry.Message, key, fields[key], value, fields)
+			}
+		}
+	}
+	if runnerEntries == 0 {
+		t.Fatal("the runner logged no entry with its task fields")
+	}
+}
@@ -0,0 +1,151 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/H0llyW00dzZ/K8sBlackPearl/language"
+	"github.com/H0llyW00dzZ/K8sBlackPearl/navigator"
+	"github.com/H0llyW00dzZ/go-urlshortner/logmonitor/constant"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+)
+
+// RestartPods deletes the pods matching a label selector so that their controllers recreate them,
+// without touching the controllers themselves. In rolling mode the pods are deleted one at a time, in
+// name order, and each deletion waits for a new pod matching the selector to become healthy, as
+// determined by CrewCheckingisPodHealthy, before moving on, so the workload never loses more than one
+// pod at once. A progress message is sent for every deletion and every ready replacement, followed
+// by a summary. The whole operation is bounded by timeout and stops when the context is cancelled.
+//
+// Parameters:
+//
+//	ctx context.Context: Context for cancellation; the operation is additionally bounded by timeout.
+//	clientset kubernetes.Interface: A Kubernetes clientset for interacting with the Kubernetes API.
+//	namespace string: The namespace of the pods.
+//	labelSelector string: The label selector of the pods to restart.
+//	rolling bool: Whether to restart the pods one at a time, waiting for each replacement.
+//	timeout time.Duration: The maximum time the restart may take.
+//	results chan<- string: A channel that receives progress messages; it must be drained concurrently.
+//	logger *zap.Logger: A logger for structured logging.
+//
+// Returns an error if the pods cannot be listed or deleted, if a replacement does not become ready in
+// time, or if the context is cancelled.
+func RestartPods(ctx context.Context, clientset kubernetes.Interface, namespace, labelSelector string, rolling bool, timeout time.Duration, results chan<- string, logger *zap.Logger) error {
+	restartCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	listOptions := v1.ListOptions{LabelSelector: labelSelector, Limit: defaultPageSize}
+	pods, err := listAllPods(restartCtx, clientset, namespace, listOptions, 0)
+	if err != nil {
+		return reportRestartPodsFailure(results, nil, err)
+	}
+	sort.Slice(pods.Items, func(i, j int) bool { return pods.Items[i].Name < pods.Items[j].Name })
+
+	// Pods seen so far, so that only new pods count as replacements.
+	known := make(map[types.UID]bool, len(pods.Items))
+	for _, pod := range pods.Items {
+		known[pod.UID] = true
+	}
+
+	var restarted []string
+	for i, pod := range pods.Items {
+		if err := restartCtx.Err(); err != nil {
+			return reportRestartPodsFailure(results, restarted, err)
+		}
+		err := clientset.CoreV1().Pods(namespace).Delete(restartCtx, pod.Name, v1.DeleteOptions{})
+		if err != nil && !apierrors.IsNotFound(err) {
+			return reportRestartPodsFailure(results, restarted, fmt.Errorf(language.ErrorFailedToDeletePod, pod.Name, err))
+		}
+		restarted = append(restarted, pod.Name)
+		results <- fmt.Sprintf(language.PodDeletedForRestart, pod.Name, i+1, len(pods.Items))
+
+		if !rolling {
+			continue
+		}
+		replacement, err := waitForReplacementPod(restartCtx, clientset, namespace, listOptions, known)
+		if err != nil {
+			if ctx.Err() == nil && restartCtx.Err() != nil {
+				err = fmt.Errorf(language.ErrorReplacementNotReadyInTime, pod.Name, timeout)
+			}
+			return reportRestartPodsFailure(results, restarted, err)
+		}
+		known[replacement.UID] = true
+		results <- fmt.Sprintf(language.ReplacementPodReady, replacement.Name, pod.Name)
+	}
+
+	successMsg := fmt.Sprintf(language.PodsRestarted, len(restarted), namespace, strings.Join(restarted, ", "))
+	results <- successMsg
+	navigator.LogInfoWithEmoji(constant.SuccessEmoji, successMsg)
+	return nil
+}
+
+// waitForReplacementPod polls the pods matching the list options until one that is not in known
+// is healthy, as determined by CrewCheckingisPodHealthy, and returns it.
+//
+// This unexported function is used internally by RestartPods.
+func waitForReplacementPod(ctx context.Context, clientset kubernetes.Interface, namespace string, listOptions v1.ListOptions, known map[types.UID]bool) (*corev1.Pod, error) {
+	for {
+		pods, err := listAllPods(ctx, clientset, namespace, listOptions, 0)
+		if err != nil && ctx.Err() == nil {
+			return nil, err
+		}
+		if err == nil {
+			for i := range pods.Items {
+				pod := &pods.Items[i]
+				if !known[pod.UID] && CrewCheckingisPodHealthy(pod) {
+					return pod, nil
+				}
+			}
+		}
+		if !waitForNextAttempt(ctx, podPollInterval) {
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// reportRestartPodsFailure sends an error message naming the pods restarted so far to the results
+// channel, logs the failure, and returns the error so callers can propagate it directly.
+//
+// This unexported function is used internally by RestartPods to report failures.
+func reportRestartPodsFailure(results chan<- string, restarted []string, err error) error {
+	errorMessage := fmt.Sprintf(language.ErrorFailedToRestartPods, len(restarted), strings.Join(restarted, ", "), err)
+	results <- errorMessage
+	navigator.LogErrorWithEmojiRateLimited(constant.ErrorEmoji, errorMessage, zap.Error(err))
+	return err
+}
+
+// extractRestartPodsParameters extracts and validates the 'labelSelector' parameter and the optional
+// 'rolling' and 'timeout' parameters. A selector is required so that a task cannot restart every pod
+// of a namespace by accident. The timeout defaults to defaultWatchTimeout.
+//
+// This function is used by task runners that restart pods.
+func extractRestartPodsParameters(parameters map[string]interface{}) (string, bool, time.Duration, error) {
+	selector, err := getParamAsString(parameters, labelSelector)
+	if err != nil || selector == "" {
+		return "", false, 0, newParameterError(labelSelector, err, language.ErrorParameterMissing, labelSelector)
+	}
+
+	rolling, err := getOptionalParamAsBool(parameters, rollinG, false)
+	if err != nil {
+		return "", false, 0, err
+	}
+
+	timeoutStr, err := getOptionalParamAsString(parameters, timeouT, defaultWatchTimeout)
+	if err != nil {
+		return "", false, 0, err
+	}
+	timeout, err := time.ParseDuration(timeoutStr)
+	if err != nil || timeout <= 0 {
+		return "", false, 0, newParameterError(timeouT, err, language.ErrorParameterInvalid, timeouT)
+	}
+
+	return selector, rolling, timeout, nil
+}
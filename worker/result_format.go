@@ -0,0 +1,76 @@
+package worker
+
+import (
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/H0llyW00dzZ/K8sBlackPearl/worker/configuration"
+)
+
+// ResultFormat selects how task outcomes are written to the results channel returned by the captain.
+type ResultFormat string
+
+const (
+	// ResultFormatText writes human-readable messages. It is the default.
+	ResultFormatText ResultFormat = "text"
+
+	// ResultFormatJSON writes one JSON-encoded TaskResult per message.
+	ResultFormatJSON ResultFormat = "json"
+)
+
+// TaskResult is the structured form of a task outcome, written to the results channel when the
+// JSON result format is selected. RetryHistory lists the failed attempts before the outcome, so a
+// task that eventually succeeded still shows the failures it overcame.
+type TaskResult struct {
+	Timestamp    time.Time       `json:"timestamp"`
+	TaskName     string          `json:"taskName"`
+	TaskType     string          `json:"taskType"`
+	Outcome      string          `json:"outcome"`
+	Message      string          `json:"message"`
+	RetryHistory []AttemptRecord `json:"retryHistory,omitempty"`
+}
+
+// resultFormat is the package-level format of the task outcomes sent on the results channel.
+var (
+	resultFormat   = ResultFormatText
+	resultFormatMu sync.RWMutex
+)
+
+// SetResultFormat selects, in a thread-safe manner, whether task outcomes are sent on the results
+// channel as human-readable text or as JSON lines that consumers can decode into a TaskResult.
+// Unknown formats select ResultFormatText.
+func SetResultFormat(format ResultFormat) {
+	resultFormatMu.Lock()
+	defer resultFormatMu.Unlock()
+	if format != ResultFormatJSON {
+		format = ResultFormatText
+	}
+	resultFormat = format
+}
+
+// formatTaskResult renders a task outcome in the configured result format. With the text format
+// the message is returned unchanged.
+//
+// This unexported function is used internally by processTask, handleFailedTask, and handleSuccessfulTask.
+func formatTaskResult(task configuration.Task, outcome, message string, history []AttemptRecord) string {
+	resultFormatMu.RLock()
+	format := resultFormat
+	resultFormatMu.RUnlock()
+	if format != ResultFormatJSON {
+		return message
+	}
+
+	encoded, err := json.Marshal(TaskResult{
+		Timestamp:    time.Now().UTC(),
+		TaskName:     task.Name,
+		TaskType:     task.Type,
+		Outcome:      outcome,
+		Message:      message,
+		RetryHistory: history,
+	})
+	if err != nil {
+		return message
+	}
+	return string(encoded)
+}
@@ -0,0 +1,59 @@
+package worker
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"sort"
+	"testing"
+
+	"github.com/H0llyW00dzZ/K8sBlackPearl/worker/configuration"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestJSONResultFormatWritesTaskResults(t *testing.T) {
+	SetResultFormat(ResultFormatJSON)
+	t.Cleanup(func() { SetResultFormat(ResultFormatText) })
+	flaky := 0
+	registerTestRunner(t, "TestFlaky", func(_ context.Context, _ kubernetes.Interface, _ string, _ configuration.Task, _ map[string]interface{}, _ int) error {
+		flaky++
+		if flaky == 1 {
+			return errors.New("connection reset")
+		}
+		return nil
+	})
+	registerTestRunner(t, "TestBroken", func(_ context.Context, _ kubernetes.Interface, _ string, _ configuration.Task, _ map[string]interface{}, _ int) error {
+		return markNonRetriable(errors.New("bad input"))
+	})
+	tasks := []configuration.Task{
+		{Name: "flaky", Type: "TestFlaky", ShipsNamespace: "default", MaxRetries: 3, RetryDelay: "1ms"},
+		{Name: "broken", Type: "TestBroken", ShipsNamespace: "default", MaxRetries: 3, RetryDelay: "1ms"},
+	}
+
+	var decoded []TaskResult
+	for _, line := range runCrewToCompletion(context.Background(), fake.NewSimpleClientset(), tasks, 1) {
+		var result TaskResult
+		decoder := json.NewDecoder(bytes.NewReader([]byte(line)))
+		decoder.DisallowUnknownFields()
+		if err := decoder.Decode(&result); err != nil {
+			t.Fatalf("the result %q is not a JSON TaskResult: %v", line, err)
+		}
+		if result.Timestamp.IsZero() || result.Message == "" {
+			t.Fatalf("the result %q has no timestamp or message", line)
+		}
+		decoded = append(decoded, result)
+	}
+	sort.Slice(decoded, func(i, j int) bool { return decoded[i].TaskName < decoded[j].TaskName })
+
+	if len(decoded) != 2 {
+		t.Fatalf("got %d results, want one per task", len(decoded))
+	}
+	if broken := decoded[0]; broken.TaskName != "broken" || broken.TaskType != "TestBroken" || broken.Outcome != "failure" {
+		t.Fatalf("got %+v, want the failure of broken", broken)
+	}
+	if flaky := decoded[1]; flaky.TaskName != "flaky" || flaky.TaskType != "TestFlaky" || flaky.Outcome != "success" || len(flaky.RetryHistory) != 1 {
+		t.Fatalf("got %+v, want the success of flaky after one failed attempt", flaky)
+	}
+}
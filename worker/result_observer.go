@@ -0,0 +1,24 @@
+package worker
+
+import "context"
+
+// resultObserverKey is the context key under which a result observer is stored.
+type resultObserverKey struct{}
+
+// WithResultObserver returns a copy of the context carrying a function that receives every line a
+// runner reports through its results channel, in order, as the runner logs it. It lets tests and
+// embedding programs collect the outcome of a runner without parsing its logs; the workertest
+// harness uses it to capture the results of the runners it drives. The observer may be called from
+// a goroutine other than the one calling Run, but never concurrently for the same run.
+func WithResultObserver(ctx context.Context, observe func(result string)) context.Context {
+	return context.WithValue(ctx, resultObserverKey{}, observe)
+}
+
+// observeResult passes a result line to the observer carried by the context, if any.
+//
+// This unexported function is used internally by logResultsFromChannel.
+func observeResult(ctx context.Context, result string) {
+	if observe, _ := ctx.Value(resultObserverKey{}).(func(result string)); observe != nil {
+		observe(result)
+	}
+}
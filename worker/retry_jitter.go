@@ -0,0 +1,40 @@
+package worker
+
+import (
+	"math/rand/v2"
+	"sync"
+	"time"
+)
+
+// retryJitter is the package-level fraction of a retry delay that is randomized; zero disables jitter.
+var (
+	retryJitter   float64
+	retryJitterMu sync.RWMutex
+)
+
+// SetRetryJitter randomizes retry delays by up to the given fraction in either direction, in a
+// thread-safe manner, so that workers failing on the same transient error do not all retry at the
+// same instant. For example, 0.2 turns a 5s delay into a random delay between 4s and 6s. The fraction
+// is clamped to [0, 1], so a jittered delay is never negative. Jitter is disabled by default, and
+// delays suggested by the API server through Retry-After are never jittered.
+func SetRetryJitter(fraction float64) {
+	retryJitterMu.Lock()
+	defer retryJitterMu.Unlock()
+	retryJitter = max(0, min(fraction, 1))
+}
+
+// jitterRetryDelay returns the delay shifted by a uniformly random amount within the configured
+// jitter fraction. The delay is returned unchanged when jitter is disabled or the delay is not positive.
+//
+// This unexported function is used internally by retryDelayFor.
+func jitterRetryDelay(delay time.Duration) time.Duration {
+	retryJitterMu.RLock()
+	fraction := retryJitter
+	retryJitterMu.RUnlock()
+
+	spread := time.Duration(float64(delay) * fraction)
+	if spread <= 0 {
+		return delay
+	}
+	return delay - spread + rand.N(2*spread+1)
+}
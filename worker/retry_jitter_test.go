@@ -0,0 +1,44 @@
+package worker
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+func TestRetryDelayJitterStaysWithinRange(t *testing.T) {
+	t.Cleanup(func() { SetRetryJitter(0) })
+	transient := errors.New("connection reset")
+
+	if delay := retryDelayFor(transient, time.Second); delay != time.Second {
+		t.Fatalf("got %v without jitter, want the configured delay", delay)
+	}
+
+	for _, tc := range []struct {
+		fraction float64
+		min, max time.Duration
+	}{
+		{0.2, 800 * time.Millisecond, 1200 * time.Millisecond},
+		// Fractions above one are clamped, so the delay never becomes negative.
+		{5, 0, 2 * time.Second},
+	} {
+		SetRetryJitter(tc.fraction)
+		distinct := make(map[time.Duration]bool)
+		for i := 0; i < 1000; i++ {
+			delay := retryDelayFor(transient, time.Second)
+			if delay < tc.min || delay > tc.max {
+				t.Fatalf("jitter %v gave delay %v, want it within [%v, %v]", tc.fraction, delay, tc.min, tc.max)
+			}
+			distinct[delay] = true
+		}
+		if len(distinct) < 2 {
+			t.Fatalf("jitter %v always gave the same delay", tc.fraction)
+		}
+	}
+
+	if delay := retryDelayFor(apierrors.NewTooManyRequests("slow down", 3), time.Second); delay != 3*time.Second {
+		t.Fatalf("got %v, want the Retry-After delay without jitter", delay)
+	}
+}
@@ -0,0 +1,45 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/H0llyW00dzZ/K8sBlackPearl/worker/configuration"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestRetryAttemptsLogThroughTheWorkerLogger(t *testing.T) {
+	core, logs := observer.New(zapcore.DebugLevel)
+	t.Cleanup(zap.ReplaceGlobals(zap.New(core)))
+	registerTestRunner(t, "TestAlwaysFail", func(_ context.Context, _ kubernetes.Interface, _ string, _ configuration.Task, _ map[string]interface{}, _ int) error {
+		return errors.New("transient failure")
+	})
+	tasks := []configuration.Task{{
+		Name:           "doomed",
+		Type:           "TestAlwaysFail",
+		ShipsNamespace: "default",
+		MaxRetries:     3,
+		RetryDelay:     "1ms",
+	}}
+
+	runCrewToCompletion(context.Background(), fake.NewSimpleClientset(), tasks, 1)
+
+	attempts := logs.FilterMessageSnippet("transient failure").All()
+	wantLevels := []zapcore.Level{zapcore.WarnLevel, zapcore.WarnLevel, zapcore.ErrorLevel}
+	if len(attempts) != len(wantLevels) {
+		t.Fatalf("got %d retry attempt logs, want %d", len(attempts), len(wantLevels))
+	}
+	for i, entry := range attempts {
+		if entry.Level != wantLevels[i] {
+			t.Fatalf("attempt %d logged at %v, want %v", i+1, entry.Level, wantLevels[i])
+		}
+		if worker, found := entry.ContextMap()["crew_worker"]; !found || worker != int64(0) {
+			t.Fatalf("attempt %d logged with fields %v, want the worker index", i+1, entry.ContextMap())
+		}
+	}
+}
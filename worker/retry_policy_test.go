@@ -0,0 +1,96 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/H0llyW00dzZ/K8sBlackPearl/worker/configuration"
+	"go.uber.org/zap"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestRetryPolicyCountsTheAttemptsMade(t *testing.T) {
+	calls := 0
+	policy := RetryPolicy{MaxRetries: 5}
+	err := policy.Execute(context.Background(), func() (string, error) {
+		calls++
+		if calls < 3 {
+			return "flaky", errors.New("not yet")
+		}
+		return "flaky", nil
+	}, zap.NewNop().Error)
+	if err != nil {
+		t.Fatalf("Execute: %v", err)
+	}
+	if policy.Attempts() != 3 || len(policy.History()) != 2 {
+		t.Fatalf("got %d attempts and %d failed attempts, want 3 and 2", policy.Attempts(), len(policy.History()))
+	}
+}
+
+func TestRetryPolicyHistoryRecordsEachFailure(t *testing.T) {
+	failures := []error{errors.New("connection reset"), errors.New("etcd leader changed")}
+	policy := RetryPolicy{MaxRetries: 5, RetryDelay: time.Millisecond}
+	err := policy.Execute(context.Background(), func() (string, error) {
+		if attempt := policy.Attempts(); attempt < len(failures) {
+			return "flaky", failures[attempt]
+		}
+		return "flaky", nil
+	}, zap.NewNop().Error)
+	if err != nil {
+		t.Fatalf("Execute: %v", err)
+	}
+
+	want := []AttemptRecord{
+		{Attempt: 1, Err: "connection reset", Delay: time.Millisecond},
+		{Attempt: 2, Err: "etcd leader changed", Delay: time.Millisecond},
+	}
+	if got := policy.History(); !reflect.DeepEqual(got, want) {
+		t.Fatalf("got history %+v, want %+v", got, want)
+	}
+}
+
+func TestRetryPolicyHistoryIsBounded(t *testing.T) {
+	policy := RetryPolicy{MaxRetries: maxAttemptHistory + 5}
+	err := policy.Execute(context.Background(), func() (string, error) {
+		return "doomed", fmt.Errorf("failure %d", policy.Attempts()+1)
+	}, zap.NewNop().Error)
+	if err == nil {
+		t.Fatal("Execute succeeded, want the retries to run out")
+	}
+
+	history := policy.History()
+	if len(history) != maxAttemptHistory {
+		t.Fatalf("got %d records, want the history capped at %d", len(history), maxAttemptHistory)
+	}
+	if first, last := history[0], history[len(history)-1]; first.Attempt != 6 || last.Attempt != maxAttemptHistory+5 || last.Err != fmt.Sprintf("failure %d", maxAttemptHistory+5) || last.Delay != 0 {
+		t.Fatalf("got records %+v to %+v, want the most recent attempts kept and no delay after the last", first, last)
+	}
+}
+
+func TestFailedTaskReportsTheRealAttemptCount(t *testing.T) {
+	executions := 0
+	registerTestRunner(t, "TestFailTwiceThenStop", func(_ context.Context, _ kubernetes.Interface, _ string, _ configuration.Task, _ map[string]interface{}, _ int) error {
+		executions++
+		if executions < 3 {
+			return errors.New("transient failure")
+		}
+		return markNonRetriable(errors.New("permanent failure"))
+	})
+	tasks := []configuration.Task{{
+		Name:           "stubborn",
+		Type:           "TestFailTwiceThenStop",
+		ShipsNamespace: "default",
+		MaxRetries:     10,
+		RetryDelay:     "1ms",
+	}}
+
+	results := runCrewToCompletion(context.Background(), fake.NewSimpleClientset(), tasks, 1)
+	if len(results) != 1 || results[0] != "Failed to complete task stubborn after 3 attempts" {
+		t.Fatalf("got results %q, want the failure to report 3 attempts rather than MaxRetries", results)
+	}
+}
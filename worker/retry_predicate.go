@@ -0,0 +1,37 @@
+package worker
+
+import "sync"
+
+// retryPredicate is the package-level, user-supplied check that can make additional errors retriable.
+var (
+	retryPredicate   func(err error) bool
+	retryPredicateMu sync.RWMutex
+)
+
+// SetRetryPredicate installs a function that decides whether an error should be retried, in a
+// thread-safe manner, for criteria the package cannot know about, such as a specific admission
+// webhook denial. Passing nil removes it.
+//
+// The predicate only augments the built-in logic: the built-in checks run first, and the predicate
+// is consulted only for errors they would not retry, so it can make an error retriable, including
+// one marked as non-retriable by a task runner, but never the reverse. It applies to the task retries
+// of RetryPolicy.Execute and to the conflict retries of ScaleDeployment and UpdateDeploymentImage.
+// Retry limits and context cancellation still apply. The predicate may be called concurrently from
+// several workers.
+func SetRetryPredicate(predicate func(err error) bool) {
+	retryPredicateMu.Lock()
+	defer retryPredicateMu.Unlock()
+	retryPredicate = predicate
+}
+
+// retriableByPredicate reports whether the predicate set with SetRetryPredicate deems the error
+// retriable. It returns false when no predicate is set.
+//
+// This unexported function is used internally by the retry paths.
+func retriableByPredicate(err error) bool {
+	retryPredicateMu.RLock()
+	predicate := retryPredicate
+	retryPredicateMu.RUnlock()
+
+	return predicate != nil && predicate(err)
+}
@@ -0,0 +1,71 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/H0llyW00dzZ/K8sBlackPearl/worker/configuration"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestRetryPredicateMakesATerminalErrorRetriable(t *testing.T) {
+	executions := 0
+	registerTestRunner(t, "TestWebhookDenied", func(_ context.Context, _ kubernetes.Interface, _ string, _ configuration.Task, _ map[string]interface{}, _ int) error {
+		executions++
+		if executions%3 != 0 {
+			return markNonRetriable(errors.New(`admission webhook "quota.example.com" denied the request: quota is being recalculated`))
+		}
+		return nil
+	})
+	task := configuration.Task{
+		Name:               "deploy",
+		Type:               "TestWebhookDenied",
+		ShipsNamespace:     "default",
+		MaxRetries:         5,
+		RetryDelayDuration: time.Millisecond,
+	}
+
+	attempts, _, err := performTaskWithRetries(context.Background(), fake.NewSimpleClientset(), "default", task, 0, nil)
+	if err == nil || attempts != 1 {
+		t.Fatalf("without a predicate: got %d attempts and error %v, want the terminal error to stop after 1 attempt", attempts, err)
+	}
+
+	executions = 0
+	SetRetryPredicate(func(err error) bool {
+		return strings.Contains(err.Error(), "quota is being recalculated")
+	})
+	t.Cleanup(func() { SetRetryPredicate(nil) })
+
+	attempts, _, err = performTaskWithRetries(context.Background(), fake.NewSimpleClientset(), "default", task, 0, nil)
+	if err != nil || attempts != 3 {
+		t.Fatalf("with a predicate: got %d attempts and error %v, want success on the third attempt", attempts, err)
+	}
+}
+
+func TestRetryPredicateCannotStopBuiltInRetries(t *testing.T) {
+	SetRetryPredicate(func(error) bool { return false })
+	t.Cleanup(func() { SetRetryPredicate(nil) })
+	executions := 0
+	registerTestRunner(t, "TestTransient", func(_ context.Context, _ kubernetes.Interface, _ string, _ configuration.Task, _ map[string]interface{}, _ int) error {
+		executions++
+		if executions < 2 {
+			return errors.New("connection reset")
+		}
+		return nil
+	})
+	task := configuration.Task{
+		Name:               "deploy",
+		Type:               "TestTransient",
+		ShipsNamespace:     "default",
+		MaxRetries:         3,
+		RetryDelayDuration: time.Millisecond,
+	}
+
+	if attempts, _, err := performTaskWithRetries(context.Background(), fake.NewSimpleClientset(), "default", task, 0, nil); err != nil || attempts != 2 {
+		t.Fatalf("got %d attempts and error %v, want the transient error retried despite the predicate", attempts, err)
+	}
+}
@@ -0,0 +1,165 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/H0llyW00dzZ/K8sBlackPearl/language"
+	"github.com/H0llyW00dzZ/K8sBlackPearl/navigator"
+	"github.com/H0llyW00dzZ/go-urlshortner/logmonitor/constant"
+	"go.uber.org/zap"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// progressDeadlineExceeded is the reason the deployment controller sets on the Progressing condition
+// once a rollout exceeds its progressDeadlineSeconds.
+const progressDeadlineExceeded = "ProgressDeadlineExceeded"
+
+// CheckDeploymentRolloutStuck diagnoses whether the rollout of a deployment is stuck. A rollout is
+// stuck when the controller has reported ProgressDeadlineExceeded, or when it is still incomplete and
+// its Progressing condition has not been updated for longer than threshold. Complete and paused
+// rollouts are never stuck. The diagnosis is reported through the results channel. Nothing is modified.
+//
+// Parameters:
+//
+//	ctx context.Context: Context for cancellation and timeout.
+//	clientset kubernetes.Interface: A Kubernetes clientset for interacting with the Kubernetes API.
+//	namespace string: The namespace of the deployment.
+//	deploymentName string: The name of the deployment to inspect.
+//	threshold time.Duration: How long a rollout may go without progress before it is considered stuck.
+//	failIfStuck bool: Whether a stuck rollout should be returned as an error.
+//	results chan<- string: A channel to send operation results for logging.
+//	logger *zap.Logger: A logger for structured logging.
+//
+// Returns an error if the deployment cannot be read, or if failIfStuck is set and the rollout is stuck.
+func CheckDeploymentRolloutStuck(ctx context.Context, clientset kubernetes.Interface, namespace, deploymentName string, threshold time.Duration, failIfStuck bool, results chan<- string, logger *zap.Logger) error {
+	deployment, err := clientset.AppsV1().Deployments(namespace).Get(ctx, deploymentName, v1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return reportRolloutCheckFailure(results, deploymentName, markNonRetriable(fmt.Errorf(language.ErrorGettingDeployment, deploymentName, err)))
+	}
+	if err != nil {
+		return reportRolloutCheckFailure(results, deploymentName, fmt.Errorf(language.ErrorGettingDeployment, deploymentName, err))
+	}
+
+	if deployment.Spec.Paused {
+		return reportRolloutDiagnosis(results, fmt.Sprintf(language.RolloutPaused, deploymentName))
+	}
+	if rolloutComplete(deployment) {
+		return reportRolloutDiagnosis(results, fmt.Sprintf(language.RolloutComplete, deploymentName))
+	}
+
+	progressing := deploymentCondition(deployment, appsv1.DeploymentProgressing)
+	var reason string
+	var sinceProgress time.Duration
+	switch {
+	case progressing == nil:
+		// Without a Progressing condition there is nothing to measure progress against.
+	case progressing.Status == corev1.ConditionFalse && progressing.Reason == progressDeadlineExceeded:
+		reason = progressing.Message
+	default:
+		sinceProgress = time.Since(progressing.LastUpdateTime.Time).Truncate(time.Second)
+		if sinceProgress > threshold {
+			reason = fmt.Sprintf(language.RolloutNoProgress, sinceProgress, threshold)
+		}
+	}
+
+	if reason == "" {
+		return reportRolloutDiagnosis(results, fmt.Sprintf(language.RolloutProgressing, deploymentName, deployment.Status.UpdatedReplicas, desiredReplicas(deployment), sinceProgress))
+	}
+
+	message := fmt.Sprintf(language.RolloutStuck, deploymentName, reason)
+	results <- message
+	navigator.LogInfoWithEmoji(language.WarningEmoji, message)
+	if failIfStuck {
+		return markNonRetriable(fmt.Errorf(language.ErrorRolloutStuck, deploymentName, reason))
+	}
+	return nil
+}
+
+// rolloutComplete reports whether the controller has observed the latest spec of the deployment and
+// all of its desired replicas are updated and available, with no old replicas left.
+//
+// This unexported function is used internally by CheckDeploymentRolloutStuck.
+func rolloutComplete(deployment *appsv1.Deployment) bool {
+	desired := desiredReplicas(deployment)
+	status := deployment.Status
+	return status.ObservedGeneration >= deployment.Generation &&
+		status.UpdatedReplicas == desired &&
+		status.AvailableReplicas == desired &&
+		status.Replicas == desired
+}
+
+// desiredReplicas returns the replica count of the deployment's spec, which defaults to one.
+//
+// This unexported function is used internally by CheckDeploymentRolloutStuck.
+func desiredReplicas(deployment *appsv1.Deployment) int32 {
+	if deployment.Spec.Replicas == nil {
+		return 1
+	}
+	return *deployment.Spec.Replicas
+}
+
+// deploymentCondition returns the deployment's condition of the given type, or nil.
+//
+// This unexported function is used internally by CheckDeploymentRolloutStuck.
+func deploymentCondition(deployment *appsv1.Deployment, conditionType appsv1.DeploymentConditionType) *appsv1.DeploymentCondition {
+	for i := range deployment.Status.Conditions {
+		if deployment.Status.Conditions[i].Type == conditionType {
+			return &deployment.Status.Conditions[i]
+		}
+	}
+	return nil
+}
+
+// reportRolloutDiagnosis sends a healthy diagnosis to the results channel and logs it.
+//
+// This unexported function is used internally by CheckDeploymentRolloutStuck.
+func reportRolloutDiagnosis(results chan<- string, message string) error {
+	results <- message
+	navigator.LogInfoWithEmoji(constant.SuccessEmoji, message)
+	return nil
+}
+
+// reportRolloutCheckFailure sends an error message to the results channel, logs the failure,
+// and returns the error so callers can propagate it directly.
+//
+// This unexported function is used internally by CheckDeploymentRolloutStuck to report failures.
+func reportRolloutCheckFailure(results chan<- string, deploymentName string, err error) error {
+	errorMessage := fmt.Sprintf(language.ErrorFailedToCheckRollout, deploymentName, err)
+	results <- errorMessage
+	navigator.LogErrorWithEmojiRateLimited(constant.ErrorEmoji, errorMessage, zap.Error(err))
+	return err
+}
+
+// extractRolloutStuckParameters extracts and validates the 'deploymentName' parameter and the optional
+// 'threshold' and 'failIfStuck' parameters. The threshold is a duration such as "15m" and defaults to
+// defaultRolloutStuckThreshold.
+//
+// This function is used by task runners that detect stuck rollouts.
+func extractRolloutStuckParameters(parameters map[string]interface{}) (string, time.Duration, bool, error) {
+	deploymentName, err := getParamAsString(parameters, deploYmentName)
+	if err != nil || deploymentName == "" {
+		return "", 0, false, newParameterError(deploYmentName, err, language.ErrorParameterMissing, deploYmentName)
+	}
+
+	thresholdStr, err := getOptionalParamAsString(parameters, thresholD, defaultRolloutStuckThreshold)
+	if err != nil {
+		return "", 0, false, err
+	}
+	threshold, err := time.ParseDuration(thresholdStr)
+	if err != nil || threshold <= 0 {
+		return "", 0, false, newParameterError(thresholD, err, language.ErrorParameterInvalid, thresholD)
+	}
+
+	failIfStuck, err := getOptionalParamAsBool(parameters, failIfStucK, false)
+	if err != nil {
+		return "", 0, false, err
+	}
+
+	return deploymentName, threshold, failIfStuck, nil
+}
@@ -0,0 +1,79 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/H0llyW00dzZ/K8sBlackPearl/language"
+	"github.com/H0llyW00dzZ/K8sBlackPearl/navigator"
+	"go.uber.org/zap"
+)
+
+// ErrRunDeadlineExceeded is the cause of the shared context once the run deadline set by
+// SetMaxRunDuration expires, and is wrapped by the errors of tasks interrupted by it, so callers can
+// tell them apart from ordinary task failures with errors.Is.
+var ErrRunDeadlineExceeded = errors.New(language.ErrorRunDeadlineExceeded)
+
+// maxRunDuration is the package-level bound on the wall-clock time of a crew run; zero means unbounded.
+var (
+	maxRunDuration   time.Duration
+	maxRunDurationMu sync.RWMutex
+)
+
+// SetMaxRunDuration bounds the total wall-clock time of every crew run started afterwards by
+// CaptainTellWorkers or CaptainTellWorkersPerNamespace, in a thread-safe manner. When the deadline
+// expires, the shared context is cancelled, in-flight tasks receive the cancellation and are reported
+// as interrupted by the run deadline rather than as failures, and the results channel is closed once
+// the workers have returned, as if shutdown had been called. A value of zero or less removes the bound,
+// which is the default.
+func SetMaxRunDuration(d time.Duration) {
+	maxRunDurationMu.Lock()
+	defer maxRunDurationMu.Unlock()
+	maxRunDuration = max(d, 0)
+}
+
+// withRunDeadline derives the shared context of a crew run, bounded by the duration set with
+// SetMaxRunDuration, if any. The returned context's cause is ErrRunDeadlineExceeded once the
+// deadline expires, or the cause passed to the returned function when it is cancelled first.
+//
+// This unexported function is used internally by CaptainTellWorkers and CaptainTellWorkersPerNamespace.
+func withRunDeadline(ctx context.Context) (context.Context, context.CancelCauseFunc, time.Duration) {
+	maxRunDurationMu.RLock()
+	d := maxRunDuration
+	maxRunDurationMu.RUnlock()
+	ctx, cancel := context.WithCancelCause(ctx)
+	if d == 0 {
+		return ctx, cancel, 0
+	}
+	ctx, stop := context.WithTimeoutCause(ctx, d, ErrRunDeadlineExceeded)
+	return ctx, func(cause error) {
+		cancel(cause)
+		stop()
+	}, d
+}
+
+// watchRunDeadline waits for the shared context of a crew run to end. If it ended because the run
+// deadline expired, the expiry is logged and shutdown is called, so the results channel is closed
+// even though the caller never called shutdown itself.
+//
+// This unexported function is used internally by CaptainTellWorkers and CaptainTellWorkersPerNamespace.
+func watchRunDeadline(ctx context.Context, d time.Duration, shutdown func()) {
+	<-ctx.Done()
+	if !runDeadlineExceeded(ctx) {
+		return
+	}
+	navigator.LogInfoWithEmoji(language.WarningEmoji, fmt.Sprintf(language.RunDeadlineExceeded, d),
+		zap.Duration(language.MaxRunDuration, d),
+	)
+	shutdown()
+}
+
+// runDeadlineExceeded reports whether the context was cancelled by the run deadline.
+//
+// This unexported function is used internally by watchRunDeadline.
+func runDeadlineExceeded(ctx context.Context) bool {
+	return errors.Is(context.Cause(ctx), ErrRunDeadlineExceeded)
+}
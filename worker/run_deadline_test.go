@@ -0,0 +1,60 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/H0llyW00dzZ/K8sBlackPearl/worker/configuration"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestRunDeadlineEndsTheRunCleanly(t *testing.T) {
+	SetMaxRunDuration(100 * time.Millisecond)
+	t.Cleanup(func() { SetMaxRunDuration(0) })
+	SetResultFormat(ResultFormatJSON)
+	t.Cleanup(func() { SetResultFormat(ResultFormatText) })
+	registerTestRunner(t, "TestVoyage", func(ctx context.Context, _ kubernetes.Interface, _ string, _ configuration.Task, _ map[string]interface{}, _ int) error {
+		select {
+		case <-ctx.Done():
+			return contextError(ctx)
+		case <-time.After(time.Hour):
+			return nil
+		}
+	})
+	tasks := []configuration.Task{
+		{Name: "voyage-1", Type: "TestVoyage", ShipsNamespace: "default", MaxRetries: 3, RetryDelay: "1ms"},
+		{Name: "voyage-2", Type: "TestVoyage", ShipsNamespace: "default", MaxRetries: 3, RetryDelay: "1ms"},
+	}
+
+	start := time.Now()
+	// The shutdown function is deliberately not called: the deadline alone must close the channel.
+	results, _ := CaptainTellWorkers(context.Background(), fake.NewSimpleClientset(), tasks, 2)
+	var outcomes []string
+	timeout := time.After(5 * time.Second)
+	for done := false; !done; {
+		select {
+		case line, ok := <-results:
+			if !ok {
+				done = true
+				break
+			}
+			var result TaskResult
+			if err := json.Unmarshal([]byte(line), &result); err != nil {
+				t.Fatalf("the result %q is not a JSON TaskResult: %v", line, err)
+			}
+			outcomes = append(outcomes, result.Outcome)
+		case <-timeout:
+			t.Fatal("the results channel was not closed after the run deadline")
+		}
+	}
+
+	if elapsed := time.Since(start); elapsed > 2*time.Second {
+		t.Fatalf("the run took %v despite a 100ms deadline", elapsed)
+	}
+	if len(outcomes) != 2 || outcomes[0] != "deadline_exceeded" || outcomes[1] != "deadline_exceeded" {
+		t.Fatalf("got outcomes %q, want both tasks reported as interrupted by the run deadline", outcomes)
+	}
+}
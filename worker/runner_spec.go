@@ -0,0 +1,77 @@
+package worker
+
+// RunnerSpec is the machine-readable description of a task type registered with
+// RegisterTaskRunnerWithSpec. It documents what the runner does, the parameters it reads, and the
+// RBAC permissions it needs, so that parameter validation, RBAC pre-flight checks, and generated
+// documentation can all be derived from the registration.
+//
+// Fields:
+//
+//	Description string: A human-readable description of what the runner does.
+//	Parameters []ParameterSpec: The parameters the runner reads from the task.
+//	RBAC []RBACRule: The permissions the runner needs in the target namespace.
+type RunnerSpec struct {
+	Description string
+	Parameters  []ParameterSpec
+	RBAC        []RBACRule
+}
+
+// ParameterSpec describes a single task parameter read by a runner.
+//
+// Fields:
+//
+//	Name string: The parameter key, for example "deploymentName".
+//	Type string: The expected type, for example "string", "int", "bool", "duration", "[]string", or "map[string]string".
+//	Required bool: Whether the runner fails when the parameter is missing.
+//	Description string: A human-readable description of the parameter.
+type ParameterSpec struct {
+	Name        string
+	Type        string
+	Required    bool
+	Description string
+}
+
+// RBACRule describes the verbs a runner needs on a resource, in the same terms as a Kubernetes
+// Role rule.
+//
+// Fields:
+//
+//	APIGroup string: The API group of the resource; empty for the core group.
+//	Resource string: The resource name, for example "deployments" or "pods/log".
+//	Verbs []string: The verbs needed on the resource, for example "get" and "update".
+type RBACRule struct {
+	APIGroup string
+	Resource string
+	Verbs    []string
+}
+
+// RequiredParameters returns the names of the parameters marked as required, in declaration order.
+func (s RunnerSpec) RequiredParameters() []string {
+	var names []string
+	for _, parameter := range s.Parameters {
+		if parameter.Required {
+			names = append(names, parameter.Name)
+		}
+	}
+	return names
+}
+
+// taskRunnerSpecs maps task types to the specs they were registered with. Task types registered
+// through RegisterTaskRunner have an empty spec.
+var taskRunnerSpecs = make(map[string]RunnerSpec)
+
+// RegisterTaskRunnerWithSpec associates a task type with a TaskRunner constructor and a RunnerSpec
+// describing the runner. Registering the same task type again replaces both.
+func RegisterTaskRunnerWithSpec(taskType string, spec RunnerSpec, constructor func() TaskRunner) {
+	taskRunnerRegistry[taskType] = constructor
+	taskRunnerSpecs[taskType] = spec
+}
+
+// GetRunnerSpec returns the RunnerSpec registered for the task type. It returns false if the task
+// type is not registered; a task type registered without a spec returns an empty RunnerSpec and true.
+func GetRunnerSpec(taskType string) (RunnerSpec, bool) {
+	if _, exists := taskRunnerRegistry[taskType]; !exists {
+		return RunnerSpec{}, false
+	}
+	return taskRunnerSpecs[taskType], true
+}
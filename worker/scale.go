@@ -3,88 +3,81 @@ package worker
 import (
 	"context"
 	"fmt"
-	"time"
 
 	"github.com/H0llyW00dzZ/K8sBlackPearl/language"
 	"github.com/H0llyW00dzZ/K8sBlackPearl/navigator"
 	"github.com/H0llyW00dzZ/go-urlshortner/logmonitor/constant"
 	"go.uber.org/zap"
-	"k8s.io/apimachinery/pkg/api/errors"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 )
 
-// ScaleDeployment attempts to scale a Kubernetes deployment to the desired number of replicas.
-// It retries the scaling operation up to a maximum number of retries upon encountering conflicts.
-// Non-conflict errors are reported immediately without retries. Success or failure messages are
-// sent through the results channel, and logs are produced accordingly.
+// ScaleDeployment makes a single attempt to scale a Kubernetes deployment to the desired number of replicas.
+// It does not retry: a task's RetryPolicy owns retries, including the Retry-After hint of a throttled
+// request and the cancellable wait between attempts, so a conflict or any other error is reported and
+// returned for the policy to decide on. If the deployment already has the desired number of replicas,
+// no update is issued and a no-op message is reported. Success or failure messages are sent through
+// the results channel, and logs are produced accordingly.
 //
 // Parameters:
 //
 //	ctx context.Context: Context for cancellation and timeout of the scaling process.
-//	clientset *kubernetes.Clientset: Kubernetes API client for interacting with the cluster.
+//	clientset kubernetes.Interface: Kubernetes API client for interacting with the cluster.
 //	namespace string: The namespace of the deployment.
 //	deploymentName string: The name of the deployment to scale.
 //	scale int: The desired number of replicas to scale to.
-//	maxRetries int: The maximum number of retries for the scaling operation.
-//	retryDelay time.Duration: The duration to wait before retrying the scaling operation.
 //	results chan<- string: A channel for sending the results of the scaling operation.
 //	logger *zap.Logger: A structured logger for logging information and errors.
 //
 // Returns:
 //
-//	error: An error if scaling fails after all retries, or nil on success.
-func ScaleDeployment(ctx context.Context, clientset *kubernetes.Clientset, namespace string, deploymentName string, scale int, maxRetries int, retryDelay time.Duration, results chan<- string, logger *zap.Logger) error {
-	var lastScaleErr error
-	for attempt := 0; attempt < maxRetries; attempt++ {
-		lastScaleErr = scaleDeploymentOnce(ctx, clientset, namespace, deploymentName, scale)
-		if lastScaleErr != nil {
-			if errors.IsConflict(lastScaleErr) {
-				// If there is a conflict, resolve it and retry.
-				navigator.LogInfoWithEmoji(language.SwordEmoji, fmt.Sprintf(language.ErrorConflict, deploymentName))
-				time.Sleep(retryDelay) // Wait before retrying
-				continue               // Retry scaling
-			} else {
-				// For non-conflict errors, send the error message and return.
-				errorMessage := fmt.Sprintf(language.FailedTOScallEdDeployment, deploymentName, scale, lastScaleErr)
-				results <- errorMessage
-				return lastScaleErr
-			}
-		} else {
-			// If scaling was successful, send a success message and return.
-			successMsg := fmt.Sprintf(language.ScaledDeployment, deploymentName, scale)
-			results <- successMsg
-			navigator.LogInfoWithEmoji(constant.SuccessEmoji, successMsg)
-			return nil
-		}
+//	error: An error if scaling fails, or nil on success.
+func ScaleDeployment(ctx context.Context, clientset kubernetes.Interface, namespace string, deploymentName string, scale int, results chan<- string, logger *zap.Logger) error {
+	scaled, scaleErr := scaleDeploymentOnce(ctx, clientset, namespace, deploymentName, scale)
+	if scaleErr != nil {
+		errorMessage := fmt.Sprintf(language.FailedTOScallEdDeployment, deploymentName, scale, scaleErr)
+		results <- errorMessage
+		return scaleErr
 	}
-
-	// If the code reaches this point, it means scaling has failed after retries.
-	failMessage := fmt.Sprintf(language.FailedToScaleDeployment, deploymentName, scale, maxRetries, lastScaleErr)
-	results <- failMessage
-	navigator.LogErrorWithEmoji(constant.ErrorEmoji, failMessage)
-	return lastScaleErr
+	if !scaled {
+		// The deployment already has the desired replicas, so no update was issued.
+		noOpMsg := fmt.Sprintf(language.DeploymentAlreadyScaled, deploymentName, scale)
+		results <- noOpMsg
+		navigator.LogInfoWithEmoji(constant.SuccessEmoji, noOpMsg)
+		return nil
+	}
+	successMsg := fmt.Sprintf(language.ScaledDeployment, deploymentName, scale)
+	results <- successMsg
+	navigator.LogInfoWithEmoji(constant.SuccessEmoji, successMsg)
+	return nil
 }
 
 // scaleDeploymentOnce performs a single attempt to scale a deployment to the desired number of replicas.
 // It updates the deployment's replica count and handles any errors that occur during the update process.
+// When the deployment already has the desired replicas, no update is written.
 //
 // Parameters:
 //
 //	ctx context.Context: Context for cancellation and timeout of the scaling operation.
-//	clientset *kubernetes.Clientset: Kubernetes API client for interacting with the cluster.
+//	clientset kubernetes.Interface: Kubernetes API client for interacting with the cluster.
 //	namespace string: The namespace of the deployment.
 //	deploymentName string: The name of the deployment to scale.
 //	scale int: The desired number of replicas to scale to.
 //
 // Returns:
 //
+//	bool: True if an update was written, false if the deployment was already at the desired scale.
 //	error: An error if the scaling operation fails, or nil if the operation is successful.
-func scaleDeploymentOnce(ctx context.Context, clientset *kubernetes.Clientset, namespace string, deploymentName string, scale int) error {
+func scaleDeploymentOnce(ctx context.Context, clientset kubernetes.Interface, namespace string, deploymentName string, scale int) (bool, error) {
 	// Get the current deployment.
 	deployment, getErr := clientset.AppsV1().Deployments(namespace).Get(ctx, deploymentName, v1.GetOptions{})
 	if getErr != nil {
-		return fmt.Errorf(language.FailedToGetDeployment, deploymentName, getErr)
+		return false, fmt.Errorf(language.ErrorGettingDeployment, deploymentName, getErr)
+	}
+
+	// Skip the write when the deployment is already at the desired scale.
+	if deployment.Spec.Replicas != nil && int(*deployment.Spec.Replicas) == scale {
+		return false, nil
 	}
 
 	// Update the replicas in the deployment spec.
@@ -93,10 +86,10 @@ func scaleDeploymentOnce(ctx context.Context, clientset *kubernetes.Clientset, n
 	// Update the deployment with the new number of replicas.
 	_, updateErr := clientset.AppsV1().Deployments(namespace).Update(ctx, deployment, v1.UpdateOptions{})
 	if updateErr != nil {
-		return fmt.Errorf(language.FailedTOScallEdDeployment, deploymentName, scale, updateErr)
+		return false, fmt.Errorf(language.ErrorScalingDeploymentReason, deploymentName, scale, updateErr)
 	}
 
-	return nil
+	return true, nil
 }
 
 // int32Ptr converts an int32 value to a pointer to an int32.
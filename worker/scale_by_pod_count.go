@@ -0,0 +1,166 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/H0llyW00dzZ/K8sBlackPearl/language"
+	"github.com/H0llyW00dzZ/K8sBlackPearl/navigator"
+	"github.com/H0llyW00dzZ/go-urlshortner/logmonitor/constant"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// The formulas that turn the scaled pod count into a whole number of replicas.
+const (
+	formulaCeil  = "ceil"
+	formulaFloor = "floor"
+	formulaRound = "round"
+)
+
+// podCountScaling describes how a source pod count is turned into a number of replicas.
+type podCountScaling struct {
+	ratio       float64
+	formula     string
+	minReplicas *int
+	maxReplicas *int
+}
+
+// ScaleBasedOnPodCount scales a deployment to a number of replicas derived from the count of the pods
+// matching a source selector, for simple custom scaling such as matching consumers to producers. The
+// count is multiplied by the ratio, rounded as the formula selects, and clamped to the optional bounds,
+// and the deployment is then scaled with ScaleDeployment. Pods that have Succeeded, Failed, or are
+// being deleted are not counted. The computed value and the scaling outcome are sent through the
+// results channel.
+//
+// Parameters:
+//
+//	ctx context.Context: Context for cancellation and timeout.
+//	clientset kubernetes.Interface: A Kubernetes clientset for interacting with the Kubernetes API.
+//	namespace string: The namespace of the source pods and of the target deployment.
+//	sourceSelector string: The label selector of the pods to count.
+//	targetDeployment string: The name of the deployment to scale.
+//	scaling podCountScaling: How the pod count is turned into replicas.
+//	results chan<- string: A channel with room for two messages.
+//	logger *zap.Logger: A logger for structured logging.
+//
+// Returns an error if the pods cannot be listed or the deployment cannot be scaled.
+func ScaleBasedOnPodCount(ctx context.Context, clientset kubernetes.Interface, namespace, sourceSelector, targetDeployment string, scaling podCountScaling, results chan<- string, logger *zap.Logger) error {
+	pods, err := listAllPods(ctx, clientset, namespace, v1.ListOptions{LabelSelector: sourceSelector, Limit: defaultPageSize}, 0)
+	if err != nil {
+		errorMessage := fmt.Sprintf(language.ErrorFailedToCountSourcePods, sourceSelector, err)
+		results <- errorMessage
+		navigator.LogErrorWithEmojiRateLimited(constant.ErrorEmoji, errorMessage, zap.Error(err))
+		return err
+	}
+
+	count := 0
+	for _, pod := range pods.Items {
+		if pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed || pod.DeletionTimestamp != nil {
+			continue
+		}
+		count++
+	}
+
+	replicas := desiredReplicasForPodCount(count, scaling)
+	message := fmt.Sprintf(language.ReplicasComputedFromPodCount, sourceSelector, count, targetDeployment, replicas)
+	results <- message
+	navigator.LogInfoWithEmoji(language.PirateEmoji, message,
+		zap.String(language.LabelSelector, sourceSelector),
+		zap.Int(language.PodCount, count),
+		zap.Int(language.DesiredReplicas, replicas),
+	)
+
+	return ScaleDeployment(ctx, clientset, namespace, targetDeployment, replicas, results, logger)
+}
+
+// desiredReplicasForPodCount multiplies the pod count by the ratio, rounds the product to a whole
+// number as the formula selects, and clamps it to the optional bounds.
+//
+// This unexported function is used internally by ScaleBasedOnPodCount.
+func desiredReplicasForPodCount(count int, scaling podCountScaling) int {
+	// Rounding away the floating-point noise keeps, for example, 10 * 0.3 from ceiling to 4.
+	scaled := math.Round(float64(count)*scaling.ratio*1e9) / 1e9
+	var replicas int
+	switch scaling.formula {
+	case formulaFloor:
+		replicas = int(math.Floor(scaled))
+	case formulaRound:
+		replicas = int(math.Round(scaled))
+	default:
+		replicas = int(math.Ceil(scaled))
+	}
+	if scaling.minReplicas != nil {
+		replicas = max(replicas, *scaling.minReplicas)
+	}
+	if scaling.maxReplicas != nil {
+		replicas = min(replicas, *scaling.maxReplicas)
+	}
+	return replicas
+}
+
+// extractScaleByPodCountParameters extracts and validates the required 'sourceSelector' and
+// 'targetDeployment' parameters and the optional 'ratio', 'formula', 'minReplicas', and 'maxReplicas'
+// parameters. The ratio must be positive and defaults to 1; the formula is one of "ceil", the
+// default, "floor", or "round". The source selector is required so that a task never counts every
+// pod of a namespace by accident.
+//
+// This function is used by task runners that scale a deployment based on a pod count.
+func extractScaleByPodCountParameters(parameters map[string]interface{}) (string, string, podCountScaling, error) {
+	selector, err := getParamAsString(parameters, sourceSelectoR)
+	if err != nil || strings.TrimSpace(selector) == "" {
+		return "", "", podCountScaling{}, newParameterError(sourceSelectoR, err, language.ErrorParameterMissing, sourceSelectoR)
+	}
+
+	target, err := getParamAsString(parameters, targetDeploymenT)
+	if err != nil || target == "" {
+		return "", "", podCountScaling{}, newParameterError(targetDeploymenT, err, language.ErrorParameterMissing, targetDeploymenT)
+	}
+
+	scaling := podCountScaling{ratio: 1}
+	if raw, exists := parameters[ratiO]; exists {
+		switch value := raw.(type) {
+		case int:
+			scaling.ratio = float64(value)
+		case float64:
+			scaling.ratio = value
+		default:
+			return "", "", podCountScaling{}, newParameterError(ratiO, nil, language.ErrorParameterInvalid, ratiO)
+		}
+		if scaling.ratio <= 0 || math.IsInf(scaling.ratio, 0) || math.IsNaN(scaling.ratio) {
+			return "", "", podCountScaling{}, newParameterError(ratiO, nil, language.ErrorParameterInvalid, ratiO)
+		}
+	}
+
+	if scaling.formula, err = getOptionalParamAsString(parameters, formulA, formulaCeil); err != nil {
+		return "", "", podCountScaling{}, err
+	}
+	switch scaling.formula {
+	case formulaCeil, formulaFloor, formulaRound:
+	default:
+		return "", "", podCountScaling{}, newParameterError(formulA, nil, language.ErrorParameterInvalid, formulA)
+	}
+
+	for key, bound := range map[string]**int{minReplicaS: &scaling.minReplicas, maxReplicaS: &scaling.maxReplicas} {
+		if _, exists := parameters[key]; !exists {
+			continue
+		}
+		value, err := getParamAsInt(parameters, key)
+		if err != nil {
+			return "", "", podCountScaling{}, err
+		}
+		if value < 0 {
+			return "", "", podCountScaling{}, newParameterError(key, nil, language.ErrorParameterInvalid, key)
+		}
+		*bound = &value
+	}
+	if scaling.minReplicas != nil && scaling.maxReplicas != nil && *scaling.minReplicas > *scaling.maxReplicas {
+		return "", "", podCountScaling{}, newParameterError(minReplicaS, nil, language.ErrorParameterInvalid, minReplicaS)
+	}
+
+	return selector, target, scaling, nil
+}
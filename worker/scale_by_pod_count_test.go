@@ -0,0 +1,91 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/H0llyW00dzZ/K8sBlackPearl/worker/configuration"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestDesiredReplicasForPodCount(t *testing.T) {
+	two, five := 2, 5
+	for name, tc := range map[string]struct {
+		count   int
+		scaling podCountScaling
+		want    int
+	}{
+		"ratio of one":             {4, podCountScaling{ratio: 1}, 4},
+		"ceil by default":          {10, podCountScaling{ratio: 0.25}, 3},
+		"ceil ignores float noise": {10, podCountScaling{ratio: 0.3}, 3},
+		"floor":                    {10, podCountScaling{ratio: 0.25, formula: formulaFloor}, 2},
+		"round":                    {10, podCountScaling{ratio: 0.25, formula: formulaRound}, 3},
+		"clamped to the minimum":   {0, podCountScaling{ratio: 1, minReplicas: &two}, 2},
+		"clamped to the maximum":   {12, podCountScaling{ratio: 2, maxReplicas: &five}, 5},
+		"within the bounds":        {3, podCountScaling{ratio: 1, minReplicas: &two, maxReplicas: &five}, 3},
+		"no pods and no minimum":   {0, podCountScaling{ratio: 3}, 0},
+	} {
+		if got := desiredReplicasForPodCount(tc.count, tc.scaling); got != tc.want {
+			t.Fatalf("%s: got %d replicas for %d pods, want %d", name, got, tc.count, tc.want)
+		}
+	}
+}
+
+func TestExtractScaleByPodCountParametersRejectsInvalidValues(t *testing.T) {
+	base := func(key string, value interface{}) map[string]interface{} {
+		parameters := map[string]interface{}{"sourceSelector": "app=producer", "targetDeployment": "api"}
+		parameters[key] = value
+		return parameters
+	}
+	for name, parameters := range map[string]map[string]interface{}{
+		"missing selector":  {"targetDeployment": "api"},
+		"zero ratio":        base("ratio", 0),
+		"unknown formula":   base("formula", "sqrt"),
+		"negative minimum":  base("minReplicas", -1),
+		"minimum above max": {"sourceSelector": "app=producer", "targetDeployment": "api", "minReplicas": 5, "maxReplicas": 2},
+	} {
+		if _, _, _, err := extractScaleByPodCountParameters(parameters); !errors.Is(err, ErrInvalidParameter) {
+			t.Fatalf("%s: got error %v, want an invalid parameter error", name, err)
+		}
+	}
+}
+
+func TestCrewScaleBasedOnPodCountScalesTheTarget(t *testing.T) {
+	replicas := int32(1)
+	objects := []runtime.Object{&appsv1.Deployment{
+		ObjectMeta: v1.ObjectMeta{Name: "consumer", Namespace: "default"},
+		Spec:       appsv1.DeploymentSpec{Replicas: &replicas},
+	}}
+	for i, phase := range []corev1.PodPhase{corev1.PodRunning, corev1.PodRunning, corev1.PodPending, corev1.PodRunning, corev1.PodRunning, corev1.PodSucceeded, corev1.PodFailed} {
+		objects = append(objects, &corev1.Pod{
+			ObjectMeta: v1.ObjectMeta{Name: string(rune('a' + i)), Namespace: "default", Labels: map[string]string{"app": "producer"}},
+			Status:     corev1.PodStatus{Phase: phase},
+		})
+	}
+	clientset := fake.NewSimpleClientset(objects...)
+	parameters := map[string]interface{}{"sourceSelector": "app=producer", "targetDeployment": "consumer", "ratio": 0.5, "maxReplicas": 10}
+	task := configuration.Task{Name: "match", Type: "CrewScaleBasedOnPodCount", RetryDelayDuration: time.Millisecond, Parameters: parameters}
+	var collector resultCollector
+
+	if err := (&CrewScaleBasedOnPodCount{}).Run(collector.context(context.Background()), clientset, "default", task, parameters, 0); err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+
+	deployment, err := clientset.AppsV1().Deployments("default").Get(context.Background(), "consumer", v1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Five live pods at a ratio of one half round up to three replicas.
+	if *deployment.Spec.Replicas != 3 {
+		t.Fatalf("got %d replicas, want 3", *deployment.Spec.Replicas)
+	}
+	if results := collector.all(); len(results) == 0 || results[0] != "Source selector 'app=producer' matches 5 pod(s); deployment 'consumer' should run 3 replica(s)" {
+		t.Fatalf("got results %q, want the computed value reported first", results)
+	}
+}
@@ -0,0 +1,100 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/H0llyW00dzZ/K8sBlackPearl/language"
+	"go.uber.org/zap"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// listDeploymentNames lists every page of deployments in a namespace matching the label selector
+// and returns their names.
+//
+// Parameters:
+//
+//	ctx context.Context: Context for cancellation and timeout.
+//	clientset kubernetes.Interface: A Kubernetes clientset for interacting with the Kubernetes API.
+//	namespace string: The namespace of the deployments.
+//	labelSelector string: The label selector matching the deployments.
+//
+// Returns:
+//
+//	[]string: The names of the matching deployments.
+//	error: An error if the deployments cannot be listed.
+func listDeploymentNames(ctx context.Context, clientset kubernetes.Interface, namespace, labelSelector string) ([]string, error) {
+	listOptions := v1.ListOptions{LabelSelector: labelSelector, Limit: defaultPageSize}
+	var names []string
+	for {
+		page, err := clientset.AppsV1().Deployments(namespace).List(ctx, listOptions)
+		if err != nil {
+			return nil, fmt.Errorf(language.ErrorListingDeployments, err)
+		}
+		for _, deployment := range page.Items {
+			names = append(names, deployment.Name)
+		}
+		if page.Continue == "" {
+			return names, nil
+		}
+		listOptions.Continue = page.Continue
+	}
+}
+
+// ScaleDeployments scales each of the named deployments to the desired number of replicas using
+// ScaleDeployment, so every deployment gets a single attempt and reports its own outcome through the
+// results channel. It continues past individual failures and checks the context before each deployment;
+// retrying the batch is left to the task's RetryPolicy.
+//
+// Parameters:
+//
+//	ctx context.Context: Context for cancellation and timeout.
+//	clientset kubernetes.Interface: A Kubernetes clientset for interacting with the Kubernetes API.
+//	namespace string: The namespace of the deployments.
+//	deploymentNames []string: The names of the deployments to scale.
+//	scale int: The desired number of replicas.
+//	results chan<- string: A channel with room for one message per deployment.
+//	logger *zap.Logger: A logger for structured logging.
+//
+// Returns an error naming every deployment that could not be scaled, or the context error if the
+// context is cancelled before the batch completes.
+func ScaleDeployments(ctx context.Context, clientset kubernetes.Interface, namespace string, deploymentNames []string, scale int, results chan<- string, logger *zap.Logger) error {
+	var failed []string
+	for _, deploymentName := range deploymentNames {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := ScaleDeployment(ctx, clientset, namespace, deploymentName, scale, results, logger); err != nil {
+			failed = append(failed, deploymentName)
+		}
+	}
+
+	if len(failed) > 0 {
+		return fmt.Errorf(language.ErrorScaleManyFailed, len(failed), strings.Join(failed, ", "))
+	}
+	return nil
+}
+
+// extractScaleManyParameters extracts and validates the 'labelSelector' and 'replicas' parameters.
+// The label selector is required so that a task can never scale every deployment in a namespace by
+// accident.
+//
+// This function is used by task runners that scale several deployments at once.
+func extractScaleManyParameters(parameters map[string]interface{}) (string, int, error) {
+	selector, err := getParamAsString(parameters, labelSelector)
+	if err != nil || strings.TrimSpace(selector) == "" {
+		return "", 0, newParameterError(labelSelector, err, language.ErrorParameterMissing, labelSelector)
+	}
+
+	replicas, err := getParamAsInt(parameters, repliCas)
+	if err != nil {
+		return "", 0, err
+	}
+	if replicas < 0 {
+		return "", 0, newParameterError(repliCas, nil, language.ErrorParameterInvalid, repliCas)
+	}
+
+	return selector, replicas, nil
+}
@@ -0,0 +1,91 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/H0llyW00dzZ/K8sBlackPearl/worker/configuration"
+	"go.uber.org/zap"
+	appsv1 "k8s.io/api/apps/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+// newConflictingDeploymentClientset returns a clientset holding a deployment whose every update fails
+// with a conflict, and a channel receiving a value for each attempted update.
+func newConflictingDeploymentClientset() (*fake.Clientset, <-chan struct{}) {
+	replicas := int32(1)
+	clientset := fake.NewSimpleClientset(&appsv1.Deployment{
+		ObjectMeta: v1.ObjectMeta{Name: "api", Namespace: "default"},
+		Spec:       appsv1.DeploymentSpec{Replicas: &replicas},
+	})
+	updates := make(chan struct{}, 16)
+	clientset.PrependReactor("update", "deployments", func(k8stesting.Action) (bool, runtime.Object, error) {
+		updates <- struct{}{}
+		return true, nil, apierrors.NewConflict(schema.GroupResource{Group: "apps", Resource: "deployments"}, "api", errors.New("modified"))
+	})
+	return clientset, updates
+}
+
+func TestScaleDeploymentMakesASingleAttempt(t *testing.T) {
+	clientset, updates := newConflictingDeploymentClientset()
+	results := make(chan string, 1)
+
+	err := ScaleDeployment(context.Background(), clientset, "default", "api", 3, results, zap.NewNop())
+	if !apierrors.IsConflict(err) {
+		t.Fatalf("got error %v, want the conflict for the task's retry policy to handle", err)
+	}
+	if len(updates) != 1 || len(results) != 1 {
+		t.Fatalf("got %d update attempts and %d results, want 1 of each", len(updates), len(results))
+	}
+}
+
+func TestCrewScaleDeploymentsHonorsRetryAfter(t *testing.T) {
+	clientset := newDeploymentClientset()
+	throttled := false
+	clientset.PrependReactor("update", "deployments", func(k8stesting.Action) (bool, runtime.Object, error) {
+		if throttled {
+			return false, nil, nil
+		}
+		throttled = true
+		return true, nil, apierrors.NewTooManyRequests("slow down", 1)
+	})
+	task := configuration.Task{
+		Name:               "scale-api",
+		Type:               "CrewScaleDeployments",
+		ShipsNamespace:     "default",
+		MaxRetries:         2,
+		RetryDelayDuration: time.Hour,
+		Parameters:         map[string]interface{}{"deploymentName": "api", "replicas": 5},
+	}
+
+	start := time.Now()
+	if _, _, err := performTaskWithRetries(context.Background(), clientset, "default", task, 0, nil); err != nil {
+		t.Fatalf("the task failed after the throttled attempt: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed < time.Second || elapsed > 5*time.Second {
+		t.Fatalf("the retry waited %v, want the one second suggested by Retry-After", elapsed)
+	}
+}
+
+func TestDeploymentRunnersUseTheLoadedRetryDelay(t *testing.T) {
+	// A task that omits retryDelay loads with a defaulted delay and must not fail when it runs.
+	tasks := []configuration.Task{
+		{Name: "scale", Type: "CrewScaleDeployments", MaxRetries: 3, RetryDelayDuration: configuration.DefaultRetryDelay,
+			Parameters: map[string]interface{}{"deploymentName": "api", "replicas": 5}},
+		{Name: "image", Type: "CrewUpdateImageDeployments", MaxRetries: 3, RetryDelayDuration: configuration.DefaultRetryDelay,
+			Parameters: map[string]interface{}{"deploymentName": "api", "containerName": "app", "newImage": "pearl:2"}},
+	}
+	clientset := newDeploymentClientset()
+	for _, task := range tasks {
+		if err := performTask(context.Background(), clientset, "default", task, 0); err != nil {
+			t.Fatalf("%s: %v", task.Name, err)
+		}
+	}
+}
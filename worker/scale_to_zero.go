@@ -0,0 +1,138 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/H0llyW00dzZ/K8sBlackPearl/language"
+	"github.com/H0llyW00dzZ/K8sBlackPearl/navigator"
+	"github.com/H0llyW00dzZ/go-urlshortner/logmonitor/constant"
+	"go.uber.org/zap"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/util/retry"
+)
+
+// ScaleDeploymentToZero records the current replica count of a deployment in the
+// 'k8sblackpearl.io/previousReplicas' annotation and scales the deployment to zero replicas.
+// Both changes are written in a single update, retried on conflicts. If the deployment already
+// carries the annotation (for example, it was scaled down by an earlier run), the recorded count
+// is kept so a later restore returns to the original size.
+//
+// Parameters:
+//
+//	ctx context.Context: Context for cancellation and timeout.
+//	clientset kubernetes.Interface: A Kubernetes clientset for interacting with the Kubernetes API.
+//	namespace string: The namespace of the deployment.
+//	deploymentName string: The name of the deployment to scale down.
+//	results chan<- string: A channel to send operation results for logging.
+//	logger *zap.Logger: A logger for structured logging.
+//
+// Returns an error if the deployment cannot be read or updated.
+func ScaleDeploymentToZero(ctx context.Context, clientset kubernetes.Interface, namespace, deploymentName string, results chan<- string, logger *zap.Logger) error {
+	var previousReplicas int32
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		deployment, err := clientset.AppsV1().Deployments(namespace).Get(ctx, deploymentName, v1.GetOptions{})
+		if err != nil {
+			return fmt.Errorf(language.FailedToGetDeployment, deploymentName, err)
+		}
+
+		previousReplicas = 1
+		if deployment.Spec.Replicas != nil {
+			previousReplicas = *deployment.Spec.Replicas
+		}
+		if recorded, found := deployment.Annotations[previousReplicasAnnotation]; found {
+			if parsed, err := strconv.ParseInt(recorded, 10, 32); err == nil {
+				previousReplicas = int32(parsed)
+			}
+		}
+
+		if deployment.Annotations == nil {
+			deployment.Annotations = make(map[string]string)
+		}
+		deployment.Annotations[previousReplicasAnnotation] = strconv.Itoa(int(previousReplicas))
+		deployment.Spec.Replicas = int32Ptr(0)
+
+		_, err = clientset.AppsV1().Deployments(namespace).Update(ctx, deployment, v1.UpdateOptions{})
+		return err
+	})
+	if err != nil {
+		return reportScaleToZeroFailure(results, fmt.Sprintf(language.FailedTOScallEdDeployment, deploymentName, 0, err), err)
+	}
+
+	successMsg := fmt.Sprintf(language.ScaledDeploymentToZero, deploymentName, previousReplicas)
+	results <- successMsg
+	navigator.LogInfoWithEmoji(constant.SuccessEmoji, successMsg)
+	return nil
+}
+
+// RestoreDeploymentReplicas scales a deployment back to the replica count recorded by
+// ScaleDeploymentToZero and removes the 'k8sblackpearl.io/previousReplicas' annotation.
+// The update is retried on conflicts. A missing or malformed annotation is reported as a
+// non-retriable error, since retrying cannot recover the original replica count.
+//
+// Parameters:
+//
+//	ctx context.Context: Context for cancellation and timeout.
+//	clientset kubernetes.Interface: A Kubernetes clientset for interacting with the Kubernetes API.
+//	namespace string: The namespace of the deployment.
+//	deploymentName string: The name of the deployment to restore.
+//	results chan<- string: A channel to send operation results for logging.
+//	logger *zap.Logger: A logger for structured logging.
+//
+// Returns an error if the annotation is missing or the deployment cannot be updated.
+func RestoreDeploymentReplicas(ctx context.Context, clientset kubernetes.Interface, namespace, deploymentName string, results chan<- string, logger *zap.Logger) error {
+	var restoredReplicas int32
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		deployment, err := clientset.AppsV1().Deployments(namespace).Get(ctx, deploymentName, v1.GetOptions{})
+		if err != nil {
+			return fmt.Errorf(language.FailedToGetDeployment, deploymentName, err)
+		}
+
+		recorded, found := deployment.Annotations[previousReplicasAnnotation]
+		if !found {
+			return markNonRetriable(fmt.Errorf(language.ErrorPreviousReplicasMissing, deploymentName, previousReplicasAnnotation))
+		}
+		parsed, err := strconv.ParseInt(recorded, 10, 32)
+		if err != nil || parsed < 0 {
+			return markNonRetriable(fmt.Errorf(language.ErrorPreviousReplicasInvalid, recorded, previousReplicasAnnotation, deploymentName))
+		}
+		restoredReplicas = int32(parsed)
+
+		delete(deployment.Annotations, previousReplicasAnnotation)
+		deployment.Spec.Replicas = int32Ptr(restoredReplicas)
+
+		_, err = clientset.AppsV1().Deployments(namespace).Update(ctx, deployment, v1.UpdateOptions{})
+		return err
+	})
+	if err != nil {
+		return reportScaleToZeroFailure(results, fmt.Sprintf(language.ErrorFailedToRestoreReplicas, deploymentName, err), err)
+	}
+
+	successMsg := fmt.Sprintf(language.RestoredDeploymentReplicas, deploymentName, restoredReplicas)
+	results <- successMsg
+	navigator.LogInfoWithEmoji(constant.SuccessEmoji, successMsg)
+	return nil
+}
+
+// reportScaleToZeroFailure sends an error message to the results channel, logs the failure,
+// and returns the error so callers can propagate it directly.
+//
+// This unexported function is used internally by ScaleDeploymentToZero and RestoreDeploymentReplicas.
+func reportScaleToZeroFailure(results chan<- string, errorMessage string, err error) error {
+	results <- errorMessage
+	navigator.LogErrorWithEmojiRateLimited(constant.ErrorEmoji, errorMessage, zap.Error(err))
+	return err
+}
+
+// extractDeploymentNameParameter extracts and validates the 'deploymentName' parameter.
+//
+// This function is used by task runners that operate on a single deployment by name.
+func extractDeploymentNameParameter(parameters map[string]interface{}) (string, error) {
+	deploymentName, err := getParamAsString(parameters, deploYmentName)
+	if err != nil || deploymentName == "" {
+		return "", newParameterError(deploYmentName, nil, language.ErrorParameterDeploymentName)
+	}
+	return deploymentName, nil
+}
@@ -0,0 +1,193 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/H0llyW00dzZ/K8sBlackPearl/language"
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// secretCacheKey is the context key under which a run-wide SecretCache is stored.
+type secretCacheKey struct{}
+
+// SecretCache caches Secrets read while resolving 'secretRef' parameters, so a Secret referenced
+// by several tasks (or several attempts of the same task) is fetched only once per run.
+// It is safe for concurrent use by multiple workers.
+type SecretCache struct {
+	mu      sync.Mutex
+	secrets map[string]*corev1.Secret
+}
+
+// NewSecretCache initializes an empty SecretCache.
+//
+// Returns:
+//
+//	*SecretCache: A pointer to the newly created SecretCache instance.
+func NewSecretCache() *SecretCache {
+	return &SecretCache{
+		secrets: make(map[string]*corev1.Secret),
+	}
+}
+
+// WithSecretCache returns a copy of the context carrying the given SecretCache.
+// CaptainTellWorkers attaches one cache per run; callers driving CrewWorker directly
+// can use this to share a cache across their own workers.
+func WithSecretCache(ctx context.Context, cache *SecretCache) context.Context {
+	return context.WithValue(ctx, secretCacheKey{}, cache)
+}
+
+// secretCacheFromContext returns the SecretCache carried by the context, or a fresh
+// cache scoped to the current call when none is present.
+func secretCacheFromContext(ctx context.Context) *SecretCache {
+	if cache, ok := ctx.Value(secretCacheKey{}).(*SecretCache); ok && cache != nil {
+		return cache
+	}
+	return NewSecretCache()
+}
+
+// get returns the named Secret from the cache, reading it from the API on the first request.
+func (c *SecretCache) get(ctx context.Context, clientset kubernetes.Interface, namespace, name string) (*corev1.Secret, error) {
+	cacheKey := namespace + "/" + name
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if secret, found := c.secrets[cacheKey]; found {
+		return secret, nil
+	}
+
+	secret, err := clientset.CoreV1().Secrets(namespace).Get(ctx, name, v1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf(language.ErrorSecretRefNotFound, name, namespace, err)
+	}
+	c.secrets[cacheKey] = secret
+	return secret, nil
+}
+
+// resolveSecretParameters returns a copy of the parameters in which every value of the form
+// {"secretRef": {"name": "...", "key": "..."}} is replaced by the decoded value of that key in the
+// referenced Secret of the given namespace. Nested maps and lists are resolved as well. The original
+// parameters are never modified, since tasks are shared between workers.
+//
+// Resolved values must never be logged; only the Secret name and key appear in error messages.
+//
+// Parameters:
+//
+//	ctx context.Context: Context for cancellation and timeout.
+//	clientset kubernetes.Interface: A Kubernetes clientset used to read the referenced Secrets.
+//	namespace string: The namespace in which the referenced Secrets live.
+//	parameters map[string]interface{}: The task parameters to resolve.
+//
+// Returns:
+//
+//	map[string]interface{}: The parameters with all secret references substituted.
+//	error: An error if a referenced Secret or key does not exist, or a reference is malformed.
+func resolveSecretParameters(ctx context.Context, clientset kubernetes.Interface, namespace string, parameters map[string]interface{}) (map[string]interface{}, error) {
+	if !containsSecretRef(parameters) {
+		return parameters, nil
+	}
+
+	cache := secretCacheFromContext(ctx)
+	resolved, err := resolveSecretValue(ctx, clientset, cache, namespace, parameters)
+	if err != nil {
+		return nil, err
+	}
+	return resolved.(map[string]interface{}), nil
+}
+
+// resolveSecretValue resolves secret references within a single parameter value.
+//
+// This unexported function is used internally by resolveSecretParameters.
+func resolveSecretValue(ctx context.Context, clientset kubernetes.Interface, cache *SecretCache, namespace string, value interface{}) (interface{}, error) {
+	switch v := value.(type) {
+	case []interface{}:
+		resolvedList := make([]interface{}, len(v))
+		for i, item := range v {
+			resolvedItem, err := resolveSecretValue(ctx, clientset, cache, namespace, item)
+			if err != nil {
+				return nil, err
+			}
+			resolvedList[i] = resolvedItem
+		}
+		return resolvedList, nil
+	case map[string]interface{}, map[interface{}]interface{}:
+		entries, ok := toStringInterfaceMap(v)
+		if !ok {
+			return value, nil
+		}
+		if ref, isRef := entries[secretReF]; isRef && len(entries) == 1 {
+			return lookupSecretRef(ctx, clientset, cache, namespace, ref)
+		}
+		resolvedMap := make(map[string]interface{}, len(entries))
+		for key, item := range entries {
+			resolvedItem, err := resolveSecretValue(ctx, clientset, cache, namespace, item)
+			if err != nil {
+				return nil, err
+			}
+			resolvedMap[key] = resolvedItem
+		}
+		return resolvedMap, nil
+	default:
+		return value, nil
+	}
+}
+
+// lookupSecretRef reads the value referenced by a single 'secretRef' object.
+//
+// This unexported function is used internally by resolveSecretValue.
+func lookupSecretRef(ctx context.Context, clientset kubernetes.Interface, cache *SecretCache, namespace string, ref interface{}) (string, error) {
+	refMap, ok := toStringInterfaceMap(ref)
+	if !ok {
+		return "", fmt.Errorf(language.ErrorInvalidSecretRef)
+	}
+	name, err := getParamAsString(refMap, secretRefName)
+	if err != nil || name == "" {
+		return "", fmt.Errorf(language.ErrorInvalidSecretRef)
+	}
+	key, err := getParamAsString(refMap, secretRefKey)
+	if err != nil || key == "" {
+		return "", fmt.Errorf(language.ErrorInvalidSecretRef)
+	}
+
+	secret, err := cache.get(ctx, clientset, namespace, name)
+	if err != nil {
+		return "", err
+	}
+	data, found := secret.Data[key]
+	if !found {
+		return "", fmt.Errorf(language.ErrorSecretRefKeyNotFound, key, name, namespace)
+	}
+	return string(data), nil
+}
+
+// containsSecretRef reports whether any value in the parameters is a secret reference,
+// so that tasks without references skip resolution entirely.
+//
+// This unexported function is used internally by resolveSecretParameters.
+func containsSecretRef(value interface{}) bool {
+	switch v := value.(type) {
+	case []interface{}:
+		for _, item := range v {
+			if containsSecretRef(item) {
+				return true
+			}
+		}
+	case map[string]interface{}, map[interface{}]interface{}:
+		entries, ok := toStringInterfaceMap(v)
+		if !ok {
+			return false
+		}
+		if _, isRef := entries[secretReF]; isRef && len(entries) == 1 {
+			return true
+		}
+		for _, item := range entries {
+			if containsSecretRef(item) {
+				return true
+			}
+		}
+	}
+	return false
+}
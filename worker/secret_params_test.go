@@ -0,0 +1,69 @@
+package worker
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestResolveSecretParameters(t *testing.T) {
+	clientset := fake.NewSimpleClientset(&corev1.Secret{
+		ObjectMeta: v1.ObjectMeta{Name: "db", Namespace: "default"},
+		Data:       map[string][]byte{"password": []byte("hunter2"), "user": []byte("pearl")},
+	})
+	ctx := WithSecretCache(context.Background(), NewSecretCache())
+	parameters := map[string]interface{}{
+		"password": map[string]interface{}{"secretRef": map[string]interface{}{"name": "db", "key": "password"}},
+		"env":      []interface{}{map[string]interface{}{"secretRef": map[string]interface{}{"name": "db", "key": "user"}}},
+		"replicas": 2,
+	}
+
+	resolved, err := resolveSecretParameters(ctx, clientset, "default", parameters)
+	if err != nil {
+		t.Fatalf("resolveSecretParameters: %v", err)
+	}
+	if resolved["password"] != "hunter2" || resolved["env"].([]interface{})[0] != "pearl" || resolved["replicas"] != 2 {
+		t.Fatalf("unexpected resolved parameters %v", resolved)
+	}
+	if _, isRef := parameters["password"].(map[string]interface{}); !isRef {
+		t.Fatal("the original parameters were modified")
+	}
+
+	gets := 0
+	for _, action := range clientset.Actions() {
+		if action.GetVerb() == "get" {
+			gets++
+		}
+	}
+	if gets != 1 {
+		t.Fatalf("the secret was read %d times, want once per run", gets)
+	}
+}
+
+func TestResolveSecretParametersErrors(t *testing.T) {
+	clientset := fake.NewSimpleClientset(&corev1.Secret{
+		ObjectMeta: v1.ObjectMeta{Name: "db", Namespace: "default"},
+		Data:       map[string][]byte{"password": []byte("hunter2")},
+	})
+	for name, tc := range map[string]struct {
+		ref  map[string]interface{}
+		want string
+	}{
+		"missing secret": {map[string]interface{}{"name": "cache", "key": "password"}, "failed to read secret 'cache'"},
+		"missing key":    {map[string]interface{}{"name": "db", "key": "token"}, "key 'token' not found in secret 'db'"},
+		"malformed ref":  {map[string]interface{}{"name": "db"}, "invalid 'secretRef'"},
+	} {
+		parameters := map[string]interface{}{"value": map[string]interface{}{"secretRef": tc.ref}}
+		_, err := resolveSecretParameters(context.Background(), clientset, "default", parameters)
+		if err == nil || !strings.Contains(err.Error(), tc.want) {
+			t.Fatalf("%s: got %v, want an error containing %q", name, err, tc.want)
+		}
+		if strings.Contains(err.Error(), "hunter2") {
+			t.Fatalf("%s: the error %q leaks a secret value", name, err)
+		}
+	}
+}
@@ -0,0 +1,92 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/H0llyW00dzZ/K8sBlackPearl/language"
+	"github.com/H0llyW00dzZ/K8sBlackPearl/navigator"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// secretMetadata is the non-sensitive description of a Secret: its name, type, key names, and age.
+// It deliberately has no field that could hold Secret data.
+type secretMetadata struct {
+	name string
+	kind corev1.SecretType
+	keys []string
+	age  time.Duration
+}
+
+// listSecretsMetadata lists the Secrets in a namespace, optionally filtered by a label selector, and
+// returns only their metadata. Secret values are discarded as soon as the key names have been read,
+// so they can never reach the results channel or the logs.
+//
+// Parameters:
+//
+//	ctx context.Context: Context for cancellation and timeout.
+//	clientset kubernetes.Interface: A Kubernetes clientset for interacting with the Kubernetes API.
+//	namespace string: The namespace whose Secrets should be listed.
+//	labelSelector string: An optional label selector to filter Secrets.
+//
+// Returns:
+//
+//	[]secretMetadata: The name, type, sorted key names, and age of each Secret.
+//	error: An error if the Secrets cannot be listed.
+func listSecretsMetadata(ctx context.Context, clientset kubernetes.Interface, namespace, labelSelector string) ([]secretMetadata, error) {
+	secretList, err := clientset.CoreV1().Secrets(namespace).List(ctx, v1.ListOptions{LabelSelector: labelSelector})
+	if err != nil {
+		return nil, fmt.Errorf(language.ErrorListingSecrets, err)
+	}
+
+	now := time.Now()
+	metadata := make([]secretMetadata, 0, len(secretList.Items))
+	for _, secret := range secretList.Items {
+		keys := make([]string, 0, len(secret.Data)+len(secret.StringData))
+		for key := range secret.Data {
+			keys = append(keys, key)
+		}
+		for key := range secret.StringData {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		metadata = append(metadata, secretMetadata{
+			name: secret.Name,
+			kind: secret.Type,
+			keys: keys,
+			age:  now.Sub(secret.CreationTimestamp.Time).Truncate(time.Second),
+		})
+	}
+	return metadata, nil
+}
+
+// reportSecretsMetadata sends one line per Secret through the results channel and logs the same
+// metadata with structured fields. Only names, types, key names, and ages are reported.
+//
+// Parameters:
+//
+//	baseFields []zap.Field: A slice of zap.Field structs providing contextual logging information.
+//	metadata []secretMetadata: The Secret metadata to report.
+//	results chan<- string: A channel with room for one message per Secret.
+func reportSecretsMetadata(baseFields []zap.Field, metadata []secretMetadata, results chan<- string) {
+	for _, secret := range metadata {
+		keyList := strings.Join(secret.keys, ", ")
+		message := fmt.Sprintf(language.SecretMetadata, secret.name, secret.kind, keyList, secret.age)
+		results <- message
+
+		secretFields := append([]zap.Field(nil), baseFields...)
+		secretFields = append(secretFields,
+			zap.String(language.SecretName, secret.name),
+			zap.String(language.SecretType, string(secret.kind)),
+			zap.Strings(language.SecretKeys, secret.keys),
+			zap.Duration(language.Age, secret.age),
+		)
+		navigator.LogInfoWithEmoji(language.PirateEmoji, message, secretFields...)
+	}
+}
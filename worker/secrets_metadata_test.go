@@ -0,0 +1,52 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/H0llyW00dzZ/K8sBlackPearl/worker/configuration"
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestCrewListSecretsMetadataNeverReportsValues(t *testing.T) {
+	clientset := fake.NewSimpleClientset(
+		&corev1.Secret{
+			ObjectMeta: v1.ObjectMeta{Name: "db", Namespace: "default", Labels: map[string]string{"app": "api"}},
+			Type:       corev1.SecretTypeOpaque,
+			Data:       map[string][]byte{"password": []byte("hunter2"), "username": []byte("jack-sparrow")},
+		},
+		&corev1.Secret{
+			ObjectMeta: v1.ObjectMeta{Name: "other", Namespace: "default"},
+			Data:       map[string][]byte{"token": []byte("rum")},
+		},
+	)
+	task := configuration.Task{
+		Name:       "inventory",
+		Type:       "CrewListSecretsMetadata",
+		Parameters: map[string]interface{}{"labelSelector": "app=api"},
+	}
+	logs := observeLogs(t)
+
+	if err := (&CrewListSecretsMetadata{}).Run(context.Background(), clientset, "default", task, task.Parameters, 0); err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+
+	var output strings.Builder
+	for _, entry := range logs.All() {
+		fmt.Fprintln(&output, entry.Message, entry.ContextMap())
+	}
+	for _, want := range []string{"Secret 'db' type=Opaque keys=[password, username]", "password", "username"} {
+		if !strings.Contains(output.String(), want) {
+			t.Fatalf("the output does not contain %q:\n%s", want, output.String())
+		}
+	}
+	for _, leaked := range []string{"hunter2", "jack-sparrow", "other"} {
+		if strings.Contains(output.String(), leaked) {
+			t.Fatalf("the output contains %q:\n%s", leaked, output.String())
+		}
+	}
+}
@@ -0,0 +1,146 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/H0llyW00dzZ/K8sBlackPearl/language"
+	"github.com/H0llyW00dzZ/K8sBlackPearl/navigator"
+	"go.uber.org/zap"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// serviceEndpointStatus lists the ready and not-ready backend addresses of a Service.
+type serviceEndpointStatus struct {
+	ready    []string
+	notReady []string
+}
+
+// checkServiceEndpoints reads the backends of a Service from its EndpointSlices and classifies each
+// address as ready or not ready. If the Service has no EndpointSlices, its legacy Endpoints object is
+// read instead. An endpoint whose ready condition is unknown is counted as ready, as the EndpointSlice
+// API specifies.
+//
+// Parameters:
+//
+//	ctx context.Context: Context for cancellation and timeout.
+//	clientset kubernetes.Interface: A Kubernetes clientset for interacting with the Kubernetes API.
+//	namespace string: The namespace of the Service.
+//	serviceName string: The name of the Service.
+//
+// Returns:
+//
+//	serviceEndpointStatus: The ready and not-ready addresses, formatted as "ip:port".
+//	error: An error if the Service or its endpoints cannot be read.
+func checkServiceEndpoints(ctx context.Context, clientset kubernetes.Interface, namespace, serviceName string) (serviceEndpointStatus, error) {
+	if _, err := clientset.CoreV1().Services(namespace).Get(ctx, serviceName, v1.GetOptions{}); err != nil {
+		if apierrors.IsNotFound(err) {
+			return serviceEndpointStatus{}, markNonRetriable(fmt.Errorf(language.ErrorServiceNotFound, serviceName, namespace, err))
+		}
+		return serviceEndpointStatus{}, err
+	}
+
+	slices, err := clientset.DiscoveryV1().EndpointSlices(namespace).List(ctx, v1.ListOptions{
+		LabelSelector: discoveryv1.LabelServiceName + "=" + serviceName,
+	})
+	if err != nil {
+		return serviceEndpointStatus{}, fmt.Errorf(language.ErrorListingEndpointSlices, err)
+	}
+	if len(slices.Items) == 0 {
+		return readLegacyEndpoints(ctx, clientset, namespace, serviceName)
+	}
+
+	var status serviceEndpointStatus
+	for _, slice := range slices.Items {
+		for _, endpoint := range slice.Endpoints {
+			ready := endpoint.Conditions.Ready == nil || *endpoint.Conditions.Ready
+			for _, address := range endpoint.Addresses {
+				for _, port := range slice.Ports {
+					target := address
+					if port.Port != nil {
+						target = address + ":" + strconv.Itoa(int(*port.Port))
+					}
+					if ready {
+						status.ready = append(status.ready, target)
+					} else {
+						status.notReady = append(status.notReady, target)
+					}
+				}
+			}
+		}
+	}
+	return status, nil
+}
+
+// readLegacyEndpoints classifies the addresses of the core/v1 Endpoints object of a Service.
+//
+// This unexported function is used internally by checkServiceEndpoints.
+func readLegacyEndpoints(ctx context.Context, clientset kubernetes.Interface, namespace, serviceName string) (serviceEndpointStatus, error) {
+	endpoints, err := clientset.CoreV1().Endpoints(namespace).Get(ctx, serviceName, v1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		// A Service without a selector and without Endpoints simply has no backends.
+		return serviceEndpointStatus{}, nil
+	}
+	if err != nil {
+		return serviceEndpointStatus{}, fmt.Errorf(language.ErrorGettingEndpoints, serviceName, err)
+	}
+
+	var status serviceEndpointStatus
+	for _, subset := range endpoints.Subsets {
+		for _, port := range subset.Ports {
+			suffix := ":" + strconv.Itoa(int(port.Port))
+			for _, address := range subset.Addresses {
+				status.ready = append(status.ready, address.IP+suffix)
+			}
+			for _, address := range subset.NotReadyAddresses {
+				status.notReady = append(status.notReady, address.IP+suffix)
+			}
+		}
+	}
+	return status, nil
+}
+
+// reportServiceEndpoints sends a summary line followed by one line per address through the results
+// channel and logs the same information with structured fields. It stops early if the context is cancelled.
+//
+// Parameters:
+//
+//	ctx context.Context: Context for cancellation.
+//	baseFields []zap.Field: A slice of zap.Field structs providing contextual logging information.
+//	serviceName string: The name of the Service.
+//	status serviceEndpointStatus: The addresses to report.
+//	results chan<- string: A channel with room for the summary and one message per address.
+//
+// Returns an error if the context is cancelled before everything is reported.
+func reportServiceEndpoints(ctx context.Context, baseFields []zap.Field, serviceName string, status serviceEndpointStatus, results chan<- string) error {
+	summary := fmt.Sprintf(language.ServiceEndpointsSummary, serviceName, len(status.ready), len(status.notReady))
+	results <- summary
+
+	summaryFields := append([]zap.Field(nil), baseFields...)
+	summaryFields = append(summaryFields,
+		zap.String(language.ServiceName, serviceName),
+		zap.Int(language.ReadyAddresses, len(status.ready)),
+		zap.Int(language.NotReadyAddresses, len(status.notReady)),
+	)
+	navigator.LogInfoWithEmoji(language.PirateEmoji, summary, summaryFields...)
+
+	lines := make([]string, 0, len(status.ready)+len(status.notReady))
+	for _, address := range status.ready {
+		lines = append(lines, fmt.Sprintf(language.ServiceEndpointAddress, serviceName, address, language.EndpointReady))
+	}
+	for _, address := range status.notReady {
+		lines = append(lines, fmt.Sprintf(language.ServiceEndpointAddress, serviceName, address, language.EndpointNotReady))
+	}
+	for _, line := range lines {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		results <- line
+		navigator.LogInfoWithEmoji(language.PirateEmoji, line, baseFields...)
+	}
+	return nil
+}
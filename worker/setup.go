@@ -2,58 +2,121 @@ package worker
 
 import (
 	"fmt"
-	"os"
-	"path/filepath"
 	"time"
 
-	"github.com/H0llyW00dzZ/ChatGPT-Next-Web-Session-Exporter/bannercli"
+	"k8s.io/client-go/discovery"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
-	"k8s.io/client-go/tools/clientcmd"
 )
 
 // NewKubernetesClient creates a new Kubernetes client using the in-cluster configuration
-// or the kubeconfig file, depending on the environment.
+// or the kubeconfig file, depending on the environment. The startup banners it prints can be replaced
+// by a single structured log line with SetQuietStartup.
 //
 // Returns:
 //
-//	*kubernetes.Clientset: A pointer to a Kubernetes Clientset ready for API interactions.
+//	*kubernetes.Clientset: A pointer to a Kubernetes Clientset ready for API interactions. It satisfies
+//	kubernetes.Interface, which is what CaptainTellWorkers and every TaskRunner accept.
 //	error: An error if the configuration fails or the client cannot be created.
 func NewKubernetesClient() (*kubernetes.Clientset, error) {
-	config, err := rest.InClusterConfig()
+	config, err := buildConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	return newClientsetForConfig(config)
+}
+
+// NewKubernetesClientWithHealthCheck creates a new Kubernetes client the same way as NewKubernetesClient,
+// then verifies that the API server is reachable by requesting its version within the given timeout.
+// This surfaces an unreachable cluster at startup instead of on the first task.
+//
+// Parameters:
+//
+//	timeout time.Duration: The maximum duration to wait for the API server to respond.
+//
+// Returns:
+//
+//	*kubernetes.Clientset: A pointer to a Kubernetes Clientset ready for API interactions.
+//	error: An error if the client cannot be created or the API server is unreachable.
+func NewKubernetesClientWithHealthCheck(timeout time.Duration) (*kubernetes.Clientset, error) {
+	config, err := buildConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	clientset, err := newClientsetForConfig(config)
+	if err != nil {
+		return nil, err
+	}
+
+	// Use a copy of the configuration bounded by the timeout so the probe cannot hang.
+	probeConfig := rest.CopyConfig(config)
+	probeConfig.Timeout = timeout
+	probeClient, err := discovery.NewDiscoveryClientForConfig(probeConfig)
 	if err != nil {
-		// Notify that the setup is not running in a Kubernetes cluster.
-		bannercli.PrintTypingBanner(notifyintializeNotInCluster, 200*time.Millisecond)
-		time.Sleep(500 * time.Millisecond)
-		bannercli.PrintAnimatedBanner(intializeoutOfCluster, 1, 200*time.Millisecond)
+		return nil, err
+	}
+
+	if err := checkServerReachable(probeClient, timeout); err != nil {
+		return nil, err
+	}
+
+	return clientset, nil
+}
+
+// newClientsetForConfig creates a clientset from the configuration and registers a dynamic client
+// built from the same configuration for it, for the runners working with arbitrary resources.
+//
+// This unexported function is used internally by the Kubernetes client constructors.
+func newClientsetForConfig(config *rest.Config) (*kubernetes.Clientset, error) {
+	clientset, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return nil, err
+	}
+	if err := registerDynamicClientForConfig(clientset, config); err != nil {
+		return nil, err
+	}
+	return clientset, nil
+}
 
-		config, err = buildOutOfClusterConfig()
+// checkServerReachable asks the API server for its version and reports an error if it does not
+// answer within the timeout. It accepts the ServerVersionInterface so a fake discovery client can
+// be used in place of a real cluster.
+//
+// Parameters:
+//
+//	client discovery.ServerVersionInterface: The discovery client used to query the server version.
+//	timeout time.Duration: The maximum duration to wait for the API server to respond.
+//
+// Returns:
+//
+//	error: An error if the API server is unreachable or does not respond in time.
+func checkServerReachable(client discovery.ServerVersionInterface, timeout time.Duration) error {
+	errCh := make(chan error, 1)
+	go func() {
+		_, err := client.ServerVersion()
+		errCh <- err
+	}()
+
+	select {
+	case err := <-errCh:
 		if err != nil {
-			return nil, err
+			return fmt.Errorf(errClusterUnreachable, err)
 		}
-	} else {
-		// Notify that the setup is running in a Kubernetes cluster.
-		bannercli.PrintTypingBanner(readyTogo, 200*time.Millisecond)
+		return nil
+	case <-time.After(timeout):
+		return fmt.Errorf(errClusterUnreachable, fmt.Errorf(errHealthCheckTimeout, timeout))
 	}
-
-	return kubernetes.NewForConfig(config)
 }
 
-// buildOutOfClusterConfig attempts to build a configuration from the kubeconfig file.
+// buildConfig resolves the Kubernetes client configuration, preferring the in-cluster
+// configuration and falling back to the kubeconfig file when not running in a cluster.
 //
 // Returns:
 //
 //	*rest.Config: A configuration object for the Kubernetes client.
-//	error: An error if the kubeconfig file cannot be found or is invalid.
-func buildOutOfClusterConfig() (*rest.Config, error) {
-	homeDir, found := os.LookupEnv(homeEnvVar)
-	if !found {
-		errMsg := fmt.Sprintf(errEnvVar, homeEnvVar)
-		bannercli.PrintTypingBanner(errMsg, 200*time.Millisecond)
-		return nil, fmt.Errorf(errMsg)
-	}
-
-	kubeconfigPath := filepath.Join(homeDir, dotKubeDir, kubeConfigFile)
-	bannercli.PrintTypingBanner(readyTogo, 200*time.Millisecond)
-	return clientcmd.BuildConfigFromFlags("", kubeconfigPath)
+//	error: An error if neither configuration source can be used.
+func buildConfig() (*rest.Config, error) {
+	return buildConfigWithOptions(KubeClientOptions{Mode: KubeClientModeAuto})
 }
@@ -0,0 +1,49 @@
+package worker
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/version"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+// unreachableServer is a discovery client whose version request fails, or never answers when blocked.
+type unreachableServer struct {
+	block chan struct{}
+}
+
+// ServerVersion fails, after waiting for the block channel to close when one is set.
+func (s unreachableServer) ServerVersion() (*version.Info, error) {
+	if s.block != nil {
+		<-s.block
+	}
+	return nil, errors.New("connection refused")
+}
+
+func TestCheckServerReachable(t *testing.T) {
+	reachable := fake.NewSimpleClientset().Discovery().(*fakediscovery.FakeDiscovery)
+	reachable.FakedServerVersion = &version.Info{GitVersion: "v1.30.2"}
+	if err := checkServerReachable(reachable, time.Second); err != nil {
+		t.Fatalf("a reachable cluster was reported as unreachable: %v", err)
+	}
+
+	err := checkServerReachable(unreachableServer{}, time.Second)
+	if err == nil || !strings.Contains(err.Error(), "unreachable") || !strings.Contains(err.Error(), "connection refused") {
+		t.Fatalf("got %v, want an unreachable error carrying the cause", err)
+	}
+
+	block := make(chan struct{})
+	defer close(block)
+	start := time.Now()
+	err = checkServerReachable(unreachableServer{block: block}, 50*time.Millisecond)
+	if err == nil || !strings.Contains(err.Error(), "no response within 50ms") {
+		t.Fatalf("got %v, want a timeout error", err)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Fatalf("the probe waited %v despite its 50ms timeout", elapsed)
+	}
+}
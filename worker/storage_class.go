@@ -0,0 +1,144 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/H0llyW00dzZ/K8sBlackPearl/language"
+	"github.com/H0llyW00dzZ/K8sBlackPearl/navigator"
+	"github.com/H0llyW00dzZ/go-urlshortner/logmonitor/constant"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// CreateStorageClass creates a cluster-scoped StorageClass. Because the provisioner, parameters,
+// reclaim policy, and binding mode of a StorageClass are immutable, overwriting an existing
+// StorageClass deletes it and creates it again with the new definition. Existing volumes are
+// not affected by this replacement. The outcome is reported through the results channel.
+//
+// Parameters:
+//
+//	ctx context.Context: Context for cancellation and timeout.
+//	clientset kubernetes.Interface: A Kubernetes clientset for interacting with the Kubernetes API.
+//	storageClass *storagev1.StorageClass: The StorageClass to create.
+//	overwrite bool: Whether an existing StorageClass with the same name should be replaced.
+//	results chan<- string: A channel to send operation results for logging.
+//	logger *zap.Logger: A logger for structured logging.
+//
+// Returns an error if the StorageClass cannot be created or replaced.
+func CreateStorageClass(ctx context.Context, clientset kubernetes.Interface, storageClass *storagev1.StorageClass, overwrite bool, results chan<- string, logger *zap.Logger) error {
+	name := storageClass.Name
+	applyDefaultObjectMeta(storageClass)
+	created, err := clientset.StorageV1().StorageClasses().Create(ctx, storageClass, v1.CreateOptions{})
+	if err == nil {
+		publishCreatedObject(ctx, created)
+		successMsg := fmt.Sprintf(language.StorageClassSuccessfullyCreated, name)
+		results <- successMsg
+		navigator.LogInfoWithEmoji(constant.SuccessEmoji, successMsg)
+		return nil
+	}
+
+	if !apierrors.IsAlreadyExists(err) {
+		return reportStorageClassFailure(results, name, fmt.Errorf(language.ErrorCreatingStorageClass, err))
+	}
+	if !overwrite {
+		return reportStorageClassFailure(results, name, fmt.Errorf(language.ErrorStorageClassAlreadyExists, name))
+	}
+
+	if err := clientset.StorageV1().StorageClasses().Delete(ctx, name, v1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+		return reportStorageClassFailure(results, name, err)
+	}
+	created, err = clientset.StorageV1().StorageClasses().Create(ctx, storageClass, v1.CreateOptions{})
+	if err != nil {
+		return reportStorageClassFailure(results, name, fmt.Errorf(language.ErrorCreatingStorageClass, err))
+	}
+	publishCreatedObject(ctx, created)
+
+	successMsg := fmt.Sprintf(language.StorageClassSuccessfullyReplaced, name)
+	results <- successMsg
+	navigator.LogInfoWithEmoji(constant.SuccessEmoji, successMsg)
+	return nil
+}
+
+// reportStorageClassFailure sends an error message to the results channel, logs the failure,
+// and returns the error so callers can propagate it directly.
+//
+// This unexported function is used internally by CreateStorageClass to report failures.
+func reportStorageClassFailure(results chan<- string, name string, err error) error {
+	errorMessage := fmt.Sprintf(language.ErrorFailedToCreateStorageClass, name, err)
+	results <- errorMessage
+	navigator.LogErrorWithEmojiRateLimited(constant.ErrorEmoji, errorMessage, zap.Error(err))
+	return err
+}
+
+// extractStorageClassParameters extracts and validates the StorageClass definition from a map of
+// parameters. 'storageClassName' and 'provisioner' are required; 'parameters', 'reclaimPolicy',
+// 'volumeBindingMode', 'allowVolumeExpansion', and 'overwrite' are optional. The reclaim policy must
+// be 'Delete' or 'Retain' and the binding mode must be 'Immediate' or 'WaitForFirstConsumer'.
+//
+// This function is used by task runners that create StorageClasses.
+func extractStorageClassParameters(parameters map[string]interface{}) (*storagev1.StorageClass, bool, error) {
+	name, err := getParamAsString(parameters, storageClassName)
+	if err != nil || name == "" {
+		return nil, false, newParameterError(storageClassName, nil, language.ErrorParameterStorageClassName)
+	}
+	provisioner, err := getParamAsString(parameters, provisioneR)
+	if err != nil || provisioner == "" {
+		return nil, false, newParameterError(provisioneR, nil, language.ErrorParameterProvisioner)
+	}
+
+	storageClass := &storagev1.StorageClass{
+		ObjectMeta:  v1.ObjectMeta{Name: name},
+		Provisioner: provisioner,
+	}
+
+	if _, exists := parameters[provisionerParameters]; exists {
+		storageClass.Parameters, err = getParamAsStringMap(parameters, provisionerParameters)
+		if err != nil {
+			return nil, false, err
+		}
+	}
+
+	reclaimPolicy, err := getOptionalParamAsString(parameters, reclaimPolicY, "")
+	if err != nil {
+		return nil, false, err
+	}
+	if reclaimPolicy != "" {
+		policy := corev1.PersistentVolumeReclaimPolicy(reclaimPolicy)
+		if policy != corev1.PersistentVolumeReclaimDelete && policy != corev1.PersistentVolumeReclaimRetain {
+			return nil, false, fmt.Errorf(language.ErrorInvalidReclaimPolicy, reclaimPolicy)
+		}
+		storageClass.ReclaimPolicy = &policy
+	}
+
+	bindingMode, err := getOptionalParamAsString(parameters, volumeBindingModE, "")
+	if err != nil {
+		return nil, false, err
+	}
+	if bindingMode != "" {
+		mode := storagev1.VolumeBindingMode(bindingMode)
+		if mode != storagev1.VolumeBindingImmediate && mode != storagev1.VolumeBindingWaitForFirstConsumer {
+			return nil, false, fmt.Errorf(language.ErrorInvalidVolumeBindingMode, bindingMode)
+		}
+		storageClass.VolumeBindingMode = &mode
+	}
+
+	if _, exists := parameters[allowVolumeExpansioN]; exists {
+		allowExpansion, err := getOptionalParamAsBool(parameters, allowVolumeExpansioN, false)
+		if err != nil {
+			return nil, false, err
+		}
+		storageClass.AllowVolumeExpansion = &allowExpansion
+	}
+
+	overwrite, err := getOptionalParamAsBool(parameters, overwritE, false)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return storageClass, overwrite, nil
+}
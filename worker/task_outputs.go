@@ -0,0 +1,342 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"sync"
+
+	"github.com/H0llyW00dzZ/K8sBlackPearl/language"
+	"github.com/H0llyW00dzZ/K8sBlackPearl/worker/configuration"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// taskOutputReference matches a reference of the form ${tasks.<name>.output.<key>} in a parameter value.
+var taskOutputReference = regexp.MustCompile(`\$\{tasks\.([^.}]+)\.output\.([^}]+)\}`)
+
+// taskOutputStoreKey is the context key under which a run-wide TaskOutputStore is stored.
+type taskOutputStoreKey struct{}
+
+// taskOutputTaskKey is the context key under which the name of the running task is stored,
+// so that the outputs it publishes are attributed to it.
+type taskOutputTaskKey struct{}
+
+// TaskOutputStore holds the outputs published by tasks during a run, keyed by task name, so that
+// later tasks can reference them as ${tasks.<name>.output.<key>} in their parameters. It also tracks
+// the completion of the run's tasks, so that a task referencing their outputs waits for them.
+// It is safe for concurrent use by multiple workers.
+type TaskOutputStore struct {
+	mu        sync.RWMutex
+	outputs   map[string]map[string]string
+	producers map[string]*taskOutputProducer
+}
+
+// taskOutputProducer tracks the completion of a task of the run whose outputs may be referenced.
+type taskOutputProducer struct {
+	done chan struct{} // Closed once the task has completed.
+	err  error         // The error the task failed with, or nil; set before done is closed.
+}
+
+// NewTaskOutputStore initializes an empty TaskOutputStore.
+//
+// Returns:
+//
+//	*TaskOutputStore: A pointer to the newly created TaskOutputStore instance.
+func NewTaskOutputStore() *TaskOutputStore {
+	return &TaskOutputStore{
+		outputs:   make(map[string]map[string]string),
+		producers: make(map[string]*taskOutputProducer),
+	}
+}
+
+// newRunTaskOutputStore initializes a TaskOutputStore that tracks the completion of the given tasks.
+//
+// This unexported function is used internally by newRunContext and runReconcileCycle.
+func newRunTaskOutputStore(tasks []configuration.Task) *TaskOutputStore {
+	store := NewTaskOutputStore()
+	for _, task := range tasks {
+		store.producers[task.Name] = &taskOutputProducer{done: make(chan struct{})}
+	}
+	return store
+}
+
+// WithTaskOutputStore returns a copy of the context carrying the given TaskOutputStore.
+// CaptainTellWorkers and CaptainTellWorkersPerNamespace attach one store per run; callers driving
+// CrewWorker directly can use this to share outputs across their own workers.
+func WithTaskOutputStore(ctx context.Context, store *TaskOutputStore) context.Context {
+	return context.WithValue(ctx, taskOutputStoreKey{}, store)
+}
+
+// taskOutputStoreFromContext returns the TaskOutputStore carried by the context, or nil.
+func taskOutputStoreFromContext(ctx context.Context) *TaskOutputStore {
+	store, _ := ctx.Value(taskOutputStoreKey{}).(*TaskOutputStore)
+	return store
+}
+
+// Publish records an output value of the named task, replacing any earlier value for the key.
+func (s *TaskOutputStore) Publish(taskName, key, value string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.outputs[taskName] == nil {
+		s.outputs[taskName] = make(map[string]string)
+	}
+	s.outputs[taskName][key] = value
+}
+
+// Get returns an output value of the named task and whether it has been published.
+func (s *TaskOutputStore) Get(taskName, key string) (string, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	value, found := s.outputs[taskName][key]
+	return value, found
+}
+
+// producer returns the completion tracker of the named task, or nil if the task is not part of the run.
+func (s *TaskOutputStore) producer(taskName string) *taskOutputProducer {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.producers[taskName]
+}
+
+// complete records that the named task has completed, failing with err if it is not nil, and wakes
+// the tasks waiting for its outputs. Only the first completion of a task is recorded.
+func (s *TaskOutputStore) complete(taskName string, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	producer := s.producers[taskName]
+	if producer == nil {
+		return
+	}
+	select {
+	case <-producer.done:
+	default:
+		producer.err = err
+		close(producer.done)
+	}
+}
+
+// recordTaskOutputCompletion records the completion of a task in the run's TaskOutputStore, if any.
+//
+// This unexported function is used internally by processTask.
+func recordTaskOutputCompletion(ctx context.Context, taskName string, err error) {
+	if store := taskOutputStoreFromContext(ctx); store != nil {
+		store.complete(taskName, err)
+	}
+}
+
+// withTaskOutputTask returns a copy of the context that attributes published outputs to the named task.
+//
+// This unexported function is used internally by performTask.
+func withTaskOutputTask(ctx context.Context, taskName string) context.Context {
+	return context.WithValue(ctx, taskOutputTaskKey{}, taskName)
+}
+
+// PublishTaskOutput records an output of the task running with the given context in the run's
+// TaskOutputStore. Runners call it to expose values, such as the name of a created object, to later
+// tasks. It does nothing when the context carries no store or no running task.
+func PublishTaskOutput(ctx context.Context, key, value string) {
+	store := taskOutputStoreFromContext(ctx)
+	taskName, _ := ctx.Value(taskOutputTaskKey{}).(string)
+	if store == nil || taskName == "" {
+		return
+	}
+	store.Publish(taskName, key, value)
+}
+
+// publishCreatedObject publishes the name and UID of an object created by the running task as
+// the 'name' and 'uid' outputs.
+//
+// This unexported function is used internally by the functions that create objects.
+func publishCreatedObject(ctx context.Context, obj v1.Object) {
+	PublishTaskOutput(ctx, outputNamE, obj.GetName())
+	PublishTaskOutput(ctx, outputUID, string(obj.GetUID()))
+}
+
+// resolveTaskOutputReferences returns a copy of the parameters in which every ${tasks.<name>.output.<key>}
+// reference inside a string value is replaced by the output published by that task. Nested maps and
+// lists are resolved as well. The original parameters are never modified, since tasks are shared
+// between workers.
+//
+// Resolution happens after the referenced tasks have completed: awaitTaskOutputProducers waits for
+// them first, for as long as the context allows. A reference to a task that is not part of the run,
+// that failed, or that completed without publishing the output is a non-retriable error naming the
+// dependency.
+//
+// Parameters:
+//
+//	ctx context.Context: The context carrying the run's TaskOutputStore.
+//	parameters map[string]interface{}: The task parameters to resolve.
+//
+// Returns:
+//
+//	map[string]interface{}: The parameters with all output references substituted.
+//	error: A non-retriable error if a referenced output is not available, or the context error.
+func resolveTaskOutputReferences(ctx context.Context, parameters map[string]interface{}) (map[string]interface{}, error) {
+	if len(referencedTaskNames(parameters)) == 0 {
+		return parameters, nil
+	}
+	if err := awaitTaskOutputProducers(ctx, parameters); err != nil {
+		return nil, err
+	}
+
+	resolved, err := resolveTaskOutputValue(taskOutputStoreFromContext(ctx), parameters)
+	if err != nil {
+		return nil, err
+	}
+	return resolved.(map[string]interface{}), nil
+}
+
+// awaitTaskOutputProducers waits until every task of the run referenced by the parameters has
+// completed, or the context is done. References to tasks that are not part of the run are left
+// for resolution to report.
+//
+// This unexported function is used internally by processTask and resolveTaskOutputReferences.
+func awaitTaskOutputProducers(ctx context.Context, parameters map[string]interface{}) error {
+	store := taskOutputStoreFromContext(ctx)
+	if store == nil {
+		return nil
+	}
+	for _, taskName := range referencedTaskNames(parameters) {
+		producer := store.producer(taskName)
+		if producer == nil {
+			continue
+		}
+		select {
+		case <-producer.done:
+		case <-ctx.Done():
+			return contextError(ctx)
+		}
+	}
+	return nil
+}
+
+// taskOutputProducersDone reports whether every task of the run referenced by the parameters has
+// completed, so that resolving them would not wait.
+//
+// This unexported function is used internally by CrewWorker.
+func taskOutputProducersDone(ctx context.Context, parameters map[string]interface{}) bool {
+	store := taskOutputStoreFromContext(ctx)
+	if store == nil {
+		return true
+	}
+	for _, taskName := range referencedTaskNames(parameters) {
+		if producer := store.producer(taskName); producer != nil {
+			select {
+			case <-producer.done:
+			default:
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// resolveTaskOutputValue resolves output references within a single parameter value.
+//
+// This unexported function is used internally by resolveTaskOutputReferences.
+func resolveTaskOutputValue(store *TaskOutputStore, value interface{}) (interface{}, error) {
+	switch v := value.(type) {
+	case string:
+		var missing error
+		resolved := taskOutputReference.ReplaceAllStringFunc(v, func(reference string) string {
+			match := taskOutputReference.FindStringSubmatch(reference)
+			if store != nil {
+				if output, found := store.Get(match[1], match[2]); found {
+					return output
+				}
+			}
+			if missing == nil {
+				missing = markNonRetriable(taskOutputNotAvailableError(store, match[1], match[2]))
+			}
+			return reference
+		})
+		if missing != nil {
+			return nil, missing
+		}
+		return resolved, nil
+	case []interface{}:
+		resolvedList := make([]interface{}, len(v))
+		for i, item := range v {
+			resolvedItem, err := resolveTaskOutputValue(store, item)
+			if err != nil {
+				return nil, err
+			}
+			resolvedList[i] = resolvedItem
+		}
+		return resolvedList, nil
+	case map[string]interface{}, map[interface{}]interface{}:
+		entries, ok := toStringInterfaceMap(v)
+		if !ok {
+			return value, nil
+		}
+		resolvedMap := make(map[string]interface{}, len(entries))
+		for key, item := range entries {
+			resolvedItem, err := resolveTaskOutputValue(store, item)
+			if err != nil {
+				return nil, err
+			}
+			resolvedMap[key] = resolvedItem
+		}
+		return resolvedMap, nil
+	default:
+		return value, nil
+	}
+}
+
+// taskOutputNotAvailableError describes why an output of the named task is not available: the task
+// is not part of the run, it failed, or it completed without publishing the output.
+//
+// This unexported function is used internally by resolveTaskOutputValue.
+func taskOutputNotAvailableError(store *TaskOutputStore, taskName, key string) error {
+	var producer *taskOutputProducer
+	if store != nil {
+		producer = store.producer(taskName)
+	}
+	if producer == nil {
+		return fmt.Errorf(language.ErrorTaskOutputProducerNotInRun, key, taskName, taskName)
+	}
+	select {
+	case <-producer.done:
+		if producer.err != nil {
+			// The error of the last attempt says more than the number of attempts made.
+			cause := producer.err
+			var taskErr *TaskExecutionError
+			if errors.As(cause, &taskErr) && taskErr.Cause != nil {
+				cause = taskErr.Cause
+			}
+			return fmt.Errorf(language.ErrorTaskOutputProducerFailed, key, taskName, taskName, cause)
+		}
+	default:
+	}
+	return fmt.Errorf(language.ErrorTaskOutputNotAvailable, key, taskName, taskName)
+}
+
+// referencedTaskNames returns the names of the tasks whose outputs are referenced by any string in
+// the parameters, in the order they are found, so that tasks without references skip resolution
+// entirely.
+//
+// This unexported function is used internally by resolveTaskOutputReferences, awaitTaskOutputProducers,
+// and taskOutputProducersDone.
+func referencedTaskNames(value interface{}) []string {
+	var names []string
+	switch v := value.(type) {
+	case string:
+		for _, match := range taskOutputReference.FindAllStringSubmatch(v, -1) {
+			names = append(names, match[1])
+		}
+	case []interface{}:
+		for _, item := range v {
+			names = append(names, referencedTaskNames(item)...)
+		}
+	case map[string]interface{}, map[interface{}]interface{}:
+		entries, ok := toStringInterfaceMap(v)
+		if !ok {
+			return nil
+		}
+		for _, item := range entries {
+			names = append(names, referencedTaskNames(item)...)
+		}
+	}
+	return names
+}
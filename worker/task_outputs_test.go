@@ -0,0 +1,136 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/H0llyW00dzZ/K8sBlackPearl/worker/configuration"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestResolveTaskOutputReferences(t *testing.T) {
+	store := NewTaskOutputStore()
+	store.Publish("create-config", "name", "settings")
+	ctx := WithTaskOutputStore(context.Background(), store)
+	parameters := map[string]interface{}{
+		"configMapName": "${tasks.create-config.output.name}",
+		"volumes":       []interface{}{map[string]interface{}{"source": "cm/${tasks.create-config.output.name}"}},
+		"replicas":      2,
+	}
+
+	resolved, err := resolveTaskOutputReferences(ctx, parameters)
+	if err != nil {
+		t.Fatalf("resolveTaskOutputReferences: %v", err)
+	}
+	if resolved["configMapName"] != "settings" {
+		t.Fatalf("got configMapName %v, want the published output", resolved["configMapName"])
+	}
+	nested := resolved["volumes"].([]interface{})[0].(map[string]interface{})
+	if nested["source"] != "cm/settings" {
+		t.Fatalf("got nested source %v, want cm/settings", nested["source"])
+	}
+	if parameters["configMapName"] != "${tasks.create-config.output.name}" {
+		t.Fatal("the original parameters were modified")
+	}
+}
+
+func TestMissingTaskOutputFailsWithoutRetries(t *testing.T) {
+	executions := 0
+	registerTestRunner(t, "TestConsumeOutput", func(_ context.Context, _ kubernetes.Interface, _ string, _ configuration.Task, _ map[string]interface{}, _ int) error {
+		executions++
+		return nil
+	})
+	task := configuration.Task{
+		Name:           "consume",
+		Type:           "TestConsumeOutput",
+		ShipsNamespace: "default",
+		MaxRetries:     3,
+		RetryDelay:     "1ms",
+		Parameters:     map[string]interface{}{"name": "${tasks.produce.output.name}"},
+	}
+	ctx := WithTaskOutputStore(context.Background(), NewTaskOutputStore())
+
+	attempts, _, err := performTaskWithRetries(ctx, fake.NewSimpleClientset(), "default", task, 0, nil)
+	if err == nil || !isNonRetriable(err) {
+		t.Fatalf("got error %v, want a non-retriable error", err)
+	}
+	var taskErr *TaskExecutionError
+	if !errors.As(err, &taskErr) || !strings.Contains(taskErr.Cause.Error(), "task 'produce'") {
+		t.Fatalf("the error %v does not name the missing dependency", err)
+	}
+	if attempts != 1 || executions != 0 {
+		t.Fatalf("got %d attempts and %d executions, want 1 attempt and no execution", attempts, executions)
+	}
+}
+
+func TestTaskOutputReferencesWaitForTheProducer(t *testing.T) {
+	registerTestRunner(t, "TestProduceOutputSlowly", func(ctx context.Context, _ kubernetes.Interface, _ string, _ configuration.Task, _ map[string]interface{}, _ int) error {
+		time.Sleep(20 * time.Millisecond)
+		PublishTaskOutput(ctx, "name", "pearl")
+		return nil
+	})
+	var consumed atomic.Value
+	registerTestRunner(t, "TestConsumeOutput", func(_ context.Context, _ kubernetes.Interface, _ string, _ configuration.Task, parameters map[string]interface{}, _ int) error {
+		consumed.Store(parameters["name"])
+		return nil
+	})
+
+	// The consumer is listed first, so a worker reaches it before the producer has completed.
+	tasks := []configuration.Task{
+		{Name: "consume", Type: "TestConsumeOutput", ShipsNamespace: "default", MaxRetries: 1,
+			Parameters: map[string]interface{}{"name": "${tasks.produce.output.name}"}},
+		{Name: "produce", Type: "TestProduceOutputSlowly", ShipsNamespace: "default", MaxRetries: 1},
+	}
+	for _, workerCount := range []int{1, 2} {
+		consumed.Store("")
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		ctx = WithTaskOutputStore(ctx, newRunTaskOutputStore(tasks))
+		runCrewToCompletion(ctx, fake.NewSimpleClientset(), tasks, workerCount)
+		cancel()
+
+		if got := consumed.Load(); got != "pearl" {
+			t.Fatalf("with %d workers: consumer got name %v, want the output of the producer", workerCount, got)
+		}
+	}
+}
+
+func TestTaskOutputReferenceToAFailedProducerFailsWithoutRetries(t *testing.T) {
+	registerTestRunner(t, "TestFailToProduce", func(_ context.Context, _ kubernetes.Interface, _ string, _ configuration.Task, _ map[string]interface{}, _ int) error {
+		return markNonRetriable(errors.New("quota exceeded"))
+	})
+	executions := 0
+	registerTestRunner(t, "TestConsumeOutput", func(_ context.Context, _ kubernetes.Interface, _ string, _ configuration.Task, _ map[string]interface{}, _ int) error {
+		executions++
+		return nil
+	})
+	producer := configuration.Task{Name: "produce", Type: "TestFailToProduce", ShipsNamespace: "default", MaxRetries: 1}
+	consumer := configuration.Task{Name: "consume", Type: "TestConsumeOutput", ShipsNamespace: "default", MaxRetries: 3,
+		Parameters: map[string]interface{}{"name": "${tasks.produce.output.name}"}}
+	ctx := WithTaskOutputStore(context.Background(), newRunTaskOutputStore([]configuration.Task{producer, consumer}))
+
+	_, _, producerErr := performTaskWithRetries(ctx, fake.NewSimpleClientset(), "default", producer, 0, nil)
+	recordTaskOutputCompletion(ctx, producer.Name, producerErr)
+	attempts, _, err := performTaskWithRetries(ctx, fake.NewSimpleClientset(), "default", consumer, 0, nil)
+	var taskErr *TaskExecutionError
+	if !errors.As(err, &taskErr) || !isNonRetriable(err) || !strings.Contains(taskErr.Cause.Error(), "quota exceeded") {
+		t.Fatalf("got error %v, want a non-retriable error carrying the failure of the producer", err)
+	}
+	if attempts != 1 || executions != 0 {
+		t.Fatalf("got %d attempts and %d executions, want 1 attempt and no execution", attempts, executions)
+	}
+}
+
+func TestTaskOutputReferenceStopsWaitingWhenCancelled(t *testing.T) {
+	producer := configuration.Task{Name: "produce", Type: "TestNeverRuns"}
+	ctx, cancel := context.WithCancel(WithTaskOutputStore(context.Background(), newRunTaskOutputStore([]configuration.Task{producer})))
+	cancel()
+
+	if _, err := resolveTaskOutputReferences(ctx, map[string]interface{}{"name": "${tasks.produce.output.name}"}); !errors.Is(err, context.Canceled) {
+		t.Fatalf("got error %v, want context.Canceled", err)
+	}
+}
@@ -0,0 +1,71 @@
+package worker
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/H0llyW00dzZ/K8sBlackPearl/worker/configuration"
+	"go.uber.org/zap"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/rest"
+)
+
+var taggedTask = configuration.Task{
+	Name:       "settings",
+	Type:       "CrewCreateConfigMap",
+	Tags:       map[string]string{"env": "prod", "team": "navy"},
+	Parameters: map[string]interface{}{"configMapName": "settings", "data": map[string]interface{}{"mode": "calm"}},
+}
+
+func TestTaskTagsAppearInRunnerLogFields(t *testing.T) {
+	logs := observeLogs(t)
+
+	if err := performTask(context.Background(), fake.NewSimpleClientset(), "default", taggedTask, 0); err != nil {
+		t.Fatalf("performTask: %v", err)
+	}
+
+	entries := logs.FilterField(zap.String("task_name", "settings")).All()
+	if len(entries) == 0 {
+		t.Fatal("no log entries carry the task name")
+	}
+	for _, entry := range entries {
+		fields := entry.ContextMap()
+		if fields["tag.env"] != "prod" || fields["tag.team"] != "navy" {
+			t.Fatalf("entry %q has fields %v, want the task's tags", entry.Message, fields)
+		}
+	}
+}
+
+func TestTaskTagsAppearInAPILatencyRecords(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"settings","namespace":"default"}}`))
+	}))
+	defer server.Close()
+	config := &rest.Config{Host: server.URL}
+	applyAPILatencyLogging(config)
+	clientset, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	logs := observeLogs(t)
+	EnableAPILatencyLogging(true)
+	t.Cleanup(func() { EnableAPILatencyLogging(false) })
+
+	ctx := withAPICallTask(context.Background(), taggedTask)
+	if _, err := clientset.CoreV1().ConfigMaps("default").Get(ctx, "settings", v1.GetOptions{}); err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+
+	entries := logs.FilterFieldKey("api_latency").All()
+	if len(entries) != 1 {
+		t.Fatalf("got %d latency records, want 1", len(entries))
+	}
+	if fields := entries[0].ContextMap(); fields["tag.env"] != "prod" || fields["tag.team"] != "navy" {
+		t.Fatalf("got fields %v, want the task's tags", fields)
+	}
+}
@@ -3,7 +3,8 @@ package worker
 import (
 	"context"
 	"fmt"
-	"time"
+	"sort"
+	"strings"
 
 	"github.com/H0llyW00dzZ/K8sBlackPearl/language"
 	"github.com/H0llyW00dzZ/K8sBlackPearl/navigator"
@@ -18,11 +19,15 @@ import (
 // InitializeTasks loads tasks from the specified configuration file.
 // filePath is the path to the configuration file that contains the task definitions.
 // It returns a slice of Task structs loaded from the configuration file and any error encountered.
+// Tasks whose type has no registered TaskRunner are rejected here, before any worker starts.
 func InitializeTasks(filePath string) ([]configuration.Task, error) {
 	tasks, err := configuration.LoadTasks(filePath)
 	if err != nil {
 		return nil, err
 	}
+	if err := ValidateTaskTypes(tasks); err != nil {
+		return nil, err
+	}
 	return tasks, nil
 }
 
@@ -30,7 +35,7 @@ func InitializeTasks(filePath string) ([]configuration.Task, error) {
 // Implementations of TaskRunner should execute tasks based on the provided context,
 // Kubernetes clientset, namespace, and task parameters.
 type TaskRunner interface {
-	Run(ctx context.Context, clientset *kubernetes.Clientset, shipsnamespace string, task configuration.Task, parameters map[string]interface{}, workerIndex int) error
+	Run(ctx context.Context, clientset kubernetes.Interface, shipsnamespace string, task configuration.Task, parameters map[string]interface{}, workerIndex int) error
 }
 
 // CrewGetPods is an example TaskRunner which currently only prints the task's parameters.
@@ -43,7 +48,7 @@ type CrewGetPods struct {
 
 // Run prints the task parameters to stdout. This method should be replaced with
 // actual backup logic to fulfill the TaskRunner interface.
-func (b *CrewGetPods) Run(ctx context.Context, clientset *kubernetes.Clientset, shipsnamespace string, task configuration.Task, parameters map[string]interface{}, workerIndex int) error {
+func (b *CrewGetPods) Run(ctx context.Context, clientset kubernetes.Interface, shipsnamespace string, task configuration.Task, parameters map[string]interface{}, workerIndex int) error {
 	// Implement backup logic here
 	// Note: Currently unimplemented, not ready yet unless you want to implement it as expert.
 	fmt.Println(language.RunningTaskBackup, parameters)
@@ -55,9 +60,10 @@ func (b *CrewGetPods) Run(ctx context.Context, clientset *kubernetes.Clientset,
 var taskRunnerRegistry = make(map[string]func() TaskRunner)
 
 // RegisterTaskRunner associates a task type with a TaskRunner constructor in the registry.
-// This function is used to extend the system with new types of tasks.
+// This function is used to extend the system with new types of tasks. It is equivalent to
+// RegisterTaskRunnerWithSpec with an empty RunnerSpec.
 func RegisterTaskRunner(taskType string, constructor func() TaskRunner) {
-	taskRunnerRegistry[taskType] = constructor
+	RegisterTaskRunnerWithSpec(taskType, RunnerSpec{}, constructor)
 }
 
 // GetTaskRunner retrieves a TaskRunner from the registry based on the provided task type.
@@ -70,6 +76,28 @@ func GetTaskRunner(taskType string) (TaskRunner, error) {
 	return constructor(), nil
 }
 
+// ValidateTaskTypes checks that every task's Type has a registered TaskRunner, so that a typo in
+// the configuration fails fast instead of surfacing only when a worker picks the task up.
+// It returns a single error naming every task with an unknown type, followed by the valid types.
+func ValidateTaskTypes(tasks []configuration.Task) error {
+	var unknown []string
+	for _, task := range tasks {
+		if _, exists := taskRunnerRegistry[task.Type]; !exists {
+			unknown = append(unknown, fmt.Sprintf(language.UnknownTaskTypeEntry, task.Name, task.Type))
+		}
+	}
+	if len(unknown) == 0 {
+		return nil
+	}
+
+	validTypes := make([]string, 0, len(taskRunnerRegistry))
+	for taskType := range taskRunnerRegistry {
+		validTypes = append(validTypes, taskType)
+	}
+	sort.Strings(validTypes)
+	return fmt.Errorf(language.ErrorUnknownTaskTypes, strings.Join(unknown, ", "), strings.Join(validTypes, ", "))
+}
+
 // CrewGetPodsTaskRunner is an implementation of TaskRunner that lists and logs all pods
 // in a given Kubernetes namespace.
 type CrewGetPodsTaskRunner struct {
@@ -79,10 +107,10 @@ type CrewGetPodsTaskRunner struct {
 
 // Run lists all pods in the specified namespace and logs each pod's name and status.
 // It uses the provided Kubernetes clientset and context to interact with the Kubernetes cluster.
-func (c *CrewGetPodsTaskRunner) Run(ctx context.Context, clientset *kubernetes.Clientset, shipsNamespace string, task configuration.Task, parameters map[string]interface{}, workerIndex int) error {
+func (c *CrewGetPodsTaskRunner) Run(ctx context.Context, clientset kubernetes.Interface, shipsNamespace string, task configuration.Task, parameters map[string]interface{}, workerIndex int) error {
 
 	// Use the provided logging pattern
-	fields := createLogFieldsForRunnerTask(task, shipsNamespace, language.TaskFetchPods)
+	fields := createLogFieldsForRunnerTask(ctx, task, shipsNamespace, language.TaskFetchPods)
 	logTaskStart(fmt.Sprintf(language.FetchingPods, workerIndex), fields)
 
 	listOptions, err := getListOptions(parameters)
@@ -91,7 +119,7 @@ func (c *CrewGetPodsTaskRunner) Run(ctx context.Context, clientset *kubernetes.C
 		return err
 	}
 
-	podList, err := listPods(ctx, clientset, shipsNamespace, listOptions)
+	podList, err := listPodsFromParameters(ctx, clientset, shipsNamespace, listOptions, parameters)
 	if err != nil {
 		return err
 	}
@@ -109,10 +137,11 @@ type CrewProcessCheckHealthTask struct {
 
 // Run iterates over the pods in the specified namespace, checks their health status,
 // and sends a formatted status message to the provided results channel.
-// It respects the context's cancellation signal and stops processing if the context is cancelled.
-func (c *CrewProcessCheckHealthTask) Run(ctx context.Context, clientset *kubernetes.Clientset, shipsNamespace string, task configuration.Task, parameters map[string]interface{}, workerIndex int) error {
+// It respects the context's cancellation signal and stops processing if the context is cancelled,
+// reporting a partial summary. When 'failIfUnhealthy' is true, any unhealthy pod fails the task.
+func (c *CrewProcessCheckHealthTask) Run(ctx context.Context, clientset kubernetes.Interface, shipsNamespace string, task configuration.Task, parameters map[string]interface{}, workerIndex int) error {
 	// Use the provided logging pattern
-	fields := createLogFieldsForRunnerTask(task, shipsNamespace, language.TaskCheckHealth)
+	fields := createLogFieldsForRunnerTask(ctx, task, shipsNamespace, language.TaskCheckHealth)
 	logTaskStart(fmt.Sprintf(language.CheckingHealthPods, workerIndex), fields)
 
 	listOptions, err := getListOptions(parameters)
@@ -120,13 +149,18 @@ func (c *CrewProcessCheckHealthTask) Run(ctx context.Context, clientset *kuberne
 		return err
 	}
 
-	podList, err := listPods(ctx, clientset, shipsNamespace, listOptions)
+	podList, err := listPodsFromParameters(ctx, clientset, shipsNamespace, listOptions, parameters)
+	if err != nil {
+		return err
+	}
+
+	failIfUnhealthy, err := getOptionalParamAsBool(parameters, failIfUnhealthY, false)
 	if err != nil {
 		return err
 	}
 
 	results := c.checkPodsHealth(ctx, podList)
-	return c.logResults(ctx, results)
+	return c.logResults(ctx, results, len(podList.Items), failIfUnhealthy)
 }
 
 // CrewLabelPodsTaskRunner is an implementation of TaskRunner that labels all pods
@@ -141,9 +175,9 @@ type CrewLabelPodsTaskRunner struct {
 // invoking the labeling operation, and logging the process. The Run method orchestrates these steps,
 // handling any errors that occur during the execution and ensuring that the task's intent is
 // fulfilled effectively.
-func (c *CrewLabelPodsTaskRunner) Run(ctx context.Context, clientset *kubernetes.Clientset, shipsNamespace string, task configuration.Task, parameters map[string]interface{}, workerIndex int) error {
+func (c *CrewLabelPodsTaskRunner) Run(ctx context.Context, clientset kubernetes.Interface, shipsNamespace string, task configuration.Task, parameters map[string]interface{}, workerIndex int) error {
 	// Use the provided logging pattern
-	fields := createLogFieldsForRunnerTask(task, shipsNamespace, language.TaskLabelPods)
+	fields := createLogFieldsForRunnerTask(ctx, task, shipsNamespace, language.TaskLabelPods)
 	logTaskStart(fmt.Sprintf(language.WritingLabelPods, workerIndex), fields)
 
 	labelKey, labelValue, err := extractLabelParameters(parameters)
@@ -173,7 +207,7 @@ type CrewManageDeployments struct {
 }
 
 // TODO: Add the new TaskRunner for managing deployments.
-func (c *CrewManageDeployments) Run(ctx context.Context, clientset *kubernetes.Clientset, shipsNamespace string, task configuration.Task, parameters map[string]interface{}, workerIndex int) error {
+func (c *CrewManageDeployments) Run(ctx context.Context, clientset kubernetes.Interface, shipsNamespace string, task configuration.Task, parameters map[string]interface{}, workerIndex int) error {
 	// Note: Currently unimplemented, not ready yet unless you want to implement it as expert.
 	// This could involve scaling deployments, updating images, etc.
 	return nil
@@ -193,27 +227,29 @@ type CrewScaleDeployments struct {
 // from the task parameters, validates them, and then calls the ScaleDeployment function to adjust the number
 // of replicas for the deployment. The method logs the initiation and completion of the scaling operation
 // and reports any errors encountered during the process.
-func (c *CrewScaleDeployments) Run(ctx context.Context, clientset *kubernetes.Clientset, shipsNamespace string, task configuration.Task, parameters map[string]interface{}, workerIndex int) error {
+func (c *CrewScaleDeployments) Run(ctx context.Context, clientset kubernetes.Interface, shipsNamespace string, task configuration.Task, parameters map[string]interface{}, workerIndex int) error {
 	// Use the provided logging pattern
-	fields := createLogFieldsForRunnerTask(task, shipsNamespace, language.TaskScaleDeployment)
+	fields := createLogFieldsForRunnerTask(ctx, task, shipsNamespace, language.TaskScaleDeployment)
 	logTaskStart(fmt.Sprintf(language.ScalingDeployment, workerIndex), fields)
-	// Extract "deploymentName" and "replicas" include "retryDelayDuration" from the task's parameters
-	deploymentName, replicas, retryDelayDuration, err := c.extractScaleParameters(task)
+	// Extract "deploymentName" and "replicas" from the task's parameters
+	deploymentName, replicas, err := c.extractScaleParameters(task)
 	if err != nil {
 		logErrorWithFields(err, fields)
 		return err
 	}
-	// Create a channel for results and defer its closure
+	// Create a channel for results; the operation reports exactly one outcome, so the channel
+	// is closed right after it returns and can then be drained.
 	results := make(chan string, 1)
-	defer close(results)
 
-	err = c.performScaling(ctx, clientset, shipsNamespace, deploymentName, replicas, task.MaxRetries, retryDelayDuration, results)
+	// performTaskWithRetries retries the task, so each execution makes a single scaling attempt.
+	err = c.performScaling(ctx, clientset, shipsNamespace, deploymentName, replicas, results)
+	close(results)
 	if err != nil {
 		logErrorWithFields(err, fields)
 		return err
 	}
 
-	logResultsFromChannel(results, fields)
+	logResultsFromChannel(ctx, results, fields)
 	return nil
 }
 
@@ -221,42 +257,35 @@ func (c *CrewScaleDeployments) Run(ctx context.Context, clientset *kubernetes.Cl
 //
 // It validates the parameters and returns them along with any error encountered.
 // task is the configuration.Task struct containing the parameters.
-// Returns the deployment name, the number of replicas, the retry delay duration, and any error encountered.
-func (c *CrewScaleDeployments) extractScaleParameters(task configuration.Task) (string, int, time.Duration, error) {
+// Returns the deployment name, the number of replicas, and any error encountered.
+func (c *CrewScaleDeployments) extractScaleParameters(task configuration.Task) (string, int, error) {
 	deploymentName, err := getParamAsString(task.Parameters, deploYmentName)
 	if err != nil {
-		return "", 0, 0, fmt.Errorf(language.ErrorParameterMustBeString, err)
+		return "", 0, newParameterError(deploYmentName, err, language.ErrorParameterMustBeString, err)
 	}
 
 	replicas, err := getParamAsInt(task.Parameters, repliCas)
 	if err != nil {
-		return "", 0, 0, fmt.Errorf(language.ErrorParameterMustBeInteger, err)
+		return "", 0, newParameterError(repliCas, err, language.ErrorParameterMustBeInteger, err)
 	}
 
-	retryDelayDuration, err := configuration.ParseDuration(task.RetryDelay)
-	if err != nil {
-		return "", 0, 0, fmt.Errorf(language.ErrorFailedToParseRetryDelayFromTask, task.Name, err)
-	}
-
-	return deploymentName, replicas, retryDelayDuration, nil
+	return deploymentName, replicas, nil
 }
 
 // performScaling carries out the scaling operation for a Kubernetes deployment.
 //
 // It uses the provided Kubernetes clientset to change the number of replicas for the specified deployment.
-// The operation is retried up to maxRetries times with a delay of retryDelayDuration between attempts.
+// It makes a single attempt; retries are left to the task's RetryPolicy.
 // The results of the operation are sent to the provided results channel.
 // ctx is the context for cancellation and deadlines.
 // clientset is the Kubernetes clientset for API interactions.
 // shipsNamespace is the namespace where the deployment resides.
 // deploymentName is the name of the deployment to scale.
 // replicas is the desired number of replicas.
-// maxRetries is the maximum number of retry attempts.
-// retryDelayDuration is the duration to wait between retries.
 // results is a channel for sending the results of the scaling operation.
 // Returns an error if the scaling operation fails.
-func (c *CrewScaleDeployments) performScaling(ctx context.Context, clientset *kubernetes.Clientset, shipsNamespace, deploymentName string, replicas, maxRetries int, retryDelayDuration time.Duration, results chan<- string) error {
-	return ScaleDeployment(ctx, clientset, shipsNamespace, deploymentName, replicas, maxRetries, retryDelayDuration, results, zap.L())
+func (c *CrewScaleDeployments) performScaling(ctx context.Context, clientset kubernetes.Interface, shipsNamespace, deploymentName string, replicas int, results chan<- string) error {
+	return ScaleDeployment(ctx, clientset, shipsNamespace, deploymentName, replicas, results, zap.L())
 }
 
 // CrewUpdateImageDeployments contains information required to update the image of a Kubernetes deployment.
@@ -273,9 +302,9 @@ type CrewUpdateImageDeployments struct {
 // It extracts the deployment name, container name, and new image from the task parameters,
 // and then proceeds with the update using the UpdateDeploymentImage function.
 // The method logs the start and end of the update operation and handles any errors encountered.
-func (c *CrewUpdateImageDeployments) Run(ctx context.Context, clientset *kubernetes.Clientset, shipsNamespace string, task configuration.Task, parameters map[string]interface{}, workerIndex int) error {
+func (c *CrewUpdateImageDeployments) Run(ctx context.Context, clientset kubernetes.Interface, shipsNamespace string, task configuration.Task, parameters map[string]interface{}, workerIndex int) error {
 	// Use the provided logging pattern
-	fields := createLogFieldsForRunnerTask(task, shipsNamespace, language.TaskUpdateDeploymentImage)
+	fields := createLogFieldsForRunnerTask(ctx, task, shipsNamespace, language.TaskUpdateDeploymentImage)
 	logTaskStart(fmt.Sprintf(language.UpdatingImage, workerIndex), fields)
 
 	// Extract deployment parameters from the provided task parameters
@@ -285,22 +314,17 @@ func (c *CrewUpdateImageDeployments) Run(ctx context.Context, clientset *kuberne
 		navigator.LogErrorWithEmojiRateLimited(language.PirateEmoji, err.Error(), fields...)
 		return err
 	}
-	// Parse the RetryDelay string into a time.Duration
-	retryDelayDuration, err := configuration.ParseDuration(task.RetryDelay)
-	if err != nil {
-		navigator.LogErrorWithEmojiRateLimited(language.PirateEmoji, language.ErrorFailedToParseRetryDelayFMT, fields...)
-		return fmt.Errorf(language.ErrorFailedToParseRetryDelayFromTask, task.Name, err)
-	}
-
 	// Create a channel to receive results from the update operation
 	results := make(chan string, 1)
-	defer close(results)
 
 	// Retrieve the logger instance
 	logger := zap.L()
 
-	// Update the deployment image using the extracted parameters
-	err = UpdateDeploymentImage(ctx, clientset, shipsNamespace, deploymentName, containerName, newImage, task.MaxRetries, retryDelayDuration, results, logger)
+	// Update the deployment image using the extracted parameters; it reports exactly one outcome,
+	// so the channel is closed right after it returns and can then be drained. performTaskWithRetries
+	// retries the task, as UpdateDeploymentImage makes a single attempt.
+	err = UpdateDeploymentImage(ctx, clientset, shipsNamespace, deploymentName, containerName, newImage, results, logger)
+	close(results)
 	if err != nil {
 		// Log the error and return if the update operation fails
 		errorFields := append(fields, zap.String(language.Error, err.Error()))
@@ -310,9 +334,7 @@ func (c *CrewUpdateImageDeployments) Run(ctx context.Context, clientset *kuberne
 	}
 
 	// Process and log the results from the update operation
-	for updateResult := range results {
-		navigator.LogInfoWithEmoji(language.PirateEmoji, updateResult, fields...)
-	}
+	logResultsFromChannel(ctx, results, fields)
 
 	return nil
 }
@@ -335,19 +357,19 @@ type CrewCreatePVCStorage struct {
 //
 // This method orchestrates the task execution by extracting the required parameters,
 // invoking the createPVC function to create the PVC, and handling any errors or logging messages.
-func (c *CrewCreatePVCStorage) Run(ctx context.Context, clientset *kubernetes.Clientset, shipsNamespace string, task configuration.Task, parameters map[string]interface{}, workerIndex int) error {
+func (c *CrewCreatePVCStorage) Run(ctx context.Context, clientset kubernetes.Interface, shipsNamespace string, task configuration.Task, parameters map[string]interface{}, workerIndex int) error {
 	// Use the provided logging pattern
-	fields := createLogFieldsForRunnerTask(task, shipsNamespace, language.TaskCreatePVC)
+	fields := createLogFieldsForRunnerTask(ctx, task, shipsNamespace, language.TaskCreatePVC)
 	logTaskStart(fmt.Sprintf(language.CreatePVCStorage, workerIndex), fields)
 
 	// Extract the necessary parameters from the task parameters using getParamAsString
 	storageClassName, err := getParamAsString(parameters, storageClassName)
 	if err != nil {
-		return fmt.Errorf(language.ErrorParameterStorageClassName)
+		return newParameterError(storageClassName, nil, language.ErrorParameterStorageClassName)
 	}
 	pvcName, err := getParamAsString(parameters, pvcName)
 	if err != nil {
-		return fmt.Errorf(language.ErrorParameterpvcName)
+		return newParameterError(pvcName, nil, language.ErrorParameterpvcName)
 	}
 	storageSize, err := getParamAsString(parameters, storageSize)
 	if err != nil {
@@ -385,9 +407,9 @@ type CrewUpdateNetworkPolicy struct {
 // from the task parameters, updates the policy using the UpdateNetworkPolicy function, and logs the process.
 // The method handles parameter extraction, the update operation, and error reporting. It uses a results channel
 // to report the outcome of the update operation.
-func (c *CrewUpdateNetworkPolicy) Run(ctx context.Context, clientset *kubernetes.Clientset, shipsNamespace string, task configuration.Task, parameters map[string]interface{}, workerIndex int) error {
+func (c *CrewUpdateNetworkPolicy) Run(ctx context.Context, clientset kubernetes.Interface, shipsNamespace string, task configuration.Task, parameters map[string]interface{}, workerIndex int) error {
 	// Use the provided logging pattern
-	fields := createLogFieldsForRunnerTask(task, shipsNamespace, language.TaskUpdateNetworkPolicy)
+	fields := createLogFieldsForRunnerTask(ctx, task, shipsNamespace, language.TaskUpdateNetworkPolicy)
 	logTaskStart(fmt.Sprintf(language.UpdateNetworkPolicy, workerIndex), fields)
 
 	// Extract network policy parameters from the provided task parameters
@@ -400,13 +422,14 @@ func (c *CrewUpdateNetworkPolicy) Run(ctx context.Context, clientset *kubernetes
 
 	// Create a channel to receive results from the update operation
 	results := make(chan string, 1)
-	defer close(results)
 
 	// Retrieve the logger instance
 	logger := zap.L()
 
-	// Update the network policy using the extracted parameters
+	// Update the network policy using the extracted parameters; it reports exactly one outcome,
+	// so the channel is closed right after it returns and can then be drained.
 	err = UpdateNetworkPolicy(ctx, clientset, shipsNamespace, policyName, policySpec, results, logger)
+	close(results)
 	if err != nil {
 		// Log the error and return if the update operation fails
 		errorFields := append(fields, zap.String(language.Error, err.Error()))
@@ -416,29 +439,2104 @@ func (c *CrewUpdateNetworkPolicy) Run(ctx context.Context, clientset *kubernetes
 	}
 
 	// Process and log the results from the update operation
-	for updateResult := range results {
-		navigator.LogInfoWithEmoji(language.PirateEmoji, updateResult, fields...)
+	logResultsFromChannel(ctx, results, fields)
+
+	return nil
+}
+
+// CrewCreateLimitRange is a TaskRunner that creates a Kubernetes LimitRange according to the provided parameters.
+type CrewCreateLimitRange struct {
+	// shipsNamespace specifies the Kubernetes namespace where the LimitRange will be created.
+	shipsNamespace string
+
+	// workerIndex is an identifier for the worker that is executing the task.
+	// This can be used for logging and tracking the progress of the task across multiple workers.
+	workerIndex int
+}
+
+// Run creates a LimitRange in the specified namespace. It extracts the LimitRange name, the limit items,
+// and the overwrite flag from the task parameters, creates the LimitRange using the CreateLimitRange function,
+// and logs the outcome reported through the results channel.
+func (c *CrewCreateLimitRange) Run(ctx context.Context, clientset kubernetes.Interface, shipsNamespace string, task configuration.Task, parameters map[string]interface{}, workerIndex int) error {
+	// Use the provided logging pattern
+	fields := createLogFieldsForRunnerTask(ctx, task, shipsNamespace, language.TaskCreateLimitRange)
+	logTaskStart(fmt.Sprintf(language.CreateLimitRange, workerIndex), fields)
+
+	// Extract limit range parameters from the provided task parameters
+	limitRangeName, limits, overwrite, err := extractLimitRangeParameters(parameters)
+	if err != nil {
+		navigator.LogErrorWithEmojiRateLimited(language.PirateEmoji, err.Error(), fields...)
+		return err
+	}
+
+	// Create a channel to receive results from the create operation.
+	// The operation reports exactly one outcome, so the channel is closed right after it returns.
+	results := make(chan string, 1)
+	err = CreateLimitRange(ctx, clientset, shipsNamespace, limitRangeName, limits, overwrite, results, zap.L())
+	close(results)
+	if err != nil {
+		logErrorWithFields(err, fields)
+		return err
 	}
 
+	logResultsFromChannel(ctx, results, fields)
 	return nil
 }
 
-// getLatestVersionOfPod fetches the latest version of the Pod from the Kubernetes API.
-func getLatestVersionOfPod(ctx context.Context, clientset *kubernetes.Clientset, namespace string, podName string) (*corev1.Pod, error) {
-	// Fetch the latest version of the Pod using the clientset.
-	latestPod, err := clientset.CoreV1().Pods(namespace).Get(ctx, podName, v1.GetOptions{})
+// CrewApplyManifest is a TaskRunner that creates or updates arbitrary Kubernetes resources
+// described by a YAML or JSON manifest, using the dynamic client and a RESTMapper.
+type CrewApplyManifest struct {
+	// shipsNamespace specifies the default Kubernetes namespace for namespaced resources.
+	shipsNamespace string
+
+	// workerIndex is an identifier for the worker that is executing the task.
+	// This can be used for logging and tracking the progress of the task across multiple workers.
+	workerIndex int
+}
+
+// Run decodes every document of the 'manifest' parameter and applies each object through the
+// ApplyManifestObjects function. Namespaced objects without a namespace are created in the task's
+// namespace, and each object's outcome is logged from the results channel. Setting 'serverSideApply'
+// switches to server-side apply with the optional 'fieldManager'; 'force' then takes ownership of
+// fields owned by other controllers, overriding their values.
+func (c *CrewApplyManifest) Run(ctx context.Context, clientset kubernetes.Interface, shipsNamespace string, task configuration.Task, parameters map[string]interface{}, workerIndex int) error {
+	// Use the provided logging pattern
+	fields := createLogFieldsForRunnerTask(ctx, task, shipsNamespace, language.TaskApplyManifest)
+	logTaskStart(fmt.Sprintf(language.ApplyManifest, workerIndex), fields)
+
+	objects, err := extractManifestParameter(parameters)
 	if err != nil {
-		return nil, err
+		navigator.LogErrorWithEmojiRateLimited(language.PirateEmoji, err.Error(), fields...)
+		return err
 	}
-	return latestPod, nil
+	opts, err := extractApplyManifestOptions(parameters)
+	if err != nil {
+		navigator.LogErrorWithEmojiRateLimited(language.PirateEmoji, err.Error(), fields...)
+		return err
+	}
+
+	dynamicClient, mapper, err := dynamicClientFor(clientset)
+	if err != nil {
+		logErrorWithFields(err, fields)
+		return err
+	}
+
+	// Each object reports exactly one outcome, so the channel is sized to hold all of them.
+	results := make(chan string, len(objects))
+	err = ApplyManifestObjectsWithOptions(ctx, dynamicClient, mapper, shipsNamespace, objects, opts, results)
+	close(results)
+
+	logResultsFromChannel(ctx, results, fields)
+	if err != nil {
+		logErrorWithFields(err, fields)
+		return err
+	}
+	return nil
 }
 
-// performTask runs the specified task by finding the appropriate TaskRunner from the registry
-// and invoking its Run method with the task's parameters.
-func performTask(ctx context.Context, clientset *kubernetes.Clientset, shipsnamespace string, task configuration.Task, workerIndex int) error {
-	runner, err := GetTaskRunner(task.Type)
+// CrewGetPodMetrics is a TaskRunner that reports the current CPU and memory usage of pods
+// using the metrics.k8s.io API.
+type CrewGetPodMetrics struct {
+	// shipsNamespace specifies the Kubernetes namespace whose pods are inspected.
+	shipsNamespace string
+
+	// workerIndex is an identifier for the worker that is executing the task.
+	// This can be used for logging and tracking the progress of the task across multiple workers.
+	workerIndex int
+}
+
+// Run lists the pod metrics in the specified namespace, optionally filtered by 'labelSelector',
+// and reports the usage of each pod through the results channel and structured logs.
+func (c *CrewGetPodMetrics) Run(ctx context.Context, clientset kubernetes.Interface, shipsNamespace string, task configuration.Task, parameters map[string]interface{}, workerIndex int) error {
+	// Use the provided logging pattern
+	fields := createLogFieldsForRunnerTask(ctx, task, shipsNamespace, language.TaskGetPodMetrics)
+	logTaskStart(fmt.Sprintf(language.GettingPodMetrics, workerIndex), fields)
+
+	selector, err := getOptionalParamAsString(parameters, labelSelector, "")
+	if err != nil {
+		navigator.LogErrorWithEmojiRateLimited(language.PirateEmoji, err.Error(), fields...)
+		return err
+	}
+
+	dynamicClient, _, err := dynamicClientFor(clientset)
+	if err != nil {
+		logErrorWithFields(err, fields)
+		return err
+	}
+	podMetrics, err := listPodMetrics(ctx, dynamicClient, shipsNamespace, selector)
+	if err != nil {
+		logErrorWithFields(err, fields)
+		return err
+	}
+
+	results := make(chan string, len(podMetrics))
+	reportPodMetrics(fields, podMetrics, results)
+	close(results)
+	return nil
+}
+
+// CrewGetNodeMetrics is a TaskRunner that reports the current CPU and memory usage of nodes
+// using the metrics.k8s.io API.
+type CrewGetNodeMetrics struct {
+	// shipsNamespace is kept for consistency with other runners; nodes are cluster-scoped.
+	shipsNamespace string
+
+	// workerIndex is an identifier for the worker that is executing the task.
+	// This can be used for logging and tracking the progress of the task across multiple workers.
+	workerIndex int
+}
+
+// Run lists the node metrics, optionally filtered by 'labelSelector', and reports the usage
+// of each node through the results channel and structured logs.
+func (c *CrewGetNodeMetrics) Run(ctx context.Context, clientset kubernetes.Interface, shipsNamespace string, task configuration.Task, parameters map[string]interface{}, workerIndex int) error {
+	// Use the provided logging pattern
+	fields := createLogFieldsForRunnerTask(ctx, task, shipsNamespace, language.TaskGetNodeMetrics)
+	logTaskStart(fmt.Sprintf(language.GettingNodeMetrics, workerIndex), fields)
+
+	selector, err := getOptionalParamAsString(parameters, labelSelector, "")
+	if err != nil {
+		navigator.LogErrorWithEmojiRateLimited(language.PirateEmoji, err.Error(), fields...)
+		return err
+	}
+
+	dynamicClient, _, err := dynamicClientFor(clientset)
+	if err != nil {
+		logErrorWithFields(err, fields)
+		return err
+	}
+	nodeMetrics, err := listNodeMetrics(ctx, dynamicClient, selector)
+	if err != nil {
+		logErrorWithFields(err, fields)
+		return err
+	}
+
+	results := make(chan string, len(nodeMetrics))
+	reportNodeMetrics(fields, nodeMetrics, results)
+	close(results)
+	return nil
+}
+
+// CrewDeleteNode is a TaskRunner that removes a Node object from the cluster after it has been drained.
+type CrewDeleteNode struct {
+	// shipsNamespace is kept for consistency with other runners; nodes are cluster-scoped.
+	shipsNamespace string
+
+	// workerIndex is an identifier for the worker that is executing the task.
+	// This can be used for logging and tracking the progress of the task across multiple workers.
+	workerIndex int
+}
+
+// Run deletes the node named by the 'nodeName' parameter using the DeleteNode function. The optional
+// 'requireCordoned' and 'requireEmpty' parameters enable safety checks that refuse to delete a node
+// that is still schedulable or still hosts workloads.
+func (c *CrewDeleteNode) Run(ctx context.Context, clientset kubernetes.Interface, shipsNamespace string, task configuration.Task, parameters map[string]interface{}, workerIndex int) error {
+	// Use the provided logging pattern
+	fields := createLogFieldsForRunnerTask(ctx, task, shipsNamespace, language.TaskDeleteNode)
+	logTaskStart(fmt.Sprintf(language.DeletingNode, workerIndex), fields)
+
+	nodeName, requireCordoned, requireEmpty, err := extractDeleteNodeParameters(parameters)
+	if err != nil {
+		navigator.LogErrorWithEmojiRateLimited(language.PirateEmoji, err.Error(), fields...)
+		return err
+	}
+
+	// The operation reports exactly one outcome, so the channel is closed right after it returns.
+	results := make(chan string, 1)
+	err = DeleteNode(ctx, clientset, nodeName, requireCordoned, requireEmpty, results, zap.L())
+	close(results)
+	if err != nil {
+		logErrorWithFields(err, fields)
+		return err
+	}
+
+	logResultsFromChannel(ctx, results, fields)
+	return nil
+}
+
+// CrewCreateStorageClass is a TaskRunner that creates a Kubernetes StorageClass according to the provided parameters.
+type CrewCreateStorageClass struct {
+	// shipsNamespace is kept for consistency with other runners; StorageClasses are cluster-scoped.
+	shipsNamespace string
+
+	// workerIndex is an identifier for the worker that is executing the task.
+	// This can be used for logging and tracking the progress of the task across multiple workers.
+	workerIndex int
+}
+
+// Run creates a StorageClass from the task parameters using the CreateStorageClass function.
+// The task's namespace is ignored because StorageClasses are cluster-scoped.
+func (c *CrewCreateStorageClass) Run(ctx context.Context, clientset kubernetes.Interface, shipsNamespace string, task configuration.Task, parameters map[string]interface{}, workerIndex int) error {
+	// Use the provided logging pattern
+	fields := createLogFieldsForRunnerTask(ctx, task, shipsNamespace, language.TaskCreateStorageClass)
+	logTaskStart(fmt.Sprintf(language.CreatingStorageClass, workerIndex), fields)
+
+	storageClass, overwrite, err := extractStorageClassParameters(parameters)
+	if err != nil {
+		navigator.LogErrorWithEmojiRateLimited(language.PirateEmoji, err.Error(), fields...)
+		return err
+	}
+
+	// The operation reports exactly one outcome, so the channel is closed right after it returns.
+	results := make(chan string, 1)
+	err = CreateStorageClass(ctx, clientset, storageClass, overwrite, results, zap.L())
+	close(results)
+	if err != nil {
+		logErrorWithFields(err, fields)
+		return err
+	}
+
+	logResultsFromChannel(ctx, results, fields)
+	return nil
+}
+
+// CrewScaleToZero is a TaskRunner that scales a deployment to zero replicas for a maintenance window,
+// remembering its previous size so that CrewRestoreReplicas can bring it back.
+type CrewScaleToZero struct {
+	// shipsNamespace is the Kubernetes namespace where the deployment resides.
+	shipsNamespace string
+
+	// workerIndex is an identifier for the worker that is executing the task.
+	// This can be used for logging and tracking the progress of the task across multiple workers.
+	workerIndex int
+}
+
+// Run scales the deployment named by the 'deploymentName' parameter to zero replicas using the
+// ScaleDeploymentToZero function.
+func (c *CrewScaleToZero) Run(ctx context.Context, clientset kubernetes.Interface, shipsNamespace string, task configuration.Task, parameters map[string]interface{}, workerIndex int) error {
+	// Use the provided logging pattern
+	fields := createLogFieldsForRunnerTask(ctx, task, shipsNamespace, language.TaskScaleToZero)
+	logTaskStart(fmt.Sprintf(language.ScalingDeploymentToZero, workerIndex), fields)
+
+	deploymentName, err := extractDeploymentNameParameter(parameters)
+	if err != nil {
+		navigator.LogErrorWithEmojiRateLimited(language.PirateEmoji, err.Error(), fields...)
+		return err
+	}
+
+	// The operation reports exactly one outcome, so the channel is closed right after it returns.
+	results := make(chan string, 1)
+	err = ScaleDeploymentToZero(ctx, clientset, shipsNamespace, deploymentName, results, zap.L())
+	close(results)
+	if err != nil {
+		logErrorWithFields(err, fields)
+		return err
+	}
+
+	logResultsFromChannel(ctx, results, fields)
+	return nil
+}
+
+// CrewRestoreReplicas is a TaskRunner that scales a deployment back to the replica count recorded
+// by CrewScaleToZero.
+type CrewRestoreReplicas struct {
+	// shipsNamespace is the Kubernetes namespace where the deployment resides.
+	shipsNamespace string
+
+	// workerIndex is an identifier for the worker that is executing the task.
+	// This can be used for logging and tracking the progress of the task across multiple workers.
+	workerIndex int
+}
+
+// Run restores the deployment named by the 'deploymentName' parameter to its recorded size using the
+// RestoreDeploymentReplicas function.
+func (c *CrewRestoreReplicas) Run(ctx context.Context, clientset kubernetes.Interface, shipsNamespace string, task configuration.Task, parameters map[string]interface{}, workerIndex int) error {
+	// Use the provided logging pattern
+	fields := createLogFieldsForRunnerTask(ctx, task, shipsNamespace, language.TaskRestoreReplicas)
+	logTaskStart(fmt.Sprintf(language.RestoringDeploymentReplicas, workerIndex), fields)
+
+	deploymentName, err := extractDeploymentNameParameter(parameters)
+	if err != nil {
+		navigator.LogErrorWithEmojiRateLimited(language.PirateEmoji, err.Error(), fields...)
+		return err
+	}
+
+	// The operation reports exactly one outcome, so the channel is closed right after it returns.
+	results := make(chan string, 1)
+	err = RestoreDeploymentReplicas(ctx, clientset, shipsNamespace, deploymentName, results, zap.L())
+	close(results)
+	if err != nil {
+		logErrorWithFields(err, fields)
+		return err
+	}
+
+	logResultsFromChannel(ctx, results, fields)
+	return nil
+}
+
+// CrewWatchPodsUntilCondition is a TaskRunner that waits for pods to reach a condition using the
+// Kubernetes watch API instead of polling.
+type CrewWatchPodsUntilCondition struct {
+	// shipsNamespace is the Kubernetes namespace where the pods reside.
+	shipsNamespace string
+
+	// workerIndex is an identifier for the worker that is executing the task.
+	// This can be used for logging and tracking the progress of the task across multiple workers.
+	workerIndex int
+}
+
+// Run waits for the pods selected by the task parameters to satisfy the 'condition' parameter using
+// the WatchPodsUntilCondition function. Observed events are logged while the watch is running.
+func (c *CrewWatchPodsUntilCondition) Run(ctx context.Context, clientset kubernetes.Interface, shipsNamespace string, task configuration.Task, parameters map[string]interface{}, workerIndex int) error {
+	// Use the provided logging pattern
+	fields := createLogFieldsForRunnerTask(ctx, task, shipsNamespace, language.TaskWatchPods)
+	logTaskStart(fmt.Sprintf(language.WatchingPods, workerIndex), fields)
+
+	labelSelector, podName, condition, timeout, err := extractWatchPodsParameters(parameters)
+	if err != nil {
+		navigator.LogErrorWithEmojiRateLimited(language.PirateEmoji, err.Error(), fields...)
+		return err
+	}
+
+	// The watch reports an unbounded number of events, so they are logged while it runs.
+	results := make(chan string, 1)
+	drained := make(chan struct{})
+	go func() {
+		logResultsFromChannel(ctx, results, fields)
+		close(drained)
+	}()
+	err = WatchPodsUntilCondition(ctx, clientset, shipsNamespace, labelSelector, podName, condition, timeout, results, zap.L())
+	close(results)
+	<-drained
+	if err != nil {
+		logErrorWithFields(err, fields)
+		return err
+	}
+	return nil
+}
+
+// CrewCreatePod is a TaskRunner that creates a single pod from the provided parameters and can
+// optionally wait for it to become ready.
+type CrewCreatePod struct {
+	// shipsNamespace is the Kubernetes namespace where the pod will be created.
+	shipsNamespace string
+
+	// workerIndex is an identifier for the worker that is executing the task.
+	// This can be used for logging and tracking the progress of the task across multiple workers.
+	workerIndex int
+}
+
+// Run creates a pod from the task parameters using the CreatePod function.
+func (c *CrewCreatePod) Run(ctx context.Context, clientset kubernetes.Interface, shipsNamespace string, task configuration.Task, parameters map[string]interface{}, workerIndex int) error {
+	// Use the provided logging pattern
+	fields := createLogFieldsForRunnerTask(ctx, task, shipsNamespace, language.TaskCreatePod)
+	logTaskStart(fmt.Sprintf(language.CreatingPod, workerIndex), fields)
+
+	spec, err := extractCreatePodParameters(parameters)
+	if err != nil {
+		navigator.LogErrorWithEmojiRateLimited(language.PirateEmoji, err.Error(), fields...)
+		return err
+	}
+
+	// The operation reports exactly one outcome, so the channel is closed right after it returns.
+	results := make(chan string, 1)
+	err = CreatePod(ctx, clientset, shipsNamespace, spec.pod, spec.wait, spec.timeout, results, zap.L())
+	close(results)
+	if err != nil {
+		logErrorWithFields(err, fields)
+		return err
+	}
+
+	logResultsFromChannel(ctx, results, fields)
+	return nil
+}
+
+// CrewDeletePodByName is a TaskRunner that deletes a single pod identified by its exact name.
+// Unlike selector-based deletion, it reports a precise error when the named pod does not exist.
+type CrewDeletePodByName struct {
+	// shipsNamespace is the Kubernetes namespace where the pod resides.
+	shipsNamespace string
+
+	// workerIndex is an identifier for the worker that is executing the task.
+	// This can be used for logging and tracking the progress of the task across multiple workers.
+	workerIndex int
+}
+
+// Run deletes the pod named by the 'podName' parameter using the DeletePodByName function.
+func (c *CrewDeletePodByName) Run(ctx context.Context, clientset kubernetes.Interface, shipsNamespace string, task configuration.Task, parameters map[string]interface{}, workerIndex int) error {
+	// Use the provided logging pattern
+	fields := createLogFieldsForRunnerTask(ctx, task, shipsNamespace, language.TaskDeletePodByName)
+	logTaskStart(fmt.Sprintf(language.DeletingPodByName, workerIndex), fields)
+
+	podName, gracePeriodSeconds, ignoreNotFound, err := extractDeletePodParameters(parameters)
+	if err != nil {
+		navigator.LogErrorWithEmojiRateLimited(language.PirateEmoji, err.Error(), fields...)
+		return err
+	}
+
+	// The operation reports exactly one outcome, so the channel is closed right after it returns.
+	results := make(chan string, 1)
+	err = DeletePodByName(ctx, clientset, shipsNamespace, podName, gracePeriodSeconds, ignoreNotFound, results, zap.L())
+	close(results)
+	if err != nil {
+		logErrorWithFields(err, fields)
+		return err
+	}
+
+	logResultsFromChannel(ctx, results, fields)
+	return nil
+}
+
+// CrewAnnotateDeployment is a TaskRunner that sets an annotation on a deployment or on its pod template.
+type CrewAnnotateDeployment struct {
+	// shipsNamespace is the Kubernetes namespace where the deployment resides.
+	shipsNamespace string
+
+	// workerIndex is an identifier for the worker that is executing the task.
+	// This can be used for logging and tracking the progress of the task across multiple workers.
+	workerIndex int
+}
+
+// Run annotates the deployment named by the 'deploymentName' parameter using the AnnotateDeployment function.
+func (c *CrewAnnotateDeployment) Run(ctx context.Context, clientset kubernetes.Interface, shipsNamespace string, task configuration.Task, parameters map[string]interface{}, workerIndex int) error {
+	// Use the provided logging pattern
+	fields := createLogFieldsForRunnerTask(ctx, task, shipsNamespace, language.TaskAnnotateDeployment)
+	logTaskStart(fmt.Sprintf(language.AnnotatingDeployment, workerIndex), fields)
+
+	deploymentName, target, annotationKey, annotationValue, err := extractAnnotateDeploymentParameters(parameters)
+	if err != nil {
+		navigator.LogErrorWithEmojiRateLimited(language.PirateEmoji, err.Error(), fields...)
+		return err
+	}
+
+	// The operation reports exactly one outcome, so the channel is closed right after it returns.
+	results := make(chan string, 1)
+	err = AnnotateDeployment(ctx, clientset, shipsNamespace, deploymentName, target, annotationKey, annotationValue, results, zap.L())
+	close(results)
+	if err != nil {
+		logErrorWithFields(err, fields)
+		return err
+	}
+
+	logResultsFromChannel(ctx, results, fields)
+	return nil
+}
+
+// CrewListSecretsMetadata is a TaskRunner that inventories the Secrets in a namespace. It reports
+// only names, types, key names, and ages, and never the Secret data.
+type CrewListSecretsMetadata struct {
+	// shipsNamespace specifies the Kubernetes namespace whose Secrets are listed.
+	shipsNamespace string
+
+	// workerIndex is an identifier for the worker that is executing the task.
+	// This can be used for logging and tracking the progress of the task across multiple workers.
+	workerIndex int
+}
+
+// Run lists the Secrets in the specified namespace, optionally filtered by 'labelSelector',
+// and reports the metadata of each Secret through the results channel and structured logs.
+func (c *CrewListSecretsMetadata) Run(ctx context.Context, clientset kubernetes.Interface, shipsNamespace string, task configuration.Task, parameters map[string]interface{}, workerIndex int) error {
+	// Use the provided logging pattern
+	fields := createLogFieldsForRunnerTask(ctx, task, shipsNamespace, language.TaskListSecretsMetadata)
+	logTaskStart(fmt.Sprintf(language.ListingSecretsMetadata, workerIndex), fields)
+
+	selector, err := getOptionalParamAsString(parameters, labelSelector, "")
+	if err != nil {
+		navigator.LogErrorWithEmojiRateLimited(language.PirateEmoji, err.Error(), fields...)
+		return err
+	}
+
+	metadata, err := listSecretsMetadata(ctx, clientset, shipsNamespace, selector)
+	if err != nil {
+		logErrorWithFields(err, fields)
+		return err
+	}
+
+	results := make(chan string, len(metadata))
+	reportSecretsMetadata(fields, metadata, results)
+	close(results)
+	return nil
+}
+
+// CrewUpdateDeploymentStrategy is a TaskRunner that changes the rollout strategy of a deployment.
+type CrewUpdateDeploymentStrategy struct {
+	// shipsNamespace is the Kubernetes namespace where the deployment resides.
+	shipsNamespace string
+
+	// workerIndex is an identifier for the worker that is executing the task.
+	// This can be used for logging and tracking the progress of the task across multiple workers.
+	workerIndex int
+}
+
+// Run sets the strategy of the deployment named by the 'deploymentName' parameter using the
+// UpdateDeploymentStrategy function.
+func (c *CrewUpdateDeploymentStrategy) Run(ctx context.Context, clientset kubernetes.Interface, shipsNamespace string, task configuration.Task, parameters map[string]interface{}, workerIndex int) error {
+	// Use the provided logging pattern
+	fields := createLogFieldsForRunnerTask(ctx, task, shipsNamespace, language.TaskUpdateDeploymentStrategy)
+	logTaskStart(fmt.Sprintf(language.UpdatingDeploymentStrategy, workerIndex), fields)
+
+	deploymentName, strategy, err := extractDeploymentStrategyParameters(parameters)
+	if err != nil {
+		navigator.LogErrorWithEmojiRateLimited(language.PirateEmoji, err.Error(), fields...)
+		return err
+	}
+
+	// The operation reports exactly one outcome, so the channel is closed right after it returns.
+	results := make(chan string, 1)
+	err = UpdateDeploymentStrategy(ctx, clientset, shipsNamespace, deploymentName, strategy, results, zap.L())
+	close(results)
+	if err != nil {
+		logErrorWithFields(err, fields)
+		return err
+	}
+
+	logResultsFromChannel(ctx, results, fields)
+	return nil
+}
+
+// CrewGetIngresses is a TaskRunner that reports the routing configuration of the Ingresses in a namespace.
+type CrewGetIngresses struct {
+	// shipsNamespace specifies the Kubernetes namespace whose Ingresses are listed.
+	shipsNamespace string
+
+	// workerIndex is an identifier for the worker that is executing the task.
+	// This can be used for logging and tracking the progress of the task across multiple workers.
+	workerIndex int
+}
+
+// Run lists the Ingresses in the specified namespace, optionally filtered by 'labelSelector', and
+// reports hosts, backends, TLS secrets, and load balancer addresses through the results channel and logs.
+func (c *CrewGetIngresses) Run(ctx context.Context, clientset kubernetes.Interface, shipsNamespace string, task configuration.Task, parameters map[string]interface{}, workerIndex int) error {
+	// Use the provided logging pattern
+	fields := createLogFieldsForRunnerTask(ctx, task, shipsNamespace, language.TaskGetIngresses)
+	logTaskStart(fmt.Sprintf(language.GettingIngresses, workerIndex), fields)
+
+	selector, err := getOptionalParamAsString(parameters, labelSelector, "")
+	if err != nil {
+		navigator.LogErrorWithEmojiRateLimited(language.PirateEmoji, err.Error(), fields...)
+		return err
+	}
+
+	summaries, err := listIngresses(ctx, clientset, shipsNamespace, selector)
+	if err != nil {
+		logErrorWithFields(err, fields)
+		return err
+	}
+
+	results := make(chan string, len(summaries))
+	err = reportIngresses(ctx, fields, summaries, results)
+	close(results)
+	if err != nil {
+		logErrorWithFields(err, fields)
+		return err
+	}
+	return nil
+}
+
+// CrewPauseDeployment is a TaskRunner that pauses the rollout of a deployment.
+type CrewPauseDeployment struct {
+	// shipsNamespace is the Kubernetes namespace where the deployment resides.
+	shipsNamespace string
+
+	// workerIndex is an identifier for the worker that is executing the task.
+	// This can be used for logging and tracking the progress of the task across multiple workers.
+	workerIndex int
+}
+
+// Run pauses the rollout of the deployment named by the 'deploymentName' parameter using the
+// SetDeploymentPaused function.
+func (c *CrewPauseDeployment) Run(ctx context.Context, clientset kubernetes.Interface, shipsNamespace string, task configuration.Task, parameters map[string]interface{}, workerIndex int) error {
+	// Use the provided logging pattern
+	fields := createLogFieldsForRunnerTask(ctx, task, shipsNamespace, language.TaskPauseDeployment)
+	logTaskStart(fmt.Sprintf(language.PausingDeployment, workerIndex), fields)
+
+	deploymentName, err := extractDeploymentNameParameter(parameters)
+	if err != nil {
+		navigator.LogErrorWithEmojiRateLimited(language.PirateEmoji, err.Error(), fields...)
+		return err
+	}
+
+	// The operation reports exactly one outcome, so the channel is closed right after it returns.
+	results := make(chan string, 1)
+	err = SetDeploymentPaused(ctx, clientset, shipsNamespace, deploymentName, true, results, zap.L())
+	close(results)
+	if err != nil {
+		logErrorWithFields(err, fields)
+		return err
+	}
+
+	logResultsFromChannel(ctx, results, fields)
+	return nil
+}
+
+// CrewResumeDeployment is a TaskRunner that resumes the rollout of a deployment.
+type CrewResumeDeployment struct {
+	// shipsNamespace is the Kubernetes namespace where the deployment resides.
+	shipsNamespace string
+
+	// workerIndex is an identifier for the worker that is executing the task.
+	// This can be used for logging and tracking the progress of the task across multiple workers.
+	workerIndex int
+}
+
+// Run resumes the rollout of the deployment named by the 'deploymentName' parameter using the
+// SetDeploymentPaused function.
+func (c *CrewResumeDeployment) Run(ctx context.Context, clientset kubernetes.Interface, shipsNamespace string, task configuration.Task, parameters map[string]interface{}, workerIndex int) error {
+	// Use the provided logging pattern
+	fields := createLogFieldsForRunnerTask(ctx, task, shipsNamespace, language.TaskResumeDeployment)
+	logTaskStart(fmt.Sprintf(language.ResumingDeployment, workerIndex), fields)
+
+	deploymentName, err := extractDeploymentNameParameter(parameters)
+	if err != nil {
+		navigator.LogErrorWithEmojiRateLimited(language.PirateEmoji, err.Error(), fields...)
+		return err
+	}
+
+	// The operation reports exactly one outcome, so the channel is closed right after it returns.
+	results := make(chan string, 1)
+	err = SetDeploymentPaused(ctx, clientset, shipsNamespace, deploymentName, false, results, zap.L())
+	close(results)
+	if err != nil {
+		logErrorWithFields(err, fields)
+		return err
+	}
+
+	logResultsFromChannel(ctx, results, fields)
+	return nil
+}
+
+// CrewGetPodsByNode is a TaskRunner that reports the pods of a namespace grouped by the node they run on.
+type CrewGetPodsByNode struct {
+	// shipsNamespace specifies the Kubernetes namespace whose pods are grouped.
+	shipsNamespace string
+
+	// workerIndex is an identifier for the worker that is executing the task.
+	// This can be used for logging and tracking the progress of the task across multiple workers.
+	workerIndex int
+}
+
+// Run lists the pods in the specified namespace, optionally filtered by 'labelSelector' and restricted
+// to a single 'nodeName', and reports the pod count and names for each node. Pods that are not yet
+// scheduled are reported as a separate group.
+func (c *CrewGetPodsByNode) Run(ctx context.Context, clientset kubernetes.Interface, shipsNamespace string, task configuration.Task, parameters map[string]interface{}, workerIndex int) error {
+	// Use the provided logging pattern
+	fields := createLogFieldsForRunnerTask(ctx, task, shipsNamespace, language.TaskGetPodsByNode)
+	logTaskStart(fmt.Sprintf(language.GettingPodsByNode, workerIndex), fields)
+
+	selector, err := getOptionalParamAsString(parameters, labelSelector, "")
+	if err != nil {
+		navigator.LogErrorWithEmojiRateLimited(language.PirateEmoji, err.Error(), fields...)
+		return err
+	}
+	nodeName, err := getOptionalParamAsString(parameters, nodeNamE, "")
+	if err != nil {
+		navigator.LogErrorWithEmojiRateLimited(language.PirateEmoji, err.Error(), fields...)
+		return err
+	}
+
+	groups, err := groupPodsByNode(ctx, clientset, shipsNamespace, selector, nodeName)
+	if err != nil {
+		logErrorWithFields(err, fields)
+		return err
+	}
+
+	results := make(chan string, len(groups))
+	err = reportPodsByNode(ctx, fields, groups, results)
+	close(results)
+	if err != nil {
+		logErrorWithFields(err, fields)
+		return err
+	}
+	return nil
+}
+
+// CrewCreateEndpoints is a TaskRunner that creates or updates the legacy core/v1 Endpoints object
+// backing a selector-less Service, pointing it at external addresses.
+type CrewCreateEndpoints struct {
+	// shipsNamespace specifies the Kubernetes namespace of the Service.
+	shipsNamespace string
+
+	// workerIndex is an identifier for the worker that is executing the task.
+	// This can be used for logging and tracking the progress of the task across multiple workers.
+	workerIndex int
+}
+
+// Run creates the Endpoints object named by the 'serviceName' parameter with the given 'addresses'
+// using the CreateEndpoints function. When 'overwrite' is true, an existing object's addresses are replaced.
+func (c *CrewCreateEndpoints) Run(ctx context.Context, clientset kubernetes.Interface, shipsNamespace string, task configuration.Task, parameters map[string]interface{}, workerIndex int) error {
+	// Use the provided logging pattern
+	fields := createLogFieldsForRunnerTask(ctx, task, shipsNamespace, language.TaskCreateEndpoints)
+	logTaskStart(fmt.Sprintf(language.CreatingEndpoints, workerIndex), fields)
+
+	endpoints, overwrite, err := extractEndpointsParameters(parameters)
+	if err != nil {
+		navigator.LogErrorWithEmojiRateLimited(language.PirateEmoji, err.Error(), fields...)
+		return err
+	}
+
+	// The operation reports exactly one outcome, so the channel is closed right after it returns.
+	results := make(chan string, 1)
+	err = CreateEndpoints(ctx, clientset, shipsNamespace, endpoints, overwrite, results, zap.L())
+	close(results)
+	if err != nil {
+		logErrorWithFields(err, fields)
+		return err
+	}
+
+	logResultsFromChannel(ctx, results, fields)
+	return nil
+}
+
+// CrewGetConfigMap is a TaskRunner that reports the keys, and optionally the values, of a ConfigMap.
+type CrewGetConfigMap struct {
+	// shipsNamespace specifies the Kubernetes namespace of the ConfigMap.
+	shipsNamespace string
+
+	// workerIndex is an identifier for the worker that is executing the task.
+	// This can be used for logging and tracking the progress of the task across multiple workers.
+	workerIndex int
+}
+
+// Run reports the keys of the ConfigMap named by the 'configMapName' parameter using the GetConfigMapKeys
+// function. Values are included only when 'showValues' is true.
+func (c *CrewGetConfigMap) Run(ctx context.Context, clientset kubernetes.Interface, shipsNamespace string, task configuration.Task, parameters map[string]interface{}, workerIndex int) error {
+	// Use the provided logging pattern
+	fields := createLogFieldsForRunnerTask(ctx, task, shipsNamespace, language.TaskGetConfigMap)
+	logTaskStart(fmt.Sprintf(language.GettingConfigMap, workerIndex), fields)
+
+	configMapName, err := getParamAsString(parameters, configMapNamE)
+	if err != nil || configMapName == "" {
+		err = newParameterError(configMapNamE, err, language.ErrorParameterMissing, configMapNamE)
+		navigator.LogErrorWithEmojiRateLimited(language.PirateEmoji, err.Error(), fields...)
+		return err
+	}
+	showValues, err := getOptionalParamAsBool(parameters, showValueS, false)
+	if err != nil {
+		navigator.LogErrorWithEmojiRateLimited(language.PirateEmoji, err.Error(), fields...)
+		return err
+	}
+
+	// The operation reports exactly one outcome, so the channel is closed right after it returns.
+	results := make(chan string, 1)
+	err = GetConfigMapKeys(ctx, clientset, shipsNamespace, configMapName, showValues, results, zap.L())
+	close(results)
+	if err != nil {
+		logErrorWithFields(err, fields)
+		return err
+	}
+
+	logResultsFromChannel(ctx, results, fields)
+	return nil
+}
+
+// CrewGetSecretKeys is a TaskRunner that reports the key names of a Secret, never its values.
+type CrewGetSecretKeys struct {
+	// shipsNamespace specifies the Kubernetes namespace of the Secret.
+	shipsNamespace string
+
+	// workerIndex is an identifier for the worker that is executing the task.
+	// This can be used for logging and tracking the progress of the task across multiple workers.
+	workerIndex int
+}
+
+// Run reports the key names of the Secret named by the 'secretName' parameter using the GetSecretKeys function.
+func (c *CrewGetSecretKeys) Run(ctx context.Context, clientset kubernetes.Interface, shipsNamespace string, task configuration.Task, parameters map[string]interface{}, workerIndex int) error {
+	// Use the provided logging pattern
+	fields := createLogFieldsForRunnerTask(ctx, task, shipsNamespace, language.TaskGetSecretKeys)
+	logTaskStart(fmt.Sprintf(language.GettingSecretKeys, workerIndex), fields)
+
+	secretName, err := getParamAsString(parameters, secretNamE)
+	if err != nil || secretName == "" {
+		err = newParameterError(secretNamE, err, language.ErrorParameterMissing, secretNamE)
+		navigator.LogErrorWithEmojiRateLimited(language.PirateEmoji, err.Error(), fields...)
+		return err
+	}
+
+	// The operation reports exactly one outcome, so the channel is closed right after it returns.
+	results := make(chan string, 1)
+	err = GetSecretKeys(ctx, clientset, shipsNamespace, secretName, results, zap.L())
+	close(results)
+	if err != nil {
+		logErrorWithFields(err, fields)
+		return err
+	}
+
+	logResultsFromChannel(ctx, results, fields)
+	return nil
+}
+
+// CrewUpdateIngressBackend is a TaskRunner that repoints a single Ingress rule path to another service.
+type CrewUpdateIngressBackend struct {
+	// shipsNamespace specifies the Kubernetes namespace of the Ingress.
+	shipsNamespace string
+
+	// workerIndex is an identifier for the worker that is executing the task.
+	// This can be used for logging and tracking the progress of the task across multiple workers.
+	workerIndex int
+}
+
+// Run updates the backend of the rule path selected by 'host' and 'path' in the Ingress named by
+// 'ingressName' to the service given by 'serviceName' and 'servicePort', using the UpdateIngressBackend function.
+func (c *CrewUpdateIngressBackend) Run(ctx context.Context, clientset kubernetes.Interface, shipsNamespace string, task configuration.Task, parameters map[string]interface{}, workerIndex int) error {
+	// Use the provided logging pattern
+	fields := createLogFieldsForRunnerTask(ctx, task, shipsNamespace, language.TaskUpdateIngressBackend)
+	logTaskStart(fmt.Sprintf(language.UpdatingIngressBackend, workerIndex), fields)
+
+	update, err := extractIngressBackendParameters(parameters)
+	if err != nil {
+		navigator.LogErrorWithEmojiRateLimited(language.PirateEmoji, err.Error(), fields...)
+		return err
+	}
+
+	// The operation reports exactly one outcome, so the channel is closed right after it returns.
+	results := make(chan string, 1)
+	err = UpdateIngressBackend(ctx, clientset, shipsNamespace, update, results, zap.L())
+	close(results)
+	if err != nil {
+		logErrorWithFields(err, fields)
+		return err
+	}
+
+	logResultsFromChannel(ctx, results, fields)
+	return nil
+}
+
+// CrewCheckServiceEndpoints is a TaskRunner that reports how many ready backends a Service has.
+type CrewCheckServiceEndpoints struct {
+	// shipsNamespace specifies the Kubernetes namespace of the Service.
+	shipsNamespace string
+
+	// workerIndex is an identifier for the worker that is executing the task.
+	// This can be used for logging and tracking the progress of the task across multiple workers.
+	workerIndex int
+}
+
+// Run reads the endpoints of the Service named by 'serviceName' and reports its ready and not-ready
+// addresses. When 'requireReady' is true, the task fails if the Service has no ready address, which
+// makes it usable as a post-deploy gate.
+func (c *CrewCheckServiceEndpoints) Run(ctx context.Context, clientset kubernetes.Interface, shipsNamespace string, task configuration.Task, parameters map[string]interface{}, workerIndex int) error {
+	// Use the provided logging pattern
+	fields := createLogFieldsForRunnerTask(ctx, task, shipsNamespace, language.TaskCheckServiceEndpoints)
+	logTaskStart(fmt.Sprintf(language.CheckingServiceEndpoints, workerIndex), fields)
+
+	serviceName, err := getParamAsString(parameters, serviceNamE)
+	if err != nil || serviceName == "" {
+		err = newParameterError(serviceNamE, err, language.ErrorParameterMissing, serviceNamE)
+		navigator.LogErrorWithEmojiRateLimited(language.PirateEmoji, err.Error(), fields...)
+		return err
+	}
+	requireReady, err := getOptionalParamAsBool(parameters, requireReadY, false)
+	if err != nil {
+		navigator.LogErrorWithEmojiRateLimited(language.PirateEmoji, err.Error(), fields...)
+		return err
+	}
+
+	status, err := checkServiceEndpoints(ctx, clientset, shipsNamespace, serviceName)
+	if err != nil {
+		logErrorWithFields(err, fields)
+		return err
+	}
+
+	results := make(chan string, 1+len(status.ready)+len(status.notReady))
+	err = reportServiceEndpoints(ctx, fields, serviceName, status, results)
+	close(results)
+	if err != nil {
+		logErrorWithFields(err, fields)
+		return err
+	}
+
+	if requireReady && len(status.ready) == 0 {
+		err = fmt.Errorf(language.ErrorNoReadyEndpoints, serviceName)
+		logErrorWithFields(err, fields)
+		return err
+	}
+	return nil
+}
+
+// CrewRunHealthProbeAgainstPod is a TaskRunner that probes a pod's HTTP health endpoint through the API server proxy.
+type CrewRunHealthProbeAgainstPod struct {
+	// shipsNamespace specifies the Kubernetes namespace of the pod.
+	shipsNamespace string
+
+	// workerIndex is an identifier for the worker that is executing the task.
+	// This can be used for logging and tracking the progress of the task across multiple workers.
+	workerIndex int
+}
+
+// Run sends an HTTP GET to 'path' on 'port' of the pod named by 'podName' using the ProbePodHTTP function,
+// and succeeds when the pod answers with 'expectedStatus' within 'timeout'.
+func (c *CrewRunHealthProbeAgainstPod) Run(ctx context.Context, clientset kubernetes.Interface, shipsNamespace string, task configuration.Task, parameters map[string]interface{}, workerIndex int) error {
+	// Use the provided logging pattern
+	fields := createLogFieldsForRunnerTask(ctx, task, shipsNamespace, language.TaskProbePod)
+	logTaskStart(fmt.Sprintf(language.ProbingPod, workerIndex), fields)
+
+	probe, err := extractPodProbeParameters(parameters)
+	if err != nil {
+		navigator.LogErrorWithEmojiRateLimited(language.PirateEmoji, err.Error(), fields...)
+		return err
+	}
+
+	// The operation reports exactly one outcome, so the channel is closed right after it returns.
+	results := make(chan string, 1)
+	err = ProbePodHTTP(ctx, clientset, shipsNamespace, probe, results, zap.L())
+	close(results)
+	if err != nil {
+		logErrorWithFields(err, fields)
+		return err
+	}
+
+	logResultsFromChannel(ctx, results, fields)
+	return nil
+}
+
+// CrewCloneDeployment is a TaskRunner that copies a deployment into another namespace.
+type CrewCloneDeployment struct {
+	// shipsNamespace is the default source namespace of the deployment.
+	shipsNamespace string
+
+	// workerIndex is an identifier for the worker that is executing the task.
+	// This can be used for logging and tracking the progress of the task across multiple workers.
+	workerIndex int
+}
+
+// Run copies the deployment named by 'deploymentName' from 'sourceNamespace' (the task namespace by
+// default) into 'targetNamespace' using the CloneDeployment function. 'nameOverride' renames the copy,
+// 'imageRewrite' maps registry prefixes to new ones, and 'overwrite' replaces an existing copy.
+func (c *CrewCloneDeployment) Run(ctx context.Context, clientset kubernetes.Interface, shipsNamespace string, task configuration.Task, parameters map[string]interface{}, workerIndex int) error {
+	// Use the provided logging pattern
+	fields := createLogFieldsForRunnerTask(ctx, task, shipsNamespace, language.TaskCloneDeployment)
+	logTaskStart(fmt.Sprintf(language.CloningDeployment, workerIndex), fields)
+
+	request, err := extractCloneDeploymentParameters(parameters, shipsNamespace)
+	if err != nil {
+		navigator.LogErrorWithEmojiRateLimited(language.PirateEmoji, err.Error(), fields...)
+		return err
+	}
+
+	// The operation reports exactly one outcome, so the channel is closed right after it returns.
+	results := make(chan string, 1)
+	err = CloneDeployment(ctx, clientset, request, results, zap.L())
+	close(results)
+	if err != nil {
+		logErrorWithFields(err, fields)
+		return err
+	}
+
+	logResultsFromChannel(ctx, results, fields)
+	return nil
+}
+
+// CrewValidateManifest is a TaskRunner that checks, without persisting anything, whether the API server
+// would accept the resources described by a YAML or JSON manifest.
+type CrewValidateManifest struct {
+	// shipsNamespace specifies the default namespace for namespaced objects that do not declare one.
+	shipsNamespace string
+
+	// workerIndex is an identifier for the worker that is executing the task.
+	// This can be used for logging and tracking the progress of the task across multiple workers.
+	workerIndex int
+}
+
+// Run decodes the 'manifest' parameter and submits each object as a server-side dry run using the
+// ValidateManifestObjects function, reporting whether each document would be accepted.
+func (c *CrewValidateManifest) Run(ctx context.Context, clientset kubernetes.Interface, shipsNamespace string, task configuration.Task, parameters map[string]interface{}, workerIndex int) error {
+	// Use the provided logging pattern
+	fields := createLogFieldsForRunnerTask(ctx, task, shipsNamespace, language.TaskValidateManifest)
+	logTaskStart(fmt.Sprintf(language.ValidatingManifest, workerIndex), fields)
+
+	objects, err := extractManifestParameter(parameters)
+	if err != nil {
+		navigator.LogErrorWithEmojiRateLimited(language.PirateEmoji, err.Error(), fields...)
+		return err
+	}
+
+	dynamicClient, mapper, err := dynamicClientFor(clientset)
+	if err != nil {
+		logErrorWithFields(err, fields)
+		return err
+	}
+
+	// Each object reports exactly one outcome, so the channel is sized to hold all of them.
+	results := make(chan string, len(objects))
+	err = ValidateManifestObjects(ctx, dynamicClient, mapper, shipsNamespace, objects, results)
+	close(results)
+
+	logResultsFromChannel(ctx, results, fields)
+	if err != nil {
+		logErrorWithFields(err, fields)
+		return err
+	}
+	return nil
+}
+
+// CrewSetImagePullSecrets is a TaskRunner that adds image pull secrets to a ServiceAccount or a deployment.
+type CrewSetImagePullSecrets struct {
+	// shipsNamespace specifies the Kubernetes namespace of the ServiceAccount or deployment.
+	shipsNamespace string
+
+	// workerIndex is an identifier for the worker that is executing the task.
+	// This can be used for logging and tracking the progress of the task across multiple workers.
+	workerIndex int
+}
+
+// Run merges the secrets listed in 'secretNames' into the image pull secrets of the ServiceAccount named
+// by 'serviceAccountName' or of the deployment named by 'deploymentName', using the SetImagePullSecrets function.
+func (c *CrewSetImagePullSecrets) Run(ctx context.Context, clientset kubernetes.Interface, shipsNamespace string, task configuration.Task, parameters map[string]interface{}, workerIndex int) error {
+	// Use the provided logging pattern
+	fields := createLogFieldsForRunnerTask(ctx, task, shipsNamespace, language.TaskSetImagePullSecrets)
+	logTaskStart(fmt.Sprintf(language.SettingImagePullSecrets, workerIndex), fields)
+
+	targetKind, targetName, secretNames, err := extractImagePullSecretsParameters(parameters)
+	if err != nil {
+		navigator.LogErrorWithEmojiRateLimited(language.PirateEmoji, err.Error(), fields...)
+		return err
+	}
+
+	// The operation reports exactly one outcome, so the channel is closed right after it returns.
+	results := make(chan string, 1)
+	err = SetImagePullSecrets(ctx, clientset, shipsNamespace, targetKind, targetName, secretNames, results, zap.L())
+	close(results)
+	if err != nil {
+		logErrorWithFields(err, fields)
+		return err
+	}
+
+	logResultsFromChannel(ctx, results, fields)
+	return nil
+}
+
+// CrewCreateVPA is a TaskRunner that creates a VerticalPodAutoscaler for a deployment,
+// using the dynamic client because VPA is a custom resource.
+type CrewCreateVPA struct {
+	// shipsNamespace specifies the Kubernetes namespace in which the VerticalPodAutoscaler is created.
+	shipsNamespace string
+
+	// workerIndex is an identifier for the worker that is executing the task.
+	// This can be used for logging and tracking the progress of the task across multiple workers.
+	workerIndex int
+}
+
+// Run creates a VerticalPodAutoscaler targeting the deployment named by 'targetName' with the given
+// 'updateMode' and optional 'minAllowed' and 'maxAllowed' resources, using the CreateVPA function.
+// An existing VerticalPodAutoscaler is only replaced when 'overwrite' is true.
+func (c *CrewCreateVPA) Run(ctx context.Context, clientset kubernetes.Interface, shipsNamespace string, task configuration.Task, parameters map[string]interface{}, workerIndex int) error {
+	// Use the provided logging pattern
+	fields := createLogFieldsForRunnerTask(ctx, task, shipsNamespace, language.TaskCreateVPA)
+	logTaskStart(fmt.Sprintf(language.CreatingVPA, workerIndex), fields)
+
+	params, err := extractVPAParameters(parameters)
+	if err != nil {
+		navigator.LogErrorWithEmojiRateLimited(language.PirateEmoji, err.Error(), fields...)
+		return err
+	}
+
+	dynamicClient, _, err := dynamicClientFor(clientset)
+	if err != nil {
+		logErrorWithFields(err, fields)
+		return err
+	}
+
+	// The operation reports exactly one outcome, so the channel is closed right after it returns.
+	results := make(chan string, 1)
+	err = CreateVPA(ctx, dynamicClient, shipsNamespace, buildVPAObject(params), params.overwrite, results, zap.L())
+	close(results)
+	if err != nil {
+		logErrorWithFields(err, fields)
+		return err
+	}
+
+	logResultsFromChannel(ctx, results, fields)
+	return nil
+}
+
+// CrewCleanupJobs is a TaskRunner that deletes finished Jobs matching an age and status filter.
+type CrewCleanupJobs struct {
+	// shipsNamespace specifies the Kubernetes namespace whose Jobs are cleaned up.
+	shipsNamespace string
+
+	// workerIndex is an identifier for the worker that is executing the task.
+	// This can be used for logging and tracking the progress of the task across multiple workers.
+	workerIndex int
+}
+
+// Run deletes the Jobs matching the optional 'labelSelector' that finished with the optional 'status'
+// at least 'olderThan' ago, using the CleanupJobs function.
+func (c *CrewCleanupJobs) Run(ctx context.Context, clientset kubernetes.Interface, shipsNamespace string, task configuration.Task, parameters map[string]interface{}, workerIndex int) error {
+	// Use the provided logging pattern
+	fields := createLogFieldsForRunnerTask(ctx, task, shipsNamespace, language.TaskCleanupJobs)
+	logTaskStart(fmt.Sprintf(language.CleaningUpJobs, workerIndex), fields)
+
+	criteria, err := extractCleanupJobsParameters(parameters)
+	if err != nil {
+		navigator.LogErrorWithEmojiRateLimited(language.PirateEmoji, err.Error(), fields...)
+		return err
+	}
+
+	// The operation reports exactly one outcome, so the channel is closed right after it returns.
+	results := make(chan string, 1)
+	err = CleanupJobs(ctx, clientset, shipsNamespace, criteria, results, zap.L())
+	close(results)
+	if err != nil {
+		logErrorWithFields(err, fields)
+		return err
+	}
+
+	logResultsFromChannel(ctx, results, fields)
+	return nil
+}
+
+// CrewWaitForJobCompletion is a TaskRunner that waits for an existing Job to complete.
+type CrewWaitForJobCompletion struct {
+	// shipsNamespace specifies the Kubernetes namespace of the Job.
+	shipsNamespace string
+
+	// workerIndex is an identifier for the worker that is executing the task.
+	// This can be used for logging and tracking the progress of the task across multiple workers.
+	workerIndex int
+}
+
+// Run waits for the Job named by 'jobName' to complete within the optional 'timeout' using the
+// WaitForJobCompletion function. Progress is logged while the Job is running.
+func (c *CrewWaitForJobCompletion) Run(ctx context.Context, clientset kubernetes.Interface, shipsNamespace string, task configuration.Task, parameters map[string]interface{}, workerIndex int) error {
+	// Use the provided logging pattern
+	fields := createLogFieldsForRunnerTask(ctx, task, shipsNamespace, language.TaskWaitForJobCompletion)
+	logTaskStart(fmt.Sprintf(language.WaitingForJob, workerIndex), fields)
+
+	jobName, timeout, err := extractJobWaitParameters(parameters)
+	if err != nil {
+		navigator.LogErrorWithEmojiRateLimited(language.PirateEmoji, err.Error(), fields...)
+		return err
+	}
+
+	// The wait reports an unbounded number of progress messages, so they are logged while it runs.
+	results := make(chan string, 1)
+	drained := make(chan struct{})
+	go func() {
+		logResultsFromChannel(ctx, results, fields)
+		close(drained)
+	}()
+	err = WaitForJobCompletion(ctx, clientset, shipsNamespace, jobName, timeout, results, zap.L())
+	close(results)
+	<-drained
+	if err != nil {
+		logErrorWithFields(err, fields)
+		return err
+	}
+	return nil
+}
+
+// CrewGetDeploymentRevisions is a TaskRunner that lists the rollout history of a deployment,
+// showing the revisions available as rollback targets.
+type CrewGetDeploymentRevisions struct {
+	// shipsNamespace specifies the Kubernetes namespace of the deployment.
+	shipsNamespace string
+
+	// workerIndex is an identifier for the worker that is executing the task.
+	// This can be used for logging and tracking the progress of the task across multiple workers.
+	workerIndex int
+}
+
+// Run lists the revisions of the deployment named by 'deploymentName', newest first, and reports each
+// revision's ReplicaSet, images, and creation time through the results channel and structured logs.
+func (c *CrewGetDeploymentRevisions) Run(ctx context.Context, clientset kubernetes.Interface, shipsNamespace string, task configuration.Task, parameters map[string]interface{}, workerIndex int) error {
+	// Use the provided logging pattern
+	fields := createLogFieldsForRunnerTask(ctx, task, shipsNamespace, language.TaskGetDeploymentRevisions)
+	logTaskStart(fmt.Sprintf(language.GettingDeploymentRevisions, workerIndex), fields)
+
+	deploymentName, err := extractDeploymentNameParameter(parameters)
+	if err != nil {
+		navigator.LogErrorWithEmojiRateLimited(language.PirateEmoji, err.Error(), fields...)
+		return err
+	}
+
+	revisions, err := listDeploymentRevisions(ctx, clientset, shipsNamespace, deploymentName)
+	if err != nil {
+		logErrorWithFields(err, fields)
+		return err
+	}
+
+	results := make(chan string, len(revisions))
+	err = reportDeploymentRevisions(ctx, fields, revisions, results)
+	close(results)
+	if err != nil {
+		logErrorWithFields(err, fields)
+		return err
+	}
+	return nil
+}
+
+// CrewGetNamespaceInventory is a TaskRunner that reports how many of each key resource type
+// a namespace contains.
+type CrewGetNamespaceInventory struct {
+	// shipsNamespace specifies the Kubernetes namespace to inventory.
+	shipsNamespace string
+
+	// workerIndex is an identifier for the worker that is executing the task.
+	// This can be used for logging and tracking the progress of the task across multiple workers.
+	workerIndex int
+}
+
+// Run counts the deployments, statefulsets, pods, services, configmaps, secrets, PVCs, and network
+// policies of the namespace, with pods and PVCs broken down by phase, and reports one line per
+// resource type through the results channel and structured logs. Resource types that cannot be
+// listed are reported without failing the task, unless none could be listed.
+func (c *CrewGetNamespaceInventory) Run(ctx context.Context, clientset kubernetes.Interface, shipsNamespace string, task configuration.Task, parameters map[string]interface{}, workerIndex int) error {
+	// Use the provided logging pattern
+	fields := createLogFieldsForRunnerTask(ctx, task, shipsNamespace, language.TaskGetNamespaceInventory)
+	logTaskStart(fmt.Sprintf(language.GettingNamespaceInventory, workerIndex), fields)
+
+	entries, err := collectNamespaceInventory(ctx, clientset, shipsNamespace)
+	if err != nil {
+		logErrorWithFields(err, fields)
+		return err
+	}
+
+	results := make(chan string, len(entries))
+	err = reportNamespaceInventory(ctx, fields, entries, results)
+	close(results)
+	if err != nil {
+		logErrorWithFields(err, fields)
+		return err
+	}
+	return nil
+}
+
+// CrewScaleMany is a TaskRunner that scales every deployment matching a label selector,
+// for example a whole application tier, to the same number of replicas.
+type CrewScaleMany struct {
+	// shipsNamespace specifies the Kubernetes namespace of the deployments.
+	shipsNamespace string
+
+	// workerIndex is an identifier for the worker that is executing the task.
+	// This can be used for logging and tracking the progress of the task across multiple workers.
+	workerIndex int
+}
+
+// Run lists the deployments matching 'labelSelector' and scales each of them to 'replicas' using the
+// ScaleDeployments function, making a single attempt per deployment; a failed task is retried as a
+// whole by its retry policy, and deployments already at the desired scale are left untouched then.
+// Every deployment's outcome is logged, and the task fails naming the deployments that could not be scaled.
+func (c *CrewScaleMany) Run(ctx context.Context, clientset kubernetes.Interface, shipsNamespace string, task configuration.Task, parameters map[string]interface{}, workerIndex int) error {
+	// Use the provided logging pattern
+	fields := createLogFieldsForRunnerTask(ctx, task, shipsNamespace, language.TaskScaleMany)
+	logTaskStart(fmt.Sprintf(language.ScalingManyDeployments, workerIndex), fields)
+
+	selector, replicas, err := extractScaleManyParameters(parameters)
+	if err != nil {
+		navigator.LogErrorWithEmojiRateLimited(language.PirateEmoji, err.Error(), fields...)
+		return err
+	}
+	deploymentNames, err := listDeploymentNames(ctx, clientset, shipsNamespace, selector)
+	if err != nil {
+		logErrorWithFields(err, fields)
+		return err
+	}
+
+	// Each deployment reports exactly one outcome, so the channel is sized to hold all of them.
+	results := make(chan string, len(deploymentNames))
+	err = ScaleDeployments(ctx, clientset, shipsNamespace, deploymentNames, replicas, results, zap.L())
+	close(results)
+
+	logResultsFromChannel(ctx, results, fields)
+	if err != nil {
+		logErrorWithFields(err, fields)
+		return err
+	}
+	return nil
+}
+
+// CrewCheckPDBViolations is a TaskRunner that checks, before a drain, whether any PodDisruptionBudget
+// would block the eviction of the affected pods.
+type CrewCheckPDBViolations struct {
+	// shipsNamespace specifies the Kubernetes namespace whose pods and budgets are inspected.
+	shipsNamespace string
+
+	// workerIndex is an identifier for the worker that is executing the task.
+	// This can be used for logging and tracking the progress of the task across multiple workers.
+	workerIndex int
+}
+
+// Run reports the status of every PodDisruptionBudget governing the pods of the namespace, or only
+// the pods on the node named by the optional 'nodeName'. The check is read-only; when 'failIfBlocked'
+// is true the task fails if any budget allows no disruptions.
+func (c *CrewCheckPDBViolations) Run(ctx context.Context, clientset kubernetes.Interface, shipsNamespace string, task configuration.Task, parameters map[string]interface{}, workerIndex int) error {
+	// Use the provided logging pattern
+	fields := createLogFieldsForRunnerTask(ctx, task, shipsNamespace, language.TaskCheckPDBViolations)
+	logTaskStart(fmt.Sprintf(language.CheckingPDBViolations, workerIndex), fields)
+
+	nodeName, err := getOptionalParamAsString(parameters, nodeNamE, "")
+	if err != nil {
+		navigator.LogErrorWithEmojiRateLimited(language.PirateEmoji, err.Error(), fields...)
+		return err
+	}
+	failIfBlocked, err := getOptionalParamAsBool(parameters, failIfBlockeD, false)
+	if err != nil {
+		navigator.LogErrorWithEmojiRateLimited(language.PirateEmoji, err.Error(), fields...)
+		return err
+	}
+
+	statuses, err := checkPDBViolations(ctx, clientset, shipsNamespace, nodeName)
+	if err != nil {
+		logErrorWithFields(err, fields)
+		return err
+	}
+
+	results := make(chan string, len(statuses))
+	err = reportPDBViolations(ctx, fields, statuses, failIfBlocked, results)
+	close(results)
+	if err != nil {
+		logErrorWithFields(err, fields)
+		return err
+	}
+	return nil
+}
+
+// CrewGetPodLogsSince is a TaskRunner that reads recent logs of a pod container, optionally from
+// the previously terminated instance of the container.
+type CrewGetPodLogsSince struct {
+	// shipsNamespace specifies the Kubernetes namespace of the pod.
+	shipsNamespace string
+
+	// workerIndex is an identifier for the worker that is executing the task.
+	// This can be used for logging and tracking the progress of the task across multiple workers.
+	workerIndex int
+}
+
+// Run reads the logs of the pod named by 'podName' using the GetPodLogsSince function, limited to the
+// optional 'containerName', 'sinceSeconds' or 'sinceTime' window, 'previous' instance, and 'limitBytes' cap.
+func (c *CrewGetPodLogsSince) Run(ctx context.Context, clientset kubernetes.Interface, shipsNamespace string, task configuration.Task, parameters map[string]interface{}, workerIndex int) error {
+	// Use the provided logging pattern
+	fields := createLogFieldsForRunnerTask(ctx, task, shipsNamespace, language.TaskGetPodLogsSince)
+	logTaskStart(fmt.Sprintf(language.GettingPodLogs, workerIndex), fields)
+
+	podName, options, err := extractPodLogsParameters(parameters)
+	if err != nil {
+		navigator.LogErrorWithEmojiRateLimited(language.PirateEmoji, err.Error(), fields...)
+		return err
+	}
+
+	// The operation reports exactly one outcome, so the channel is closed right after it returns.
+	results := make(chan string, 1)
+	err = GetPodLogsSince(ctx, clientset, shipsNamespace, podName, options, results, zap.L())
+	close(results)
+	if err != nil {
+		logErrorWithFields(err, fields)
+		return err
+	}
+	logResultsFromChannel(ctx, results, fields)
+	return nil
+}
+
+// CrewUpdateSecretData is a TaskRunner that writes keys into an existing Secret, merging them into its
+// data unless a full replacement is requested.
+type CrewUpdateSecretData struct {
+	// shipsNamespace specifies the Kubernetes namespace of the Secret.
+	shipsNamespace string
+
+	// workerIndex is an identifier for the worker that is executing the task.
+	// This can be used for logging and tracking the progress of the task across multiple workers.
+	workerIndex int
+}
+
+// Run writes the 'stringData' keys into the Secret named by 'secretName' using the UpdateSecretData
+// function. Other keys are preserved unless 'replace' is true. Values are never logged.
+func (c *CrewUpdateSecretData) Run(ctx context.Context, clientset kubernetes.Interface, shipsNamespace string, task configuration.Task, parameters map[string]interface{}, workerIndex int) error {
+	// Use the provided logging pattern
+	fields := createLogFieldsForRunnerTask(ctx, task, shipsNamespace, language.TaskUpdateSecretData)
+	logTaskStart(fmt.Sprintf(language.UpdatingSecretData, workerIndex), fields)
+
+	secretName, stringData, replace, err := extractUpdateSecretParameters(parameters)
+	if err != nil {
+		navigator.LogErrorWithEmojiRateLimited(language.PirateEmoji, err.Error(), fields...)
+		return err
+	}
+
+	// The operation reports exactly one outcome, so the channel is closed right after it returns.
+	results := make(chan string, 1)
+	err = UpdateSecretData(ctx, clientset, shipsNamespace, secretName, stringData, replace, results, zap.L())
+	close(results)
+	if err != nil {
+		logErrorWithFields(err, fields)
+		return err
+	}
+	logResultsFromChannel(ctx, results, fields)
+	return nil
+}
+
+// CrewSetDeploymentNodeSelector is a TaskRunner that pins the pods of a deployment to matching nodes
+// by setting the node selector of its pod template.
+type CrewSetDeploymentNodeSelector struct {
+	// shipsNamespace specifies the Kubernetes namespace of the deployment.
+	shipsNamespace string
+
+	// workerIndex is an identifier for the worker that is executing the task.
+	// This can be used for logging and tracking the progress of the task across multiple workers.
+	workerIndex int
+}
+
+// Run merges the 'nodeSelector' entries into the pod template of the deployment named by 'deploymentName'
+// using the SetDeploymentNodeSelector function, or replaces the selector when 'replace' is true.
+func (c *CrewSetDeploymentNodeSelector) Run(ctx context.Context, clientset kubernetes.Interface, shipsNamespace string, task configuration.Task, parameters map[string]interface{}, workerIndex int) error {
+	// Use the provided logging pattern
+	fields := createLogFieldsForRunnerTask(ctx, task, shipsNamespace, language.TaskSetDeploymentNodeSelector)
+	logTaskStart(fmt.Sprintf(language.SettingDeploymentNodeSelector, workerIndex), fields)
+
+	deploymentName, nodeSelector, replace, err := extractNodeSelectorParameters(parameters)
+	if err != nil {
+		navigator.LogErrorWithEmojiRateLimited(language.PirateEmoji, err.Error(), fields...)
+		return err
+	}
+
+	// The operation reports exactly one outcome, so the channel is closed right after it returns.
+	results := make(chan string, 1)
+	err = SetDeploymentNodeSelector(ctx, clientset, shipsNamespace, deploymentName, nodeSelector, replace, results, zap.L())
+	close(results)
+	if err != nil {
+		logErrorWithFields(err, fields)
+		return err
+	}
+	logResultsFromChannel(ctx, results, fields)
+	return nil
+}
+
+// CrewCheckImageTags is a TaskRunner that reports deployment containers whose images drift from
+// the expected ones, without changing anything.
+type CrewCheckImageTags struct {
+	// shipsNamespace specifies the Kubernetes namespace of the deployments.
+	shipsNamespace string
+
+	// workerIndex is an identifier for the worker that is executing the task.
+	// This can be used for logging and tracking the progress of the task across multiple workers.
+	workerIndex int
+}
+
+// Run compares the images of the deployments matching the optional 'labelSelector' with the 'expected'
+// map of container names to images and reports each checked container. The task fails on a mismatch
+// only when 'failOnMismatch' is true.
+func (c *CrewCheckImageTags) Run(ctx context.Context, clientset kubernetes.Interface, shipsNamespace string, task configuration.Task, parameters map[string]interface{}, workerIndex int) error {
+	// Use the provided logging pattern
+	fields := createLogFieldsForRunnerTask(ctx, task, shipsNamespace, language.TaskCheckImageTags)
+	logTaskStart(fmt.Sprintf(language.CheckingImageTags, workerIndex), fields)
+
+	selector, expected, failOnMismatch, err := extractImageCheckParameters(parameters)
+	if err != nil {
+		navigator.LogErrorWithEmojiRateLimited(language.PirateEmoji, err.Error(), fields...)
+		return err
+	}
+
+	checks, err := checkImageTags(ctx, clientset, shipsNamespace, selector, expected)
+	if err != nil {
+		logErrorWithFields(err, fields)
+		return err
+	}
+
+	results := make(chan string, len(checks))
+	err = reportImageChecks(ctx, fields, checks, failOnMismatch, results)
+	close(results)
+	if err != nil {
+		logErrorWithFields(err, fields)
+		return err
+	}
+	return nil
+}
+
+// CrewDeletePodsByPhase is a TaskRunner that deletes the Succeeded or Failed pods of a namespace.
+type CrewDeletePodsByPhase struct {
+	// shipsNamespace specifies the Kubernetes namespace whose pods are deleted.
+	shipsNamespace string
+
+	// workerIndex is an identifier for the worker that is executing the task.
+	// This can be used for logging and tracking the progress of the task across multiple workers.
+	workerIndex int
+}
+
+// Run deletes the pods in the 'phase' given by the task, optionally restricted by 'labelSelector' and to
+// pods that finished at least 'olderThan' ago, using the DeletePodsByPhase function.
+func (c *CrewDeletePodsByPhase) Run(ctx context.Context, clientset kubernetes.Interface, shipsNamespace string, task configuration.Task, parameters map[string]interface{}, workerIndex int) error {
+	// Use the provided logging pattern
+	fields := createLogFieldsForRunnerTask(ctx, task, shipsNamespace, language.TaskDeletePodsByPhase)
+	logTaskStart(fmt.Sprintf(language.DeletingPodsByPhase, workerIndex), fields)
+
+	criteria, err := extractDeletePodsByPhaseParameters(parameters)
+	if err != nil {
+		navigator.LogErrorWithEmojiRateLimited(language.PirateEmoji, err.Error(), fields...)
+		return err
+	}
+
+	// The operation reports exactly one outcome, so the channel is closed right after it returns.
+	results := make(chan string, 1)
+	err = DeletePodsByPhase(ctx, clientset, shipsNamespace, criteria, results, zap.L())
+	close(results)
+	if err != nil {
+		logErrorWithFields(err, fields)
+		return err
+	}
+
+	logResultsFromChannel(ctx, results, fields)
+	return nil
+}
+
+// CrewGetResourceQuotaUsage is a TaskRunner that reports how much of each ResourceQuota of a namespace
+// is consumed, to check for headroom before scaling.
+type CrewGetResourceQuotaUsage struct {
+	// shipsNamespace specifies the Kubernetes namespace whose quotas are inspected.
+	shipsNamespace string
+
+	// workerIndex is an identifier for the worker that is executing the task.
+	// This can be used for logging and tracking the progress of the task across multiple workers.
+	workerIndex int
+}
+
+// Run reports the used amount, hard limit, and remaining headroom of every resource limited by the
+// namespace's ResourceQuotas, flagging resources at or near their limit. The task fails on an exhausted
+// resource only when 'failIfExhausted' is true.
+func (c *CrewGetResourceQuotaUsage) Run(ctx context.Context, clientset kubernetes.Interface, shipsNamespace string, task configuration.Task, parameters map[string]interface{}, workerIndex int) error {
+	// Use the provided logging pattern
+	fields := createLogFieldsForRunnerTask(ctx, task, shipsNamespace, language.TaskGetResourceQuotaUsage)
+	logTaskStart(fmt.Sprintf(language.GettingResourceQuotaUsage, workerIndex), fields)
+
+	failIfExhausted, err := getOptionalParamAsBool(parameters, failIfExhausteD, false)
+	if err != nil {
+		navigator.LogErrorWithEmojiRateLimited(language.PirateEmoji, err.Error(), fields...)
+		return err
+	}
+
+	usages, err := getResourceQuotaUsage(ctx, clientset, shipsNamespace)
+	if err != nil {
+		logErrorWithFields(err, fields)
+		return err
+	}
+
+	results := make(chan string, max(len(usages), 1))
+	err = reportResourceQuotaUsage(ctx, fields, shipsNamespace, usages, failIfExhausted, results)
+	close(results)
+	if err != nil {
+		logErrorWithFields(err, fields)
+		return err
+	}
+	return nil
+}
+
+// CrewCreateConfigMap is a TaskRunner that creates or overwrites a ConfigMap holding string data and
+// binary data supplied as base64.
+type CrewCreateConfigMap struct {
+	// shipsNamespace specifies the Kubernetes namespace of the ConfigMap.
+	shipsNamespace string
+
+	// workerIndex is an identifier for the worker that is executing the task.
+	// This can be used for logging and tracking the progress of the task across multiple workers.
+	workerIndex int
+}
+
+// Run creates the ConfigMap named by the 'configMapName' parameter from the 'data' and 'binaryData'
+// parameters using the CreateConfigMap function. When 'overwrite' is true, an existing ConfigMap's data is replaced.
+func (c *CrewCreateConfigMap) Run(ctx context.Context, clientset kubernetes.Interface, shipsNamespace string, task configuration.Task, parameters map[string]interface{}, workerIndex int) error {
+	// Use the provided logging pattern
+	fields := createLogFieldsForRunnerTask(ctx, task, shipsNamespace, language.TaskCreateConfigMap)
+	logTaskStart(fmt.Sprintf(language.CreatingConfigMap, workerIndex), fields)
+
+	configMap, overwrite, err := extractConfigMapParameters(parameters)
+	if err != nil {
+		navigator.LogErrorWithEmojiRateLimited(language.PirateEmoji, err.Error(), fields...)
+		return err
+	}
+
+	// The operation reports exactly one outcome, so the channel is closed right after it returns.
+	results := make(chan string, 1)
+	err = CreateConfigMap(ctx, clientset, shipsNamespace, configMap, overwrite, results, zap.L())
+	close(results)
+	if err != nil {
+		logErrorWithFields(err, fields)
+		return err
+	}
+
+	logResultsFromChannel(ctx, results, fields)
+	return nil
+}
+
+// CrewWaitForPVCBound is a TaskRunner that waits for an existing PVC to be bound, gating tasks that mount it.
+type CrewWaitForPVCBound struct {
+	// shipsNamespace specifies the Kubernetes namespace of the PVC.
+	shipsNamespace string
+
+	// workerIndex is an identifier for the worker that is executing the task.
+	// This can be used for logging and tracking the progress of the task across multiple workers.
+	workerIndex int
+}
+
+// Run waits for the PVC named by 'pvcName' to be bound within the optional 'timeout' using the
+// WaitForPVCBound function. Phase changes are logged while the PVC is pending.
+func (c *CrewWaitForPVCBound) Run(ctx context.Context, clientset kubernetes.Interface, shipsNamespace string, task configuration.Task, parameters map[string]interface{}, workerIndex int) error {
+	// Use the provided logging pattern
+	fields := createLogFieldsForRunnerTask(ctx, task, shipsNamespace, language.TaskWaitForPVCBound)
+	logTaskStart(fmt.Sprintf(language.WaitingForPVCBound, workerIndex), fields)
+
+	claimName, timeout, err := extractPVCWaitParameters(parameters)
+	if err != nil {
+		navigator.LogErrorWithEmojiRateLimited(language.PirateEmoji, err.Error(), fields...)
+		return err
+	}
+
+	// The wait reports an unbounded number of progress messages, so they are logged while it runs.
+	results := make(chan string, 1)
+	drained := make(chan struct{})
+	go func() {
+		logResultsFromChannel(ctx, results, fields)
+		close(drained)
+	}()
+	err = WaitForPVCBound(ctx, clientset, shipsNamespace, claimName, timeout, results, zap.L())
+	close(results)
+	<-drained
+	if err != nil {
+		logErrorWithFields(err, fields)
+		return err
+	}
+	return nil
+}
+
+// CrewRestartPods is a TaskRunner that restarts the pods matching a label selector by deleting them,
+// leaving their controllers untouched.
+type CrewRestartPods struct {
+	// shipsNamespace specifies the Kubernetes namespace of the pods.
+	shipsNamespace string
+
+	// workerIndex is an identifier for the worker that is executing the task.
+	// This can be used for logging and tracking the progress of the task across multiple workers.
+	workerIndex int
+}
+
+// Run restarts the pods matching the 'labelSelector' parameter within the optional 'timeout' using the
+// RestartPods function. When 'rolling' is true, each replacement must be ready before the next pod is deleted.
+func (c *CrewRestartPods) Run(ctx context.Context, clientset kubernetes.Interface, shipsNamespace string, task configuration.Task, parameters map[string]interface{}, workerIndex int) error {
+	// Use the provided logging pattern
+	fields := createLogFieldsForRunnerTask(ctx, task, shipsNamespace, language.TaskRestartPods)
+	logTaskStart(fmt.Sprintf(language.RestartingPods, workerIndex), fields)
+
+	selector, rolling, timeout, err := extractRestartPodsParameters(parameters)
+	if err != nil {
+		navigator.LogErrorWithEmojiRateLimited(language.PirateEmoji, err.Error(), fields...)
+		return err
+	}
+
+	// The restart reports an unbounded number of progress messages, so they are logged while it runs.
+	results := make(chan string, 1)
+	drained := make(chan struct{})
+	go func() {
+		logResultsFromChannel(ctx, results, fields)
+		close(drained)
+	}()
+	err = RestartPods(ctx, clientset, shipsNamespace, selector, rolling, timeout, results, zap.L())
+	close(results)
+	<-drained
+	if err != nil {
+		logErrorWithFields(err, fields)
+		return err
+	}
+	return nil
+}
+
+// CrewGetPodOwnerChain is a TaskRunner that reports the chain of objects owning a pod.
+type CrewGetPodOwnerChain struct {
+	// shipsNamespace specifies the Kubernetes namespace of the pod.
+	shipsNamespace string
+
+	// workerIndex is an identifier for the worker that is executing the task.
+	// This can be used for logging and tracking the progress of the task across multiple workers.
+	workerIndex int
+}
+
+// Run reports the ownership chain of the pod named by the 'podName' parameter using the
+// GetPodOwnerChain function.
+func (c *CrewGetPodOwnerChain) Run(ctx context.Context, clientset kubernetes.Interface, shipsNamespace string, task configuration.Task, parameters map[string]interface{}, workerIndex int) error {
+	// Use the provided logging pattern
+	fields := createLogFieldsForRunnerTask(ctx, task, shipsNamespace, language.TaskGetPodOwnerChain)
+	logTaskStart(fmt.Sprintf(language.GettingPodOwnerChain, workerIndex), fields)
+
+	podName, err := extractOwnerChainParameters(parameters)
+	if err != nil {
+		navigator.LogErrorWithEmojiRateLimited(language.PirateEmoji, err.Error(), fields...)
+		return err
+	}
+
+	// The operation reports exactly one outcome, so the channel is closed right after it returns.
+	results := make(chan string, 1)
+	err = GetPodOwnerChain(ctx, clientset, shipsNamespace, podName, results, zap.L())
+	close(results)
+	if err != nil {
+		logErrorWithFields(err, fields)
+		return err
+	}
+
+	logResultsFromChannel(ctx, results, fields)
+	return nil
+}
+
+// CrewCreateHeadlessService is a TaskRunner that creates a headless Service for StatefulSet discovery.
+type CrewCreateHeadlessService struct {
+	// shipsNamespace specifies the Kubernetes namespace of the Service.
+	shipsNamespace string
+
+	// workerIndex is an identifier for the worker that is executing the task.
+	// This can be used for logging and tracking the progress of the task across multiple workers.
+	workerIndex int
+}
+
+// Run creates the headless Service named by the 'serviceName' parameter with the given 'selector' and
+// 'ports' using the CreateHeadlessService function.
+func (c *CrewCreateHeadlessService) Run(ctx context.Context, clientset kubernetes.Interface, shipsNamespace string, task configuration.Task, parameters map[string]interface{}, workerIndex int) error {
+	// Use the provided logging pattern
+	fields := createLogFieldsForRunnerTask(ctx, task, shipsNamespace, language.TaskCreateHeadlessService)
+	logTaskStart(fmt.Sprintf(language.CreatingHeadlessService, workerIndex), fields)
+
+	service, err := extractHeadlessServiceParameters(parameters)
+	if err != nil {
+		navigator.LogErrorWithEmojiRateLimited(language.PirateEmoji, err.Error(), fields...)
+		return err
+	}
+
+	// The operation reports exactly one outcome, so the channel is closed right after it returns.
+	results := make(chan string, 1)
+	err = CreateHeadlessService(ctx, clientset, shipsNamespace, service, results, zap.L())
+	close(results)
+	if err != nil {
+		logErrorWithFields(err, fields)
+		return err
+	}
+
+	logResultsFromChannel(ctx, results, fields)
+	return nil
+}
+
+// CrewCheckDeploymentRolloutStuck is a TaskRunner that detects deployment rollouts that stopped progressing.
+type CrewCheckDeploymentRolloutStuck struct {
+	// shipsNamespace specifies the Kubernetes namespace of the deployment.
+	shipsNamespace string
+
+	// workerIndex is an identifier for the worker that is executing the task.
+	// This can be used for logging and tracking the progress of the task across multiple workers.
+	workerIndex int
+}
+
+// Run diagnoses the rollout of the deployment named by the 'deploymentName' parameter using the
+// CheckDeploymentRolloutStuck function. When 'failIfStuck' is true, a stuck rollout fails the task.
+func (c *CrewCheckDeploymentRolloutStuck) Run(ctx context.Context, clientset kubernetes.Interface, shipsNamespace string, task configuration.Task, parameters map[string]interface{}, workerIndex int) error {
+	// Use the provided logging pattern
+	fields := createLogFieldsForRunnerTask(ctx, task, shipsNamespace, language.TaskCheckDeploymentRolloutStuck)
+	logTaskStart(fmt.Sprintf(language.CheckingRolloutStuck, workerIndex), fields)
+
+	deploymentName, threshold, failIfStuck, err := extractRolloutStuckParameters(parameters)
+	if err != nil {
+		navigator.LogErrorWithEmojiRateLimited(language.PirateEmoji, err.Error(), fields...)
+		return err
+	}
+
+	// The operation reports exactly one outcome, so the channel is closed right after it returns.
+	results := make(chan string, 1)
+	err = CheckDeploymentRolloutStuck(ctx, clientset, shipsNamespace, deploymentName, threshold, failIfStuck, results, zap.L())
+	close(results)
+	if err != nil {
+		logErrorWithFields(err, fields)
+		return err
+	}
+
+	logResultsFromChannel(ctx, results, fields)
+	return nil
+}
+
+// CrewUpdateHPA is a TaskRunner that adjusts the bounds and CPU target of a HorizontalPodAutoscaler.
+type CrewUpdateHPA struct {
+	// shipsNamespace specifies the Kubernetes namespace of the HPA.
+	shipsNamespace string
+
+	// workerIndex is an identifier for the worker that is executing the task.
+	// This can be used for logging and tracking the progress of the task across multiple workers.
+	workerIndex int
+}
+
+// Run updates the HPA named by the 'hpaName' parameter using the UpdateHPA function. Only the given
+// 'minReplicas', 'maxReplicas', and 'targetCPUUtilizationPercentage' parameters are changed.
+func (c *CrewUpdateHPA) Run(ctx context.Context, clientset kubernetes.Interface, shipsNamespace string, task configuration.Task, parameters map[string]interface{}, workerIndex int) error {
+	// Use the provided logging pattern
+	fields := createLogFieldsForRunnerTask(ctx, task, shipsNamespace, language.TaskUpdateHPA)
+	logTaskStart(fmt.Sprintf(language.UpdatingHPA, workerIndex), fields)
+
+	hpaName, update, err := extractUpdateHPAParameters(parameters)
+	if err != nil {
+		navigator.LogErrorWithEmojiRateLimited(language.PirateEmoji, err.Error(), fields...)
+		return err
+	}
+
+	// The operation reports exactly one outcome, so the channel is closed right after it returns.
+	results := make(chan string, 1)
+	err = UpdateHPA(ctx, clientset, shipsNamespace, hpaName, update, results, zap.L())
+	close(results)
+	if err != nil {
+		logErrorWithFields(err, fields)
+		return err
+	}
+
+	logResultsFromChannel(ctx, results, fields)
+	return nil
+}
+
+// CrewCreateEndpointSlice is a TaskRunner that creates or overwrites the EndpointSlice of a Service,
+// the modern replacement for the legacy Endpoints managed by CrewCreateEndpoints.
+type CrewCreateEndpointSlice struct {
+	// shipsNamespace specifies the Kubernetes namespace of the Service.
+	shipsNamespace string
+
+	// workerIndex is an identifier for the worker that is executing the task.
+	// This can be used for logging and tracking the progress of the task across multiple workers.
+	workerIndex int
+}
+
+// Run creates the EndpointSlice of the Service named by the 'serviceName' parameter from the
+// 'addressType', 'endpoints', and 'ports' parameters using the CreateEndpointSlice function. When
+// 'overwrite' is true, an existing slice's endpoints and ports are replaced.
+func (c *CrewCreateEndpointSlice) Run(ctx context.Context, clientset kubernetes.Interface, shipsNamespace string, task configuration.Task, parameters map[string]interface{}, workerIndex int) error {
+	// Use the provided logging pattern
+	fields := createLogFieldsForRunnerTask(ctx, task, shipsNamespace, language.TaskCreateEndpointSlice)
+	logTaskStart(fmt.Sprintf(language.CreatingEndpointSlice, workerIndex), fields)
+
+	slice, overwrite, err := extractEndpointSliceParameters(parameters)
+	if err != nil {
+		navigator.LogErrorWithEmojiRateLimited(language.PirateEmoji, err.Error(), fields...)
+		return err
+	}
+
+	// The operation reports exactly one outcome, so the channel is closed right after it returns.
+	results := make(chan string, 1)
+	err = CreateEndpointSlice(ctx, clientset, shipsNamespace, slice, overwrite, results, zap.L())
+	close(results)
+	if err != nil {
+		logErrorWithFields(err, fields)
+		return err
+	}
+
+	logResultsFromChannel(ctx, results, fields)
+	return nil
+}
+
+// CrewGetPodResourceRequests is a TaskRunner that sums the resource requests and limits of pods.
+type CrewGetPodResourceRequests struct {
+	// shipsNamespace specifies the Kubernetes namespace of the pods.
+	shipsNamespace string
+
+	// workerIndex is an identifier for the worker that is executing the task.
+	// This can be used for logging and tracking the progress of the task across multiple workers.
+	workerIndex int
+}
+
+// Run sums the CPU and memory requests and limits of the pods matching the optional 'labelSelector'
+// parameter using the GetPodResourceRequests function. When 'perPod' is true, every pod is reported too.
+func (c *CrewGetPodResourceRequests) Run(ctx context.Context, clientset kubernetes.Interface, shipsNamespace string, task configuration.Task, parameters map[string]interface{}, workerIndex int) error {
+	// Use the provided logging pattern
+	fields := createLogFieldsForRunnerTask(ctx, task, shipsNamespace, language.TaskGetPodResourceRequests)
+	logTaskStart(fmt.Sprintf(language.SummingPodResources, workerIndex), fields)
+
+	selector, perPod, err := extractPodResourcesParameters(parameters)
+	if err != nil {
+		navigator.LogErrorWithEmojiRateLimited(language.PirateEmoji, err.Error(), fields...)
+		return err
+	}
+
+	// The per-pod breakdown is unbounded, so the messages are logged while the operation runs.
+	results := make(chan string, 1)
+	drained := make(chan struct{})
+	go func() {
+		logResultsFromChannel(ctx, results, fields)
+		close(drained)
+	}()
+	err = GetPodResourceRequests(ctx, clientset, shipsNamespace, selector, perPod, results, zap.L())
+	close(results)
+	<-drained
+	if err != nil {
+		logErrorWithFields(err, fields)
+		return err
+	}
+	return nil
+}
+
+// CrewCheckPodSecurity is a TaskRunner that audits the securityContext of pods against a policy.
+type CrewCheckPodSecurity struct {
+	// shipsNamespace specifies the Kubernetes namespace of the pods.
+	shipsNamespace string
+
+	// workerIndex is an identifier for the worker that is executing the task.
+	// This can be used for logging and tracking the progress of the task across multiple workers.
+	workerIndex int
+}
+
+// Run audits the pods matching the optional 'labelSelector' parameter against the optional 'policy'
+// using the CheckPodSecurity function. When 'failIfViolation' is true, any violation fails the task.
+func (c *CrewCheckPodSecurity) Run(ctx context.Context, clientset kubernetes.Interface, shipsNamespace string, task configuration.Task, parameters map[string]interface{}, workerIndex int) error {
+	// Use the provided logging pattern
+	fields := createLogFieldsForRunnerTask(ctx, task, shipsNamespace, language.TaskCheckPodSecurity)
+	logTaskStart(fmt.Sprintf(language.CheckingPodSecurity, workerIndex), fields)
+
+	selector, policy, failIfViolation, err := extractPodSecurityParameters(parameters)
+	if err != nil {
+		navigator.LogErrorWithEmojiRateLimited(language.PirateEmoji, err.Error(), fields...)
+		return err
+	}
+
+	// The check reports one message per violating container, so they are logged while it runs.
+	results := make(chan string, 1)
+	drained := make(chan struct{})
+	go func() {
+		logResultsFromChannel(ctx, results, fields)
+		close(drained)
+	}()
+	err = CheckPodSecurity(ctx, clientset, shipsNamespace, selector, policy, failIfViolation, results, zap.L())
+	close(results)
+	<-drained
+	if err != nil {
+		logErrorWithFields(err, fields)
+		return err
+	}
+	return nil
+}
+
+// CrewLabelResourcesAcrossKinds is a TaskRunner that labels the objects of several resource kinds
+// matching a label selector.
+type CrewLabelResourcesAcrossKinds struct {
+	// shipsNamespace specifies the Kubernetes namespace of the objects.
+	shipsNamespace string
+
+	// workerIndex is an identifier for the worker that is executing the task.
+	// This can be used for logging and tracking the progress of the task across multiple workers.
+	workerIndex int
+}
+
+// Run labels the objects of every requested kind and logs the outcome of each kind.
+func (c *CrewLabelResourcesAcrossKinds) Run(ctx context.Context, clientset kubernetes.Interface, shipsNamespace string, task configuration.Task, parameters map[string]interface{}, workerIndex int) error {
+	// Use the provided logging pattern
+	fields := createLogFieldsForRunnerTask(ctx, task, shipsNamespace, language.TaskLabelResourcesAcrossKinds)
+	logTaskStart(fmt.Sprintf(language.LabelingResourcesAcrossKinds, workerIndex), fields)
+
+	kinds, selector, labelKey, labelValue, err := extractLabelKindsParameters(parameters)
+	if err != nil {
+		navigator.LogErrorWithEmojiRateLimited(language.PirateEmoji, err.Error(), fields...)
+		return err
+	}
+
+	// The labeling reports one message per kind, so they are logged while it runs.
+	results := make(chan string, 1)
+	drained := make(chan struct{})
+	go func() {
+		logResultsFromChannel(ctx, results, fields)
+		close(drained)
+	}()
+	err = LabelResourcesAcrossKinds(ctx, clientset, shipsNamespace, kinds, selector, labelKey, labelValue, results, zap.L())
+	close(results)
+	<-drained
+	if err != nil {
+		logErrorWithFields(err, fields)
+		return err
+	}
+	return nil
+}
+
+// CrewGetOrphanedResources is a TaskRunner that reports the ConfigMaps, Secrets, and
+// PersistentVolumeClaims of a namespace that appear to be unreferenced.
+type CrewGetOrphanedResources struct {
+	// shipsNamespace specifies the Kubernetes namespace to check.
+	shipsNamespace string
+
+	// workerIndex is an identifier for the worker that is executing the task.
+	// This can be used for logging and tracking the progress of the task across multiple workers.
+	workerIndex int
+}
+
+// Run finds the unreferenced resources of the namespace and logs every candidate.
+func (c *CrewGetOrphanedResources) Run(ctx context.Context, clientset kubernetes.Interface, shipsNamespace string, task configuration.Task, parameters map[string]interface{}, workerIndex int) error {
+	// Use the provided logging pattern
+	fields := createLogFieldsForRunnerTask(ctx, task, shipsNamespace, language.TaskGetOrphanedResources)
+	logTaskStart(fmt.Sprintf(language.FindingOrphanedResources, workerIndex), fields)
+
+	// The check reports one message per unreferenced resource, so they are logged while it runs.
+	results := make(chan string, 1)
+	drained := make(chan struct{})
+	go func() {
+		logResultsFromChannel(ctx, results, fields)
+		close(drained)
+	}()
+	err := GetOrphanedResources(ctx, clientset, shipsNamespace, results, zap.L())
+	close(results)
+	<-drained
+	if err != nil {
+		logErrorWithFields(err, fields)
+		return err
+	}
+	return nil
+}
+
+// CrewScaleBasedOnPodCount is a TaskRunner that scales a deployment to a number of replicas derived
+// from the count of the pods matching a selector.
+type CrewScaleBasedOnPodCount struct {
+	// shipsNamespace specifies the Kubernetes namespace of the pods and of the deployment.
+	shipsNamespace string
+
+	// workerIndex is an identifier for the worker that is executing the task.
+	// This can be used for logging and tracking the progress of the task across multiple workers.
+	workerIndex int
+}
+
+// Run counts the source pods, scales the target deployment accordingly with a single attempt, leaving
+// retries to the task's retry policy, and logs the computed replicas and the outcome.
+func (c *CrewScaleBasedOnPodCount) Run(ctx context.Context, clientset kubernetes.Interface, shipsNamespace string, task configuration.Task, parameters map[string]interface{}, workerIndex int) error {
+	// Use the provided logging pattern
+	fields := createLogFieldsForRunnerTask(ctx, task, shipsNamespace, language.TaskScaleBasedOnPodCount)
+	logTaskStart(fmt.Sprintf(language.ScalingBasedOnPodCount, workerIndex), fields)
+
+	selector, target, scaling, err := extractScaleByPodCountParameters(parameters)
+	if err != nil {
+		navigator.LogErrorWithEmojiRateLimited(language.PirateEmoji, err.Error(), fields...)
+		return err
+	}
+
+	// The operation reports the computed replicas and one scaling outcome, so the channel is sized
+	// to hold both and closed right after it returns.
+	results := make(chan string, 2)
+	err = ScaleBasedOnPodCount(ctx, clientset, shipsNamespace, selector, target, scaling, results, zap.L())
+	close(results)
+	if err != nil {
+		logErrorWithFields(err, fields)
+		return err
+	}
+
+	logResultsFromChannel(ctx, results, fields)
+	return nil
+}
+
+// getLatestVersionOfPod fetches the latest version of the Pod from the Kubernetes API.
+func getLatestVersionOfPod(ctx context.Context, clientset kubernetes.Interface, namespace string, podName string) (*corev1.Pod, error) {
+	// Fetch the latest version of the Pod using the clientset.
+	latestPod, err := clientset.CoreV1().Pods(namespace).Get(ctx, podName, v1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return latestPod, nil
+}
+
+// performTask runs the specified task by finding the appropriate TaskRunner from the registry
+// and invoking its Run method with the task's parameters. Snake_case parameter keys are first normalized
+// to the camelCase keys the runners read. Any ${tasks.<name>.output.<key>} references
+// are replaced by the outputs of earlier tasks, and any 'secretRef' parameter values are resolved
+// from Secrets in the task's namespace, before the runner executes. A panic in the runner
+// is recovered and returned as a non-retriable error, so one bad task cannot take down the crew.
+func performTask(ctx context.Context, clientset kubernetes.Interface, shipsnamespace string, task configuration.Task, workerIndex int) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = recoveredPanicError(task.Name, workerIndex, r)
+		}
+	}()
+	runner, err := GetTaskRunner(task.Type)
+	if err != nil {
+		return err
+	}
+	parameters, err := normalizeParameterKeys(task.Parameters)
+	if err != nil {
+		return err
+	}
+	parameters, err = resolveTaskOutputReferences(ctx, parameters)
+	if err != nil {
+		return err
+	}
+	parameters, err = resolveSecretParameters(ctx, clientset, shipsnamespace, parameters)
 	if err != nil {
 		return err
 	}
-	return runner.Run(ctx, clientset, shipsnamespace, task, task.Parameters, workerIndex)
+	task.Parameters = parameters
+	ctx = withTaskOutputTask(ctx, task.Name)
+	return runner.Run(withAPICallTask(ctx, task), clientset, shipsnamespace, task, parameters, workerIndex)
 }
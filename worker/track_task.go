@@ -1,6 +1,7 @@
 package worker
 
 import (
+	"context"
 	"sync"
 
 	"github.com/H0llyW00dzZ/K8sBlackPearl/worker/configuration"
@@ -112,14 +113,13 @@ func (s *TaskStatusMap) DeleteTask(name string) {
 //
 // Parameters:
 //
+//	ctx context.Context: Unused, as the claims are held in memory; present to satisfy ClaimStore.
 //	taskName string: The name of the task to claim.
 //
 // Returns:
 //
 //	bool: A boolean indicating whether the task was successfully claimed.
-//
-// Note: this deadcode is left here for future use.
-func (s *TaskStatusMap) Claim(taskName string) bool {
+func (s *TaskStatusMap) Claim(_ context.Context, taskName string) bool {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 	if _, alreadyClaimed := s.claimed[taskName]; alreadyClaimed {
@@ -136,8 +136,9 @@ func (s *TaskStatusMap) Claim(taskName string) bool {
 //
 // Parameters:
 //
+//	ctx context.Context: Unused, as the claims are held in memory; present to satisfy ClaimStore.
 //	taskName string: The name of the task to unclaim.
-func (s *TaskStatusMap) Release(taskName string) {
+func (s *TaskStatusMap) Release(_ context.Context, taskName string) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 	delete(s.claimed, taskName) // Remove the task's claim status.
@@ -168,14 +169,13 @@ func (s *TaskStatusMap) GetAllTasks() []configuration.Task {
 //
 // Parameters:
 //
+//	ctx context.Context: Unused, as the claims are held in memory; present to satisfy ClaimStore.
 //	taskName string: The name of the task to check the claim status for.
 //
 // Returns:
 //
 //	bool: A boolean indicating whether the task is currently claimed.
-//
-// Note: this deadcode is left here for future use.
-func (s *TaskStatusMap) IsClaimed(taskName string) bool {
+func (s *TaskStatusMap) IsClaimed(_ context.Context, taskName string) bool {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 	_, claimed := s.claimed[taskName] // Check the claim status of the task.
@@ -0,0 +1,157 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/H0llyW00dzZ/K8sBlackPearl/language"
+	"github.com/H0llyW00dzZ/K8sBlackPearl/navigator"
+	"github.com/H0llyW00dzZ/go-urlshortner/logmonitor/constant"
+	"go.uber.org/zap"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/util/retry"
+)
+
+// hpaUpdate holds the HorizontalPodAutoscaler fields to change; nil fields are left as they are.
+type hpaUpdate struct {
+	minReplicas          *int32
+	maxReplicas          *int32
+	targetCPUUtilization *int32
+}
+
+// UpdateHPA retunes an existing HorizontalPodAutoscaler, changing only the fields set in the update.
+// The CPU target is applied to the HPA's CPU resource metric as an average utilization, adding that
+// metric when the HPA has none. The bounds are validated after merging, so minReplicas must not exceed
+// maxReplicas once both the existing and the new values are combined. The update is retried on
+// conflicts with a fresh read.
+//
+// Parameters:
+//
+//	ctx context.Context: Context for cancellation and timeout.
+//	clientset kubernetes.Interface: A Kubernetes clientset for interacting with the Kubernetes API.
+//	namespace string: The namespace of the HPA.
+//	hpaName string: The name of the HPA to update.
+//	update hpaUpdate: The fields to change.
+//	results chan<- string: A channel to send operation results for logging.
+//	logger *zap.Logger: A logger for structured logging.
+//
+// Returns an error if the HPA does not exist, the merged bounds are invalid, or the update fails.
+func UpdateHPA(ctx context.Context, clientset kubernetes.Interface, namespace, hpaName string, update hpaUpdate, results chan<- string, logger *zap.Logger) error {
+	var applied *autoscalingv2.HorizontalPodAutoscaler
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		hpa, err := clientset.AutoscalingV2().HorizontalPodAutoscalers(namespace).Get(ctx, hpaName, v1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			return markNonRetriable(fmt.Errorf(language.ErrorHPANotFound, hpaName, namespace))
+		}
+		if err != nil {
+			return err
+		}
+
+		if update.minReplicas != nil {
+			hpa.Spec.MinReplicas = update.minReplicas
+		}
+		if update.maxReplicas != nil {
+			hpa.Spec.MaxReplicas = *update.maxReplicas
+		}
+		if update.targetCPUUtilization != nil {
+			setHPACPUTarget(hpa, *update.targetCPUUtilization)
+		}
+
+		minReplicas := int32(1)
+		if hpa.Spec.MinReplicas != nil {
+			minReplicas = *hpa.Spec.MinReplicas
+		}
+		if minReplicas > hpa.Spec.MaxReplicas {
+			return markNonRetriable(fmt.Errorf(language.ErrorHPAInvalidBounds, minReplicas, hpa.Spec.MaxReplicas))
+		}
+
+		applied, err = clientset.AutoscalingV2().HorizontalPodAutoscalers(namespace).Update(ctx, hpa, v1.UpdateOptions{})
+		return err
+	})
+	if err != nil {
+		errorMessage := fmt.Sprintf(language.ErrorFailedToUpdateHPA, hpaName, err)
+		results <- errorMessage
+		navigator.LogErrorWithEmojiRateLimited(constant.ErrorEmoji, errorMessage, zap.Error(err))
+		return err
+	}
+
+	minReplicas := int32(1)
+	if applied.Spec.MinReplicas != nil {
+		minReplicas = *applied.Spec.MinReplicas
+	}
+	successMsg := fmt.Sprintf(language.HPAUpdated, hpaName, minReplicas, applied.Spec.MaxReplicas)
+	results <- successMsg
+	navigator.LogInfoWithEmoji(constant.SuccessEmoji, successMsg)
+	return nil
+}
+
+// setHPACPUTarget sets the average utilization target of the HPA's CPU resource metric, adding the
+// metric when the HPA does not have one.
+//
+// This unexported function is used internally by UpdateHPA.
+func setHPACPUTarget(hpa *autoscalingv2.HorizontalPodAutoscaler, utilization int32) {
+	target := autoscalingv2.MetricTarget{Type: autoscalingv2.UtilizationMetricType, AverageUtilization: &utilization}
+	for i := range hpa.Spec.Metrics {
+		metric := &hpa.Spec.Metrics[i]
+		if metric.Type == autoscalingv2.ResourceMetricSourceType && metric.Resource != nil && metric.Resource.Name == corev1.ResourceCPU {
+			metric.Resource.Target = target
+			return
+		}
+	}
+	hpa.Spec.Metrics = append(hpa.Spec.Metrics, autoscalingv2.MetricSpec{
+		Type:     autoscalingv2.ResourceMetricSourceType,
+		Resource: &autoscalingv2.ResourceMetricSource{Name: corev1.ResourceCPU, Target: target},
+	})
+}
+
+// extractUpdateHPAParameters extracts and validates the 'hpaName' parameter and the optional
+// 'minReplicas', 'maxReplicas', and 'targetCPUUtilizationPercentage' parameters, at least one of which
+// must be given. All of them must be at least 1; the CPU target may exceed 100, since utilization is
+// measured against the pods' requests.
+//
+// This function is used by task runners that update HPAs.
+func extractUpdateHPAParameters(parameters map[string]interface{}) (string, hpaUpdate, error) {
+	hpaName, err := getParamAsString(parameters, hpaNamE)
+	if err != nil || hpaName == "" {
+		return "", hpaUpdate{}, newParameterError(hpaNamE, err, language.ErrorParameterMissing, hpaNamE)
+	}
+
+	var update hpaUpdate
+	if update.minReplicas, err = getOptionalPositiveInt32(parameters, minReplicaS); err != nil {
+		return "", hpaUpdate{}, err
+	}
+	if update.maxReplicas, err = getOptionalPositiveInt32(parameters, maxReplicaS); err != nil {
+		return "", hpaUpdate{}, err
+	}
+	if update.targetCPUUtilization, err = getOptionalPositiveInt32(parameters, targetCPUUtilizationPercentagE); err != nil {
+		return "", hpaUpdate{}, err
+	}
+	if update.minReplicas == nil && update.maxReplicas == nil && update.targetCPUUtilization == nil {
+		return "", hpaUpdate{}, newParameterError(hpaNamE, nil, language.ErrorHPAUpdateMissing)
+	}
+
+	return hpaName, update, nil
+}
+
+// getOptionalPositiveInt32 retrieves an optional integer parameter that must be at least 1.
+// It returns nil when the key is absent.
+//
+// This unexported function is used internally by extractUpdateHPAParameters.
+func getOptionalPositiveInt32(parameters map[string]interface{}, key string) (*int32, error) {
+	if _, exists := parameters[key]; !exists {
+		return nil, nil
+	}
+	value, err := getParamAsInt(parameters, key)
+	if err != nil {
+		return nil, err
+	}
+	if value < 1 {
+		return nil, newParameterError(key, nil, language.ErrorParameterInvalid, key)
+	}
+	number := int32(value)
+	return &number, nil
+}
@@ -0,0 +1,90 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/H0llyW00dzZ/K8sBlackPearl/worker/configuration"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+// newHPAClientset returns a clientset holding an HPA with 2 to 10 replicas targeting 80% CPU.
+func newHPAClientset() *fake.Clientset {
+	minReplicas, utilization := int32(2), int32(80)
+	return fake.NewSimpleClientset(&autoscalingv2.HorizontalPodAutoscaler{
+		ObjectMeta: v1.ObjectMeta{Name: "api", Namespace: "default"},
+		Spec: autoscalingv2.HorizontalPodAutoscalerSpec{
+			MinReplicas: &minReplicas,
+			MaxReplicas: 10,
+			Metrics: []autoscalingv2.MetricSpec{{
+				Type: autoscalingv2.ResourceMetricSourceType,
+				Resource: &autoscalingv2.ResourceMetricSource{
+					Name:   corev1.ResourceCPU,
+					Target: autoscalingv2.MetricTarget{Type: autoscalingv2.UtilizationMetricType, AverageUtilization: &utilization},
+				},
+			}},
+		},
+	})
+}
+
+func runUpdateHPA(t *testing.T, clientset *fake.Clientset, parameters map[string]interface{}) (*autoscalingv2.HorizontalPodAutoscaler, error) {
+	t.Helper()
+	task := configuration.Task{Name: "retune", Type: "CrewUpdateHPA", Parameters: parameters}
+	err := (&CrewUpdateHPA{}).Run(context.Background(), clientset, "default", task, parameters, 0)
+	hpa, getErr := clientset.AutoscalingV2().HorizontalPodAutoscalers("default").Get(context.Background(), "api", v1.GetOptions{})
+	if getErr != nil && !apierrors.IsNotFound(getErr) {
+		t.Fatal(getErr)
+	}
+	return hpa, err
+}
+
+func TestCrewUpdateHPAChangesOnlyTheGivenFields(t *testing.T) {
+	for name, tc := range map[string]struct {
+		parameters                      map[string]interface{}
+		wantMin, wantMax, wantCPUTarget int32
+	}{
+		"max only":        {map[string]interface{}{"hpaName": "api", "maxReplicas": 20}, 2, 20, 80},
+		"min only":        {map[string]interface{}{"hpaName": "api", "minReplicas": 4}, 4, 10, 80},
+		"CPU target only": {map[string]interface{}{"hpaName": "api", "targetCPUUtilizationPercentage": 60}, 2, 10, 60},
+	} {
+		hpa, err := runUpdateHPA(t, newHPAClientset(), tc.parameters)
+		if err != nil {
+			t.Fatalf("%s: Run: %v", name, err)
+		}
+		if *hpa.Spec.MinReplicas != tc.wantMin || hpa.Spec.MaxReplicas != tc.wantMax || len(hpa.Spec.Metrics) != 1 || *hpa.Spec.Metrics[0].Resource.Target.AverageUtilization != tc.wantCPUTarget {
+			t.Fatalf("%s: got %d to %d replicas and metrics %+v, want %d to %d at %d%% CPU",
+				name, *hpa.Spec.MinReplicas, hpa.Spec.MaxReplicas, hpa.Spec.Metrics, tc.wantMin, tc.wantMax, tc.wantCPUTarget)
+		}
+	}
+}
+
+func TestCrewUpdateHPARejectsInvalidMergedBounds(t *testing.T) {
+	clientset := newHPAClientset()
+
+	hpa, err := runUpdateHPA(t, clientset, map[string]interface{}{"hpaName": "api", "minReplicas": 12})
+	if err == nil || !isNonRetriable(err) {
+		t.Fatalf("got error %v, want a non-retriable error for a minimum above the existing maximum", err)
+	}
+	if *hpa.Spec.MinReplicas != 2 || countUpdates(clientset) != 0 {
+		t.Fatalf("got minReplicas %d after %d updates, want the HPA left unchanged", *hpa.Spec.MinReplicas, countUpdates(clientset))
+	}
+}
+
+func TestCrewUpdateHPAReportsAMissingHPA(t *testing.T) {
+	_, err := runUpdateHPA(t, fake.NewSimpleClientset(), map[string]interface{}{"hpaName": "api", "maxReplicas": 20})
+	if err == nil || !isNonRetriable(err) {
+		t.Fatalf("got error %v, want a non-retriable not-found error", err)
+	}
+}
+
+func TestCrewUpdateHPARequiresAFieldToChange(t *testing.T) {
+	_, err := runUpdateHPA(t, newHPAClientset(), map[string]interface{}{"hpaName": "api"})
+	if !errors.Is(err, ErrInvalidParameter) {
+		t.Fatalf("got error %v, want an invalid parameter error when no field is given", err)
+	}
+}
@@ -0,0 +1,62 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/H0llyW00dzZ/K8sBlackPearl/worker/configuration"
+	"go.uber.org/zap"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+func TestUpdateDeploymentImageMakesASingleAttempt(t *testing.T) {
+	clientset, updates := newConflictingDeploymentClientset()
+	results := make(chan string, 1)
+
+	err := UpdateDeploymentImage(context.Background(), clientset, "default", "api", "app", "pearl:2", results, zap.NewNop())
+	if !apierrors.IsConflict(err) {
+		t.Fatalf("got error %v, want the conflict for the task's retry policy to handle", err)
+	}
+	if len(updates) != 1 || len(results) != 1 {
+		t.Fatalf("got %d update attempts and %d results, want 1 of each", len(updates), len(results))
+	}
+}
+
+func TestCrewUpdateImageDeploymentsStopsWaitingWhenCancelled(t *testing.T) {
+	clientset, updates := newConflictingDeploymentClientset()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		<-updates
+		cancel()
+	}()
+	task := configuration.Task{
+		Name:               "update-api",
+		Type:               "CrewUpdateImageDeployments",
+		ShipsNamespace:     "default",
+		MaxRetries:         5,
+		RetryDelayDuration: time.Hour,
+		Parameters:         map[string]interface{}{"deploymentName": "api", "containerName": "app", "newImage": "pearl:2"},
+	}
+
+	done := make(chan error, 1)
+	var attempts int
+	go func() {
+		var err error
+		attempts, _, err = performTaskWithRetries(ctx, clientset, "default", task, 0, nil)
+		done <- err
+	}()
+	select {
+	case err := <-done:
+		if err == nil || attempts != 1 {
+			t.Fatalf("got %d attempts and error %v, want the cancellation to end the retries after 1 attempt", attempts, err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("the task kept waiting between conflict retries after the context was cancelled")
+	}
+	if !errors.Is(ctx.Err(), context.Canceled) {
+		t.Fatal("the context was not cancelled by the first update")
+	}
+}
@@ -0,0 +1,131 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/H0llyW00dzZ/K8sBlackPearl/language"
+	"github.com/H0llyW00dzZ/K8sBlackPearl/navigator"
+	"github.com/H0llyW00dzZ/go-urlshortner/logmonitor/constant"
+	"go.uber.org/zap"
+	networkingv1 "k8s.io/api/networking/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/util/retry"
+)
+
+// ingressBackendUpdate identifies an Ingress rule path and the service backend it should point to.
+type ingressBackendUpdate struct {
+	ingressName string
+	host        string
+	path        string
+	backend     networkingv1.IngressServiceBackend
+}
+
+// UpdateIngressBackend repoints the backend of a single Ingress rule path to another service. The
+// Ingress is read, the path matching the host and path of the update is located, and the update is
+// retried on conflicts with a fresh read. The previous and new backends are reported.
+//
+// Parameters:
+//
+//	ctx context.Context: Context for cancellation and timeout.
+//	clientset kubernetes.Interface: A Kubernetes clientset for interacting with the Kubernetes API.
+//	namespace string: The namespace of the Ingress.
+//	update ingressBackendUpdate: The rule path to change and its new backend.
+//	results chan<- string: A channel to send operation results for logging.
+//	logger *zap.Logger: A logger for structured logging.
+//
+// Returns an error if the Ingress cannot be read or updated, or if no rule matches the host and path.
+func UpdateIngressBackend(ctx context.Context, clientset kubernetes.Interface, namespace string, update ingressBackendUpdate, results chan<- string, logger *zap.Logger) error {
+	var previous string
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		ingress, err := clientset.NetworkingV1().Ingresses(namespace).Get(ctx, update.ingressName, v1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		path := findIngressPath(ingress, update.host, update.path)
+		if path == nil {
+			return markNonRetriable(fmt.Errorf(language.ErrorIngressPathNotFound, update.host, update.path, update.ingressName))
+		}
+		previous = describeIngressBackend(&path.Backend)
+		backend := update.backend
+		path.Backend = networkingv1.IngressBackend{Service: &backend}
+		_, err = clientset.NetworkingV1().Ingresses(namespace).Update(ctx, ingress, v1.UpdateOptions{})
+		return err
+	})
+	if err != nil {
+		errorMessage := fmt.Sprintf(language.ErrorFailedToUpdateIngressBackend, update.ingressName, err)
+		results <- errorMessage
+		navigator.LogErrorWithEmojiRateLimited(constant.ErrorEmoji, errorMessage, zap.Error(err))
+		return err
+	}
+
+	current := describeIngressBackend(&networkingv1.IngressBackend{Service: &update.backend})
+	successMsg := fmt.Sprintf(language.IngressBackendUpdated, update.ingressName, update.host, update.path, previous, current)
+	results <- successMsg
+	navigator.LogInfoWithEmoji(constant.SuccessEmoji, successMsg, zap.String(language.IngressName, update.ingressName))
+	return nil
+}
+
+// findIngressPath returns the HTTP path of the Ingress whose rule host and path match exactly,
+// or nil if there is none. An empty host matches rules without a host.
+//
+// This unexported function is used internally by UpdateIngressBackend.
+func findIngressPath(ingress *networkingv1.Ingress, host, path string) *networkingv1.HTTPIngressPath {
+	for i := range ingress.Spec.Rules {
+		rule := &ingress.Spec.Rules[i]
+		if rule.Host != host || rule.HTTP == nil {
+			continue
+		}
+		for j := range rule.HTTP.Paths {
+			if rule.HTTP.Paths[j].Path == path {
+				return &rule.HTTP.Paths[j]
+			}
+		}
+	}
+	return nil
+}
+
+// extractIngressBackendParameters extracts and validates the 'ingressName', 'host', 'path',
+// 'serviceName', and 'servicePort' parameters. 'host' is optional and selects rules without a host
+// when omitted; 'servicePort' may be a port number or a named port.
+//
+// This function is used by task runners that update Ingress backends.
+func extractIngressBackendParameters(parameters map[string]interface{}) (ingressBackendUpdate, error) {
+	ingressName, err := getParamAsString(parameters, ingressNamE)
+	if err != nil || ingressName == "" {
+		return ingressBackendUpdate{}, newParameterError(ingressNamE, err, language.ErrorParameterMissing, ingressNamE)
+	}
+	host, err := getOptionalParamAsString(parameters, hosT, "")
+	if err != nil {
+		return ingressBackendUpdate{}, err
+	}
+	path, err := getParamAsString(parameters, patH)
+	if err != nil || path == "" {
+		return ingressBackendUpdate{}, newParameterError(patH, err, language.ErrorParameterMissing, patH)
+	}
+	serviceName, err := getParamAsString(parameters, serviceNamE)
+	if err != nil || serviceName == "" {
+		return ingressBackendUpdate{}, newParameterError(serviceNamE, err, language.ErrorParameterMissing, serviceNamE)
+	}
+
+	backend := networkingv1.IngressServiceBackend{Name: serviceName}
+	switch port := parameters[servicePorT].(type) {
+	case string:
+		if port == "" {
+			return ingressBackendUpdate{}, newParameterError(servicePorT, nil, language.ErrorParameterMissing, servicePorT)
+		}
+		backend.Port.Name = port
+	default:
+		number, err := getParamAsInt(parameters, servicePorT)
+		if err != nil {
+			return ingressBackendUpdate{}, err
+		}
+		if number < 1 || number > 65535 {
+			return ingressBackendUpdate{}, newParameterError(servicePorT, nil, language.ErrorInvalidPort, number)
+		}
+		backend.Port.Number = int32(number)
+	}
+
+	return ingressBackendUpdate{ingressName: ingressName, host: host, path: path, backend: backend}, nil
+}
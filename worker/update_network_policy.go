@@ -25,7 +25,7 @@ import (
 // Parameters:
 //
 //	ctx context.Context: Context for cancellation and timeout.
-//	clientset *kubernetes.Clientset: A Kubernetes clientset for interacting with the Kubernetes API.
+//	clientset kubernetes.Interface: A Kubernetes clientset for interacting with the Kubernetes API.
 //	namespace: The Kubernetes namespace containing the NetworkPolicy.
 //	policyName string: The name of the NetworkPolicy to update.
 //	policySpec networkingv1.NetworkPolicySpec: The new specification for the NetworkPolicy.
@@ -33,7 +33,7 @@ import (
 //	logger *zap.Logger: A logger for structured logging.
 //
 // Returns an error if the operation fails after retries or if a non-conflict error is encountered.
-func UpdateNetworkPolicy(ctx context.Context, clientset *kubernetes.Clientset, namespace, policyName string, policySpec networkingv1.NetworkPolicySpec, results chan<- string, logger *zap.Logger) error {
+func UpdateNetworkPolicy(ctx context.Context, clientset kubernetes.Interface, namespace, policyName string, policySpec networkingv1.NetworkPolicySpec, results chan<- string, logger *zap.Logger) error {
 	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
 		// Get the current NetworkPolicy
 		currentPolicy, err := clientset.NetworkingV1().NetworkPolicies(namespace).Get(ctx, policyName, v1.GetOptions{})
@@ -83,10 +83,10 @@ func reportNetworkFailure(results chan<- string, logger *zap.Logger, policyName,
 func extractPolicyName(parameters map[string]interface{}) (string, error) {
 	policyName, err := getParamAsString(parameters, policyNamE)
 	if err != nil {
-		return "", fmt.Errorf(language.ErrorParameterMustBeString, err)
+		return "", newParameterError(policyNamE, err, language.ErrorParameterMustBeString, err)
 	}
 	if policyName == "" {
-		return "", fmt.Errorf(language.ErrorParameterPolicyName)
+		return "", newParameterError(policyNamE, nil, language.ErrorParameterPolicyName)
 	}
 	return policyName, nil
 }
@@ -129,7 +129,7 @@ func extractNetworkPolicyParameters(parameters map[string]interface{}) (string,
 
 	policySpecData, err := getParamAsString(parameters, policySpeC)
 	if err != nil {
-		return "", networkingv1.NetworkPolicySpec{}, fmt.Errorf(language.ErrorParameterMustBeString, err)
+		return "", networkingv1.NetworkPolicySpec{}, newParameterError(policySpeC, err, language.ErrorParameterMustBeString, err)
 	}
 
 	policySpec, err := unmarshalPolicySpec(policySpecData)
@@ -0,0 +1,103 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/H0llyW00dzZ/K8sBlackPearl/language"
+	"github.com/H0llyW00dzZ/K8sBlackPearl/navigator"
+	"github.com/H0llyW00dzZ/go-urlshortner/logmonitor/constant"
+	"go.uber.org/zap"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/util/retry"
+)
+
+// UpdateSecretData writes the given keys into an existing Secret. By default the keys are merged into
+// the Secret's data, leaving every other key untouched; with replace set, the data is replaced as a
+// whole. The update is retried on conflicts with a fresh read. Only key names are reported or logged,
+// never values.
+//
+// Parameters:
+//
+//	ctx context.Context: Context for cancellation and timeout.
+//	clientset kubernetes.Interface: A Kubernetes clientset for interacting with the Kubernetes API.
+//	namespace string: The namespace of the Secret.
+//	secretName string: The name of the Secret.
+//	stringData map[string]string: The keys and plain-text values to write.
+//	replace bool: True to replace all data of the Secret instead of merging into it.
+//	results chan<- string: A channel to send operation results for logging.
+//	logger *zap.Logger: A logger for structured logging.
+//
+// Returns an error if the Secret cannot be read or updated. A missing Secret is reported as a
+// NotFound error that is not retried.
+func UpdateSecretData(ctx context.Context, clientset kubernetes.Interface, namespace, secretName string, stringData map[string]string, replace bool, results chan<- string, logger *zap.Logger) error {
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		secret, err := clientset.CoreV1().Secrets(namespace).Get(ctx, secretName, v1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			return markNonRetriable(fmt.Errorf(language.ErrorSecretNotFound, secretName, namespace, err))
+		}
+		if err != nil {
+			return err
+		}
+
+		if replace || secret.Data == nil {
+			secret.Data = make(map[string][]byte, len(stringData))
+		}
+		for key, value := range stringData {
+			secret.Data[key] = []byte(value)
+		}
+		_, err = clientset.CoreV1().Secrets(namespace).Update(ctx, secret, v1.UpdateOptions{})
+		return err
+	})
+	if err != nil {
+		errorMessage := fmt.Sprintf(language.ErrorFailedToUpdateSecret, secretName, err)
+		results <- errorMessage
+		navigator.LogErrorWithEmojiRateLimited(constant.ErrorEmoji, errorMessage, zap.Error(err))
+		return err
+	}
+
+	keys := make([]string, 0, len(stringData))
+	for key := range stringData {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	format := language.SecretDataMerged
+	if replace {
+		format = language.SecretDataReplaced
+	}
+	message := fmt.Sprintf(format, secretName, strings.Join(keys, ", "))
+	results <- message
+	navigator.LogInfoWithEmoji(constant.SuccessEmoji, message, zap.String(language.SecretName, secretName), zap.Strings(language.SecretKeys, keys))
+	return nil
+}
+
+// extractUpdateSecretParameters extracts and validates the 'secretName', 'stringData', and optional
+// 'replace' parameters. 'stringData' must be a non-empty map of string values.
+//
+// This function is used by task runners that update Secrets.
+func extractUpdateSecretParameters(parameters map[string]interface{}) (string, map[string]string, bool, error) {
+	secretName, err := getParamAsString(parameters, secretNamE)
+	if err != nil || secretName == "" {
+		return "", nil, false, newParameterError(secretNamE, err, language.ErrorParameterMissing, secretNamE)
+	}
+
+	stringData, err := getParamAsStringMap(parameters, stringDatA)
+	if err != nil {
+		return "", nil, false, err
+	}
+	if len(stringData) == 0 {
+		return "", nil, false, newParameterError(stringDatA, nil, language.ErrorParameterMissing, stringDatA)
+	}
+
+	replace, err := getOptionalParamAsBool(parameters, replacE, false)
+	if err != nil {
+		return "", nil, false, err
+	}
+
+	return secretName, stringData, replace, nil
+}
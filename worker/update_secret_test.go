@@ -0,0 +1,80 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func newSecretClientset() *fake.Clientset {
+	return fake.NewSimpleClientset(&corev1.Secret{
+		ObjectMeta: v1.ObjectMeta{Name: "db", Namespace: "default"},
+		Data:       map[string][]byte{"username": []byte("jack-sparrow"), "password": []byte("hunter2")},
+	})
+}
+
+func secretData(t *testing.T, clientset *fake.Clientset) map[string]string {
+	t.Helper()
+	secret, err := clientset.CoreV1().Secrets("default").Get(context.Background(), "db", v1.GetOptions{})
+	if err != nil {
+		t.Fatalf("get secret: %v", err)
+	}
+	data := make(map[string]string, len(secret.Data))
+	for key, value := range secret.Data {
+		data[key] = string(value)
+	}
+	return data
+}
+
+func TestUpdateSecretDataMergePreservesUnrelatedKeys(t *testing.T) {
+	clientset := newSecretClientset()
+	logs := observeLogs(t)
+	results := make(chan string, 1)
+
+	if err := UpdateSecretData(context.Background(), clientset, "default", "db", map[string]string{"password": "black-pearl"}, false, results, nil); err != nil {
+		t.Fatalf("UpdateSecretData: %v", err)
+	}
+
+	want := map[string]string{"username": "jack-sparrow", "password": "black-pearl"}
+	if got := secretData(t, clientset); !reflect.DeepEqual(got, want) {
+		t.Fatalf("got data %v after merge, want %v", got, want)
+	}
+	var output strings.Builder
+	fmt.Fprintln(&output, <-results)
+	for _, entry := range logs.All() {
+		fmt.Fprintln(&output, entry.Message, entry.ContextMap())
+	}
+	if strings.Contains(output.String(), "black-pearl") || !strings.Contains(output.String(), "password") {
+		t.Fatalf("output should name the key but never its value:\n%s", output.String())
+	}
+}
+
+func TestUpdateSecretDataReplaceDropsOtherKeys(t *testing.T) {
+	clientset := newSecretClientset()
+	results := make(chan string, 1)
+
+	if err := UpdateSecretData(context.Background(), clientset, "default", "db", map[string]string{"token": "rum"}, true, results, nil); err != nil {
+		t.Fatalf("UpdateSecretData: %v", err)
+	}
+
+	want := map[string]string{"token": "rum"}
+	if got := secretData(t, clientset); !reflect.DeepEqual(got, want) {
+		t.Fatalf("got data %v after replace, want %v", got, want)
+	}
+}
+
+func TestUpdateSecretDataMissingSecret(t *testing.T) {
+	results := make(chan string, 1)
+
+	err := UpdateSecretData(context.Background(), fake.NewSimpleClientset(), "default", "db", map[string]string{"password": "black-pearl"}, false, results, nil)
+	if !apierrors.IsNotFound(err) || !isNonRetriable(err) {
+		t.Fatalf("got error %v, want a non-retriable NotFound error", err)
+	}
+}
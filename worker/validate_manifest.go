@@ -0,0 +1,94 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/H0llyW00dzZ/K8sBlackPearl/language"
+	"github.com/H0llyW00dzZ/K8sBlackPearl/navigator"
+	"github.com/H0llyW00dzZ/go-urlshortner/logmonitor/constant"
+	"go.uber.org/zap"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/dynamic"
+)
+
+// ValidateManifestObjects checks whether the API server would accept each of the given objects by
+// issuing server-side dry-run requests: a create, or an update when the object already exists.
+// Dry-run requests run validation and admission but never persist anything. Every object's outcome,
+// including the server's validation or admission message, is reported through the results channel.
+//
+// Parameters:
+//
+//	ctx context.Context: Context for cancellation and timeout.
+//	dynamicClient dynamic.Interface: A dynamic client for unstructured resource operations.
+//	mapper meta.RESTMapper: A RESTMapper to resolve object kinds into API resources.
+//	namespace string: The default namespace for namespaced objects that do not declare one.
+//	objects []*unstructured.Unstructured: The decoded manifest objects to validate.
+//	results chan<- string: A channel to send per-object results; it must have room for one message per object.
+//
+// Returns an error naming every object that was rejected, or nil if all were accepted. When every
+// rejection comes from validation, the error is not retried.
+func ValidateManifestObjects(ctx context.Context, dynamicClient dynamic.Interface, mapper meta.RESTMapper, namespace string, objects []*unstructured.Unstructured, results chan<- string) error {
+	var rejected []string
+	onlyValidationErrors := true
+	for _, obj := range objects {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		objectRef := describeObject(obj)
+		if err := dryRunManifestObject(ctx, dynamicClient, mapper, namespace, obj); err != nil {
+			errorMessage := fmt.Sprintf(language.ObjectRejected, objectRef, err)
+			results <- errorMessage
+			navigator.LogErrorWithEmojiRateLimited(constant.ErrorEmoji, errorMessage, zap.Error(err))
+			rejected = append(rejected, objectRef)
+			if !apierrors.IsInvalid(err) && !apierrors.IsBadRequest(err) {
+				onlyValidationErrors = false
+			}
+			continue
+		}
+
+		successMsg := fmt.Sprintf(language.ObjectValid, objectRef)
+		results <- successMsg
+		navigator.LogInfoWithEmoji(constant.SuccessEmoji, successMsg)
+	}
+
+	if len(rejected) == 0 {
+		return nil
+	}
+	err := fmt.Errorf(language.ErrorValidateManifestFailed, len(rejected), strings.Join(rejected, ", "))
+	if onlyValidationErrors {
+		return markNonRetriable(err)
+	}
+	return err
+}
+
+// dryRunManifestObject submits a single object as a dry-run create, or as a dry-run update carrying
+// the live resource version when the object already exists.
+//
+// This unexported function is used internally by ValidateManifestObjects.
+func dryRunManifestObject(ctx context.Context, dynamicClient dynamic.Interface, mapper meta.RESTMapper, namespace string, obj *unstructured.Unstructured) error {
+	resourceClient, _, err := resourceInterfaceFor(dynamicClient, mapper, obj, namespace)
+	if err != nil {
+		return err
+	}
+
+	dryRun := []string{v1.DryRunAll}
+	_, err = resourceClient.Create(ctx, obj, v1.CreateOptions{DryRun: dryRun})
+	if !apierrors.IsAlreadyExists(err) {
+		return err
+	}
+
+	existing, err := resourceClient.Get(ctx, obj.GetName(), v1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	candidate := obj.DeepCopy()
+	candidate.SetResourceVersion(existing.GetResourceVersion())
+	_, err = resourceClient.Update(ctx, candidate, v1.UpdateOptions{DryRun: dryRun})
+	return err
+}
@@ -0,0 +1,128 @@
+package worker
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/H0llyW00dzZ/K8sBlackPearl/worker/configuration"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/client-go/dynamic"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+// dryRunServer wraps a fake dynamic client the way an API server treats dry-run requests, which the
+// fake client does not: dry-run writes are validated but never stored. Objects labeled valid=false
+// are rejected as invalid, and any write without dry run fails the test.
+type dryRunServer struct {
+	dynamic.Interface
+	t *testing.T
+}
+
+// Resource returns a resource client that serves dry-run writes.
+func (s dryRunServer) Resource(resource schema.GroupVersionResource) dynamic.NamespaceableResourceInterface {
+	return dryRunResource{NamespaceableResourceInterface: s.Interface.Resource(resource), t: s.t}
+}
+
+// dryRunResource serves dry-run writes for a single resource.
+type dryRunResource struct {
+	dynamic.NamespaceableResourceInterface
+	t *testing.T
+}
+
+// Namespace returns a client for the namespace that serves dry-run writes.
+func (r dryRunResource) Namespace(namespace string) dynamic.ResourceInterface {
+	return dryRunNamespacedResource{ResourceInterface: r.NamespaceableResourceInterface.Namespace(namespace), t: r.t}
+}
+
+// dryRunNamespacedResource serves dry-run writes for a single resource in a namespace.
+type dryRunNamespacedResource struct {
+	dynamic.ResourceInterface
+	t *testing.T
+}
+
+// Create validates the object, failing with AlreadyExists when it is stored already.
+func (r dryRunNamespacedResource) Create(ctx context.Context, obj *unstructured.Unstructured, options v1.CreateOptions, _ ...string) (*unstructured.Unstructured, error) {
+	if _, err := r.Get(ctx, obj.GetName(), v1.GetOptions{}); err == nil {
+		return nil, apierrors.NewAlreadyExists(schema.GroupResource{Resource: "configmaps"}, obj.GetName())
+	}
+	return r.validate(obj, options.DryRun)
+}
+
+// Update validates the object.
+func (r dryRunNamespacedResource) Update(_ context.Context, obj *unstructured.Unstructured, options v1.UpdateOptions, _ ...string) (*unstructured.Unstructured, error) {
+	return r.validate(obj, options.DryRun)
+}
+
+// validate accepts the object unless it is labeled valid=false, without storing it.
+func (r dryRunNamespacedResource) validate(obj *unstructured.Unstructured, dryRun []string) (*unstructured.Unstructured, error) {
+	if len(dryRun) != 1 || dryRun[0] != v1.DryRunAll {
+		r.t.Errorf("%s was written with dry run %v, want %v", obj.GetName(), dryRun, []string{v1.DryRunAll})
+	}
+	if obj.GetLabels()["valid"] == "false" {
+		return nil, apierrors.NewInvalid(schema.GroupKind{Kind: "ConfigMap"}, obj.GetName(),
+			field.ErrorList{field.Invalid(field.NewPath("metadata", "labels"), "false", "rejected by policy")})
+	}
+	return obj, nil
+}
+
+func TestCrewValidateManifestReportsEachDocumentWithoutPersisting(t *testing.T) {
+	clientset := fake.NewSimpleClientset()
+	forgetDynamicClient(t, clientset)
+	scheme := runtime.NewScheme()
+	if err := corev1.AddToScheme(scheme); err != nil {
+		t.Fatalf("AddToScheme: %v", err)
+	}
+	existing := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata":   map[string]interface{}{"name": "existing", "namespace": "default"},
+		"data":       map[string]interface{}{"mode": "calm"},
+	}}
+	dynamicClient := dynamicfake.NewSimpleDynamicClient(scheme, existing)
+	mapper := meta.NewDefaultRESTMapper([]schema.GroupVersion{corev1.SchemeGroupVersion})
+	mapper.Add(corev1.SchemeGroupVersion.WithKind("ConfigMap"), meta.RESTScopeNamespace)
+	SetDynamicClient(clientset, dryRunServer{Interface: dynamicClient, t: t}, mapper)
+
+	manifest := strings.Join([]string{
+		"apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: fresh\ndata:\n  mode: calm\n",
+		"apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: existing\ndata:\n  mode: storm\n",
+		"apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: broken\n  labels:\n    valid: \"false\"\n",
+	}, "---\n")
+	task := configuration.Task{Name: "validate", Type: "CrewValidateManifest", ShipsNamespace: "default"}
+	collector := &resultCollector{}
+
+	err := (&CrewValidateManifest{}).Run(collector.context(context.Background()), clientset, "default", task, map[string]interface{}{"manifest": manifest}, 0)
+	if err == nil || !isNonRetriable(err) {
+		t.Fatalf("got error %v, want a non-retriable error for the rejected document", err)
+	}
+
+	results := collector.all()
+	if len(results) != 3 ||
+		results[0] != "ConfigMap/fresh would be accepted by the API server" ||
+		results[1] != "ConfigMap/existing would be accepted by the API server" ||
+		!strings.HasPrefix(results[2], "ConfigMap/broken would be rejected by the API server:") ||
+		!strings.Contains(results[2], "rejected by policy") {
+		t.Fatalf("got results %q", results)
+	}
+
+	configMaps := dynamicClient.Resource(corev1.SchemeGroupVersion.WithResource("configmaps")).Namespace("default")
+	if _, err := configMaps.Get(context.Background(), "fresh", v1.GetOptions{}); !apierrors.IsNotFound(err) {
+		t.Fatalf("validating created the fresh object: %v", err)
+	}
+	stored, err := configMaps.Get(context.Background(), "existing", v1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if mode, _, _ := unstructured.NestedString(stored.Object, "data", "mode"); mode != "calm" {
+		t.Fatalf("validating changed the existing object to data.mode %q", mode)
+	}
+}
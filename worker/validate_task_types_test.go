@@ -0,0 +1,35 @@
+package worker
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/H0llyW00dzZ/K8sBlackPearl/worker/configuration"
+)
+
+func TestValidateTaskTypes(t *testing.T) {
+	if err := ValidateTaskTypes([]configuration.Task{
+		{Name: "list", Type: "CrewGetPods"},
+		{Name: "scale", Type: "CrewScaleDeployments"},
+	}); err != nil {
+		t.Fatalf("registered types rejected: %v", err)
+	}
+
+	err := ValidateTaskTypes([]configuration.Task{
+		{Name: "list", Type: "CrewGetPods"},
+		{Name: "typo", Type: "CrewGetPod"},
+		{Name: "made-up", Type: "CrewSinkShip"},
+	})
+	if err == nil {
+		t.Fatal("unknown types accepted")
+	}
+	message := err.Error()
+	for _, want := range []string{"'typo' (type 'CrewGetPod')", "'made-up' (type 'CrewSinkShip')", "CrewGetPods", "CrewScaleDeployments"} {
+		if !strings.Contains(message, want) {
+			t.Fatalf("error %q does not mention %q", message, want)
+		}
+	}
+	if unknown, _, _ := strings.Cut(message, "; valid types are"); strings.Contains(unknown, "'list'") {
+		t.Fatalf("error %q lists a registered task as unknown", message)
+	}
+}
@@ -0,0 +1,195 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/H0llyW00dzZ/K8sBlackPearl/language"
+	"github.com/H0llyW00dzZ/K8sBlackPearl/navigator"
+	"github.com/H0llyW00dzZ/go-urlshortner/logmonitor/constant"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/util/retry"
+)
+
+// vpaResource identifies the VerticalPodAutoscaler custom resource. VPA is installed as a CRD,
+// so it has no typed client in client-go and is managed through the dynamic client.
+var vpaResource = schema.GroupVersionResource{Group: "autoscaling.k8s.io", Version: "v1", Resource: "verticalpodautoscalers"}
+
+// vpaUpdateModes are the update modes accepted for a VerticalPodAutoscaler.
+var vpaUpdateModes = map[string]bool{"Off": true, "Initial": true, "Auto": true}
+
+// vpaParameters holds the VerticalPodAutoscaler definition extracted from task parameters.
+type vpaParameters struct {
+	name       string
+	targetName string
+	updateMode string
+	minAllowed corev1.ResourceList
+	maxAllowed corev1.ResourceList
+	overwrite  bool
+}
+
+// CreateVPA creates a VerticalPodAutoscaler in the specified namespace. If it already exists and
+// overwrite is enabled, the existing object's spec is replaced, retrying on conflicts. When the
+// VerticalPodAutoscaler CRD is not installed in the cluster, a non-retriable error is returned.
+//
+// Parameters:
+//
+//	ctx context.Context: Context for cancellation and timeout.
+//	dynamicClient dynamic.Interface: A dynamic client for unstructured resource operations.
+//	namespace string: The namespace in which to create the VerticalPodAutoscaler.
+//	vpa *unstructured.Unstructured: The VerticalPodAutoscaler to create.
+//	overwrite bool: Whether an existing VerticalPodAutoscaler with the same name should be replaced.
+//	results chan<- string: A channel to send operation results for logging.
+//	logger *zap.Logger: A logger for structured logging.
+//
+// Returns an error if the VerticalPodAutoscaler cannot be created or overwritten.
+func CreateVPA(ctx context.Context, dynamicClient dynamic.Interface, namespace string, vpa *unstructured.Unstructured, overwrite bool, results chan<- string, logger *zap.Logger) error {
+	vpaClient := dynamicClient.Resource(vpaResource).Namespace(namespace)
+	vpaName := vpa.GetName()
+	applyDefaultObjectMeta(vpa)
+
+	created, err := vpaClient.Create(ctx, vpa, v1.CreateOptions{})
+	if err == nil {
+		publishCreatedObject(ctx, created)
+		successMsg := fmt.Sprintf(language.VPASuccessfullyCreated, vpaName, namespace)
+		results <- successMsg
+		navigator.LogInfoWithEmoji(constant.SuccessEmoji, successMsg)
+		return nil
+	}
+
+	if apierrors.IsNotFound(err) || meta.IsNoMatchError(err) {
+		return reportVPAFailure(results, vpaName, markNonRetriable(fmt.Errorf(language.ErrorVPANotInstalled, vpaResource.GroupVersion().String(), err)))
+	}
+	if !apierrors.IsAlreadyExists(err) {
+		return reportVPAFailure(results, vpaName, err)
+	}
+	if !overwrite {
+		return reportVPAFailure(results, vpaName, markNonRetriable(fmt.Errorf(language.ErrorVPAAlreadyExists, vpaName)))
+	}
+
+	err = retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		current, getErr := vpaClient.Get(ctx, vpaName, v1.GetOptions{})
+		if getErr != nil {
+			return getErr
+		}
+		current.Object["spec"] = vpa.Object["spec"]
+		_, updateErr := vpaClient.Update(ctx, current, v1.UpdateOptions{})
+		return updateErr
+	})
+	if err != nil {
+		return reportVPAFailure(results, vpaName, err)
+	}
+
+	successMsg := fmt.Sprintf(language.VPASuccessfullyUpdated, vpaName, namespace)
+	results <- successMsg
+	navigator.LogInfoWithEmoji(constant.SuccessEmoji, successMsg)
+	return nil
+}
+
+// reportVPAFailure sends an error message to the results channel, logs the failure,
+// and returns the error so callers can propagate it directly.
+//
+// This unexported function is used internally by CreateVPA to report failures.
+func reportVPAFailure(results chan<- string, vpaName string, err error) error {
+	errorMessage := fmt.Sprintf(language.ErrorFailedToCreateVPA, vpaName, err)
+	results <- errorMessage
+	navigator.LogErrorWithEmojiRateLimited(constant.ErrorEmoji, errorMessage, zap.Error(err))
+	return err
+}
+
+// buildVPAObject builds the unstructured VerticalPodAutoscaler targeting a deployment. The min and
+// max allowed resources, when given, form a resource policy that applies to every container.
+//
+// This unexported function is used internally by CrewCreateVPA.
+func buildVPAObject(params vpaParameters) *unstructured.Unstructured {
+	spec := map[string]interface{}{
+		"targetRef": map[string]interface{}{
+			"apiVersion": "apps/v1",
+			"kind":       "Deployment",
+			"name":       params.targetName,
+		},
+		"updatePolicy": map[string]interface{}{
+			"updateMode": params.updateMode,
+		},
+	}
+
+	if len(params.minAllowed) > 0 || len(params.maxAllowed) > 0 {
+		containerPolicy := map[string]interface{}{"containerName": "*"}
+		if len(params.minAllowed) > 0 {
+			containerPolicy["minAllowed"] = resourceListToUnstructured(params.minAllowed)
+		}
+		if len(params.maxAllowed) > 0 {
+			containerPolicy["maxAllowed"] = resourceListToUnstructured(params.maxAllowed)
+		}
+		spec["resourcePolicy"] = map[string]interface{}{
+			"containerPolicies": []interface{}{containerPolicy},
+		}
+	}
+
+	vpa := &unstructured.Unstructured{Object: map[string]interface{}{"spec": spec}}
+	vpa.SetGroupVersionKind(vpaResource.GroupVersion().WithKind("VerticalPodAutoscaler"))
+	vpa.SetName(params.name)
+	return vpa
+}
+
+// resourceListToUnstructured converts a resource list into the string quantities used by
+// unstructured objects.
+//
+// This unexported function is used internally by buildVPAObject.
+func resourceListToUnstructured(list corev1.ResourceList) map[string]interface{} {
+	out := make(map[string]interface{}, len(list))
+	for name, quantity := range list {
+		out[string(name)] = quantity.String()
+	}
+	return out
+}
+
+// extractVPAParameters extracts and validates the 'targetName', 'updateMode', 'vpaName', 'minAllowed',
+// 'maxAllowed', and 'overwrite' parameters. The update mode must be 'Off', 'Initial', or 'Auto', and the
+// VerticalPodAutoscaler is named after its target deployment unless 'vpaName' is given.
+//
+// This function is used by task runners that create VerticalPodAutoscalers.
+func extractVPAParameters(parameters map[string]interface{}) (vpaParameters, error) {
+	targetName, err := getParamAsString(parameters, targetNamE)
+	if err != nil || targetName == "" {
+		return vpaParameters{}, newParameterError(targetNamE, err, language.ErrorParameterMissing, targetNamE)
+	}
+
+	updateMode, err := getParamAsString(parameters, updateModE)
+	if err != nil {
+		return vpaParameters{}, err
+	}
+	if !vpaUpdateModes[updateMode] {
+		return vpaParameters{}, newParameterError(updateModE, nil, language.ErrorInvalidVPAUpdateMode, updateMode)
+	}
+
+	name, err := getOptionalParamAsString(parameters, vpaNamE, targetName)
+	if err != nil {
+		return vpaParameters{}, err
+	}
+
+	params := vpaParameters{name: name, targetName: targetName, updateMode: updateMode}
+	if _, exists := parameters[minAlloweD]; exists {
+		if params.minAllowed, err = parseResourceList(parameters, minAlloweD); err != nil {
+			return vpaParameters{}, err
+		}
+	}
+	if _, exists := parameters[maxAlloweD]; exists {
+		if params.maxAllowed, err = parseResourceList(parameters, maxAlloweD); err != nil {
+			return vpaParameters{}, err
+		}
+	}
+
+	params.overwrite, err = getOptionalParamAsBool(parameters, overwritE, false)
+	if err != nil {
+		return vpaParameters{}, err
+	}
+	return params, nil
+}
@@ -0,0 +1,221 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/H0llyW00dzZ/K8sBlackPearl/language"
+	"github.com/H0llyW00dzZ/K8sBlackPearl/navigator"
+	"github.com/H0llyW00dzZ/go-urlshortner/logmonitor/constant"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/kubernetes"
+)
+
+// WatchPodsUntilCondition streams pod events from the Kubernetes watch API and returns once the
+// requested condition is met. Supported conditions are 'ready', satisfied when at least one pod
+// matches and every matching pod is running with all containers ready, and 'deleted', satisfied
+// when no matching pod remains.
+//
+// Watches expire on the server side; when the event channel closes, the watch is re-established
+// from the last seen resource version. If that version is too old (HTTP 410 Gone), the pods are
+// listed again and the watch resumes from the fresh resource version.
+//
+// Parameters:
+//
+//	ctx context.Context: Context for cancellation; the wait is additionally bounded by timeout.
+//	clientset kubernetes.Interface: A Kubernetes clientset for interacting with the Kubernetes API.
+//	namespace string: The namespace of the pods.
+//	labelSelector string: An optional label selector for the watched pods.
+//	podName string: An optional pod name to restrict the watch to a single pod.
+//	condition string: The condition to wait for, either 'ready' or 'deleted'.
+//	timeout time.Duration: The maximum time to wait.
+//	results chan<- string: A channel that receives one message per observed event; it must be drained concurrently.
+//	logger *zap.Logger: A logger for structured logging.
+//
+// Returns an error if the condition is not met before the timeout or the watch cannot be established.
+func WatchPodsUntilCondition(ctx context.Context, clientset kubernetes.Interface, namespace, labelSelector, podName, condition string, timeout time.Duration, results chan<- string, logger *zap.Logger) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	listOptions := v1.ListOptions{LabelSelector: labelSelector}
+	if podName != "" {
+		listOptions.FieldSelector = fields.OneTermEqualSelector(metadataName, podName).String()
+	}
+
+	pods, resourceVersion, err := listPodsForWatch(ctx, clientset, namespace, listOptions)
+	if err != nil {
+		return reportWatchFailure(results, condition, err)
+	}
+
+	for {
+		if podConditionMet(pods, condition) {
+			successMsg := fmt.Sprintf(language.WatchConditionMet, condition, len(pods))
+			results <- successMsg
+			navigator.LogInfoWithEmoji(constant.SuccessEmoji, successMsg)
+			return nil
+		}
+
+		watchOptions := listOptions
+		watchOptions.ResourceVersion = resourceVersion
+		watchOptions.AllowWatchBookmarks = true
+		watcher, err := clientset.CoreV1().Pods(namespace).Watch(ctx, watchOptions)
+		if err != nil {
+			if ctx.Err() != nil {
+				return reportWatchFailure(results, condition, fmt.Errorf(language.ErrorWatchTimedOut, condition, timeout))
+			}
+			return reportWatchFailure(results, condition, err)
+		}
+
+		resourceVersion, err = consumePodEvents(ctx, watcher, pods, resourceVersion, condition, results)
+		watcher.Stop()
+		switch {
+		case ctx.Err() != nil:
+			return reportWatchFailure(results, condition, fmt.Errorf(language.ErrorWatchTimedOut, condition, timeout))
+		case apierrors.IsResourceExpired(err) || apierrors.IsGone(err):
+			// The resource version is too old to resume from, so start over with a fresh list.
+			navigator.LogInfoWithEmoji(language.SwordEmoji, language.WatchExpiredRelisting)
+			pods, resourceVersion, err = listPodsForWatch(ctx, clientset, namespace, listOptions)
+			if err != nil {
+				return reportWatchFailure(results, condition, err)
+			}
+		case err != nil:
+			return reportWatchFailure(results, condition, err)
+		}
+	}
+}
+
+// listPodsForWatch lists the pods to seed the watch state and returns them keyed by name,
+// along with the resource version from which the watch should start.
+//
+// This unexported function is used internally by WatchPodsUntilCondition.
+func listPodsForWatch(ctx context.Context, clientset kubernetes.Interface, namespace string, listOptions v1.ListOptions) (map[string]*corev1.Pod, string, error) {
+	podList, err := clientset.CoreV1().Pods(namespace).List(ctx, listOptions)
+	if err != nil {
+		return nil, "", fmt.Errorf(language.ErrorListingPods, err)
+	}
+	pods := make(map[string]*corev1.Pod, len(podList.Items))
+	for i := range podList.Items {
+		pods[podList.Items[i].Name] = &podList.Items[i]
+	}
+	return pods, podList.ResourceVersion, nil
+}
+
+// consumePodEvents applies watch events to the tracked pods until the condition is met, the watch
+// channel closes, or the context is done. It returns the last seen resource version so that the
+// caller can resume the watch from there.
+//
+// This unexported function is used internally by WatchPodsUntilCondition.
+func consumePodEvents(ctx context.Context, watcher watch.Interface, pods map[string]*corev1.Pod, resourceVersion, condition string, results chan<- string) (string, error) {
+	for {
+		select {
+		case <-ctx.Done():
+			return resourceVersion, ctx.Err()
+		case event, ok := <-watcher.ResultChan():
+			if !ok {
+				// The server closed the watch; the caller re-establishes it.
+				return resourceVersion, nil
+			}
+			if event.Type == watch.Error {
+				return resourceVersion, apierrors.FromObject(event.Object)
+			}
+			pod, isPod := event.Object.(*corev1.Pod)
+			if !isPod {
+				continue
+			}
+			resourceVersion = pod.ResourceVersion
+
+			switch event.Type {
+			case watch.Bookmark:
+				continue
+			case watch.Deleted:
+				delete(pods, pod.Name)
+			default:
+				pods[pod.Name] = pod
+			}
+
+			eventMsg := fmt.Sprintf(language.WatchPodEvent, event.Type, pod.Name, pod.Status.Phase)
+			select {
+			case results <- eventMsg:
+			case <-ctx.Done():
+				return resourceVersion, ctx.Err()
+			}
+			if podConditionMet(pods, condition) {
+				return resourceVersion, nil
+			}
+		}
+	}
+}
+
+// podConditionMet reports whether the tracked pods satisfy the condition.
+//
+// This unexported function is used internally by WatchPodsUntilCondition.
+func podConditionMet(pods map[string]*corev1.Pod, condition string) bool {
+	switch condition {
+	case watchConditionDeleted:
+		return len(pods) == 0
+	default:
+		if len(pods) == 0 {
+			return false
+		}
+		for _, pod := range pods {
+			if !CrewCheckingisPodHealthy(pod) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// reportWatchFailure sends an error message to the results channel, logs the failure,
+// and returns the error so callers can propagate it directly.
+//
+// This unexported function is used internally by WatchPodsUntilCondition to report failures.
+func reportWatchFailure(results chan<- string, condition string, err error) error {
+	errorMessage := fmt.Sprintf(language.ErrorWatchFailed, condition, err)
+	results <- errorMessage
+	navigator.LogErrorWithEmojiRateLimited(constant.ErrorEmoji, errorMessage, zap.Error(err))
+	return err
+}
+
+// extractWatchPodsParameters extracts and validates the 'condition', 'timeout', 'labelSelector',
+// and 'podName' parameters. The condition must be 'ready' or 'deleted'; the timeout defaults to
+// five minutes. At least one of 'labelSelector' or 'podName' must be given.
+//
+// This function is used by task runners that wait on pods through the watch API.
+func extractWatchPodsParameters(parameters map[string]interface{}) (labelSelectorValue, podName, condition string, timeout time.Duration, err error) {
+	condition, err = getParamAsString(parameters, conditioN)
+	if err != nil {
+		return "", "", "", 0, err
+	}
+	if condition != watchConditionReady && condition != watchConditionDeleted {
+		return "", "", "", 0, newParameterError(conditioN, nil, language.ErrorInvalidWatchCondition, condition)
+	}
+
+	labelSelectorValue, err = getOptionalParamAsString(parameters, labelSelector, "")
+	if err != nil {
+		return "", "", "", 0, err
+	}
+	podName, err = getOptionalParamAsString(parameters, language.PodName, "")
+	if err != nil {
+		return "", "", "", 0, err
+	}
+	if labelSelectorValue == "" && podName == "" {
+		return "", "", "", 0, newParameterError(labelSelector, nil, language.ErrorWatchTargetMissing)
+	}
+
+	timeoutStr, err := getOptionalParamAsString(parameters, timeouT, defaultWatchTimeout)
+	if err != nil {
+		return "", "", "", 0, err
+	}
+	timeout, err = time.ParseDuration(timeoutStr)
+	if err != nil || timeout <= 0 {
+		return "", "", "", 0, newParameterError(timeouT, err, language.ErrorParameterInvalid, timeouT)
+	}
+	return labelSelectorValue, podName, condition, timeout, nil
+}
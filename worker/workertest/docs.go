@@ -0,0 +1,45 @@
+// Package workertest provides a harness for testing TaskRunner implementations against a fake
+// Kubernetes clientset, without a cluster.
+//
+// A Harness wraps a k8s.io/client-go/kubernetes/fake clientset seeded with objects. Running a runner
+// through the harness captures the lines it reports through its results channel, so tests can assert
+// on the reported outcome as well as on the state of the fake cluster afterwards.
+//
+// Example usage:
+//
+//	func TestScaleDeployments(t *testing.T) {
+//		replicas := int32(1)
+//		deployment := &appsv1.Deployment{
+//			ObjectMeta: metav1.ObjectMeta{Name: "api", Namespace: "default"},
+//			Spec:       appsv1.DeploymentSpec{Replicas: &replicas},
+//		}
+//		harness := workertest.NewHarness("default", deployment)
+//
+//		task := configuration.Task{
+//			Name:       "scale-api",
+//			Type:       "CrewScaleDeployments",
+//			MaxRetries: 1,
+//			RetryDelay: "1s",
+//			Parameters: map[string]interface{}{
+//				"deploymentName": "api",
+//				"replicas":       3,
+//			},
+//		}
+//		output, err := harness.RunAndCollect(context.Background(), &worker.CrewScaleDeployments{}, task)
+//		if err != nil {
+//			t.Fatalf("run failed: %v\n%s", err, strings.Join(output.Results, "\n"))
+//		}
+//
+//		scaled, _ := harness.Clientset.AppsV1().Deployments("default").Get(context.Background(), "api", metav1.GetOptions{})
+//		if *scaled.Spec.Replicas != 3 {
+//			t.Fatalf("expected 3 replicas, got %d", *scaled.Spec.Replicas)
+//		}
+//	}
+//
+// Important Note:
+//
+// Runners still log through the package-level logger of the navigator package; set it, for example
+// with navigator.SetLogger(zap.NewNop()), to keep test output quiet. Runners that rely on the dynamic
+// client, such as CrewApplyManifest and the metrics runners, fail without retries until a fake dynamic
+// client is registered for the harness clientset with worker.SetDynamicClient.
+package workertest
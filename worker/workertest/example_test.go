@@ -0,0 +1,54 @@
+package workertest_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/H0llyW00dzZ/K8sBlackPearl/navigator"
+	"github.com/H0llyW00dzZ/K8sBlackPearl/worker"
+	"github.com/H0llyW00dzZ/K8sBlackPearl/worker/configuration"
+	"github.com/H0llyW00dzZ/K8sBlackPearl/worker/workertest"
+	"go.uber.org/zap"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func ExampleHarness_RunAndCollect() {
+	navigator.SetLogger(zap.NewNop())
+
+	replicas := int32(1)
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "api", Namespace: "default"},
+		Spec:       appsv1.DeploymentSpec{Replicas: &replicas},
+	}
+	harness := workertest.NewHarness("default", deployment)
+
+	task := configuration.Task{
+		Name:       "scale-api",
+		Type:       "CrewScaleDeployments",
+		MaxRetries: 1,
+		RetryDelay: "1s",
+		Parameters: map[string]interface{}{
+			"deploymentName": "api",
+			"replicas":       3,
+		},
+	}
+	output, err := harness.RunAndCollect(context.Background(), &worker.CrewScaleDeployments{}, task)
+	if err != nil {
+		fmt.Println("run failed:", err)
+		return
+	}
+	for _, result := range output.Results {
+		fmt.Println(result)
+	}
+
+	scaled, err := harness.Clientset.AppsV1().Deployments("default").Get(context.Background(), "api", metav1.GetOptions{})
+	if err != nil {
+		fmt.Println("get failed:", err)
+		return
+	}
+	fmt.Println("replicas:", *scaled.Spec.Replicas)
+	// Output:
+	// Scaled deployment 'api' to '3' replicas
+	// replicas: 3
+}
@@ -0,0 +1,87 @@
+package workertest
+
+import (
+	"context"
+	"sync"
+
+	"github.com/H0llyW00dzZ/K8sBlackPearl/worker"
+	"github.com/H0llyW00dzZ/K8sBlackPearl/worker/configuration"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+// Harness runs TaskRunners against a fake clientset.
+//
+// Fields:
+//
+//	Clientset *fake.Clientset: The fake clientset passed to the runners; inspect it after a run to
+//	assert on the resulting cluster state.
+//	Namespace string: The namespace passed to the runners.
+//	WorkerIndex int: The worker index passed to the runners.
+type Harness struct {
+	Clientset   *fake.Clientset
+	Namespace   string
+	WorkerIndex int
+}
+
+// Output holds what a runner reported during a single run.
+//
+// Fields:
+//
+//	Results []string: The lines the runner reported through its results channel, in order. Runners
+//	that do not report through a results channel, and runners that stop at an error before draining
+//	it, leave it empty; the error returned by the run describes the failure then.
+type Output struct {
+	Results []string
+}
+
+// NewHarness creates a Harness whose fake clientset is seeded with the given objects.
+//
+// Parameters:
+//
+//	namespace string: The namespace passed to the runners.
+//	objects ...runtime.Object: The objects the fake cluster starts with.
+//
+// Returns:
+//
+//	*Harness: The harness ready to run TaskRunners.
+func NewHarness(namespace string, objects ...runtime.Object) *Harness {
+	return &Harness{
+		Clientset: fake.NewSimpleClientset(objects...),
+		Namespace: namespace,
+	}
+}
+
+// RunAndCollect runs the TaskRunner with the task and its parameters against the fake clientset and
+// returns the results it reported, captured through worker.WithResultObserver. If the task does not
+// name a namespace, the harness namespace is used.
+//
+// Parameters:
+//
+//	ctx context.Context: Context for cancellation and timeout of the run.
+//	runner worker.TaskRunner: The runner under test.
+//	task configuration.Task: The task to run.
+//
+// Returns:
+//
+//	Output: The results reported during the run.
+//	error: The error returned by the runner.
+func (h *Harness) RunAndCollect(ctx context.Context, runner worker.TaskRunner, task configuration.Task) (Output, error) {
+	var mu sync.Mutex
+	var output Output
+	ctx = worker.WithResultObserver(ctx, func(result string) {
+		mu.Lock()
+		output.Results = append(output.Results, result)
+		mu.Unlock()
+	})
+
+	namespace := task.ShipsNamespace
+	if namespace == "" {
+		namespace = h.Namespace
+	}
+	err := runner.Run(ctx, h.Clientset, namespace, task, task.Parameters, h.WorkerIndex)
+
+	mu.Lock()
+	defer mu.Unlock()
+	return Output{Results: append([]string(nil), output.Results...)}, err
+}